@@ -0,0 +1,240 @@
+package poker
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var updateAPI = flag.Bool("update-api", false, "rewrite testdata/api.txt with the current exported API")
+
+const apiFile = "testdata/api.txt"
+
+// modulePath is the module path in go.mod.
+const modulePath = "github.com/sdeboni/go-poker"
+
+// TestAPI fails when the exported API of the module's packages differs from
+// testdata/api.txt, so that changes to it are made on purpose: run with
+// -update-api and commit the file with the change.
+func TestAPI(t *testing.T) {
+	imp := &apiImporter{token.NewFileSet(), importer.Default(), map[string]*types.Package{}}
+	var api []string
+	err := filepath.WalkDir(".", func(path string, d os.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return err
+		}
+		if name := d.Name(); path != "." && (name == "testdata" || name == "internal" || strings.HasPrefix(name, ".")) {
+			return filepath.SkipDir
+		}
+		lines, err := packageAPI(imp, path)
+		api = append(api, lines...)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Sort(api)
+	got := strings.Join(api, "\n") + "\n"
+
+	if *updateAPI {
+		if err := os.WriteFile(apiFile, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	expected, err := os.ReadFile(apiFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	added, removed := diffLines(strings.Split(got, "\n"), strings.Split(string(expected), "\n"))
+	for _, line := range removed {
+		t.Errorf("removed: %s", line)
+	}
+	for _, line := range added {
+		t.Errorf("added: %s", line)
+	}
+	if len(added)+len(removed) > 0 {
+		t.Log("run go test -run TestAPI -update-api to accept the changes")
+	}
+}
+
+// apiImporter type-checks the module's packages from their non-test files,
+// once each, and imports the standard library from export data.
+type apiImporter struct {
+	fset *token.FileSet
+	std  types.Importer
+	pkgs map[string]*types.Package
+}
+
+func (imp *apiImporter) Import(path string) (*types.Package, error) {
+	if path != modulePath && !strings.HasPrefix(path, modulePath+"/") {
+		return imp.std.Import(path)
+	}
+	if pkg, ok := imp.pkgs[path]; ok {
+		return pkg, nil
+	}
+	dir := "." + strings.TrimPrefix(path, modulePath)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []*ast.File
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") || strings.HasSuffix(e.Name(), "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(imp.fset, filepath.Join(dir, e.Name()), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	var pkg *types.Package
+	if len(files) > 0 {
+		conf := types.Config{Importer: imp}
+		if pkg, err = conf.Check(path, imp.fset, files, nil); err != nil {
+			return nil, err
+		}
+	}
+	imp.pkgs[path] = pkg
+	return pkg, nil
+}
+
+// packageAPI lists the exported API of the package in dir, if any, one
+// declaration per line.
+func packageAPI(imp *apiImporter, dir string) ([]string, error) {
+	path := modulePath
+	if dir != "." {
+		path += "/" + filepath.ToSlash(dir)
+	}
+	pkg, err := imp.Import(path)
+	if pkg == nil || err != nil {
+		return nil, err
+	}
+
+	qualifier := func(other *types.Package) string {
+		if other == pkg {
+			return ""
+		}
+		return other.Name()
+	}
+	prefix := "pkg " + path + ", "
+	var lines []string
+	for _, name := range pkg.Scope().Names() {
+		obj := pkg.Scope().Lookup(name)
+		if !obj.Exported() {
+			continue
+		}
+		switch obj := obj.(type) {
+		case *types.Const:
+			lines = append(lines, fmt.Sprintf("%sconst %s %s = %s", prefix, name, types.TypeString(obj.Type(), qualifier), obj.Val().ExactString()))
+		case *types.Var:
+			lines = append(lines, fmt.Sprintf("%svar %s %s", prefix, name, types.TypeString(obj.Type(), qualifier)))
+		case *types.Func:
+			lines = append(lines, fmt.Sprintf("%sfunc %s%s", prefix, name, signature(obj.Type().(*types.Signature), qualifier)))
+		case *types.TypeName:
+			lines = append(lines, typeAPI(prefix, obj, qualifier)...)
+		}
+	}
+	return lines, nil
+}
+
+func typeAPI(prefix string, obj *types.TypeName, qualifier types.Qualifier) []string {
+	name := obj.Name()
+	if obj.IsAlias() {
+		// The aliased type's API is listed with its own package.
+		return []string{fmt.Sprintf("%stype %s = %s", prefix, name, types.TypeString(types.Unalias(obj.Type()), qualifier))}
+	}
+	var lines []string
+	switch u := obj.Type().Underlying().(type) {
+	case *types.Struct:
+		lines = append(lines, fmt.Sprintf("%stype %s struct", prefix, name))
+		for field := range u.Fields() {
+			if field.Exported() {
+				lines = append(lines, fmt.Sprintf("%stype %s struct, %s %s", prefix, name, field.Name(), types.TypeString(field.Type(), qualifier)))
+			}
+		}
+	case *types.Interface:
+		lines = append(lines, fmt.Sprintf("%stype %s interface", prefix, name))
+		for method := range u.Methods() {
+			lines = append(lines, fmt.Sprintf("%stype %s interface, %s%s", prefix, name, method.Name(), signature(method.Type().(*types.Signature), qualifier)))
+		}
+	case *types.Signature:
+		lines = append(lines, fmt.Sprintf("%stype %s func%s", prefix, name, signature(u, qualifier)))
+	default:
+		lines = append(lines, fmt.Sprintf("%stype %s %s", prefix, name, types.TypeString(u, qualifier)))
+	}
+
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return lines
+	}
+	for method := range named.Methods() {
+		if !method.Exported() {
+			continue
+		}
+		sig := method.Type().(*types.Signature)
+		receiver := name
+		if _, ok := sig.Recv().Type().(*types.Pointer); ok {
+			receiver = "*" + name
+		}
+		lines = append(lines, fmt.Sprintf("%smethod (%s) %s%s", prefix, receiver, method.Name(), signature(sig, qualifier)))
+	}
+	return lines
+}
+
+// signature formats parameter and result types without their names, which
+// callers do not depend on.
+func signature(sig *types.Signature, qualifier types.Qualifier) string {
+	tuple := func(vars *types.Tuple, variadic bool) []string {
+		var list []string
+		for i := range vars.Len() {
+			t := vars.At(i).Type()
+			if variadic && i == vars.Len()-1 {
+				list = append(list, "..."+types.TypeString(t.(*types.Slice).Elem(), qualifier))
+			} else {
+				list = append(list, types.TypeString(t, qualifier))
+			}
+		}
+		return list
+	}
+	s := "(" + strings.Join(tuple(sig.Params(), sig.Variadic()), ", ") + ")"
+	switch results := tuple(sig.Results(), false); len(results) {
+	case 0:
+	case 1:
+		s += " " + results[0]
+	default:
+		s += " (" + strings.Join(results, ", ") + ")"
+	}
+	return s
+}
+
+// diffLines returns the lines only in a and only in b.
+func diffLines(a, b []string) (onlyA, onlyB []string) {
+	inB := make(map[string]bool)
+	for _, line := range b {
+		inB[line] = true
+	}
+	inA := make(map[string]bool)
+	for _, line := range a {
+		inA[line] = true
+		if line != "" && !inB[line] {
+			onlyA = append(onlyA, line)
+		}
+	}
+	for _, line := range b {
+		if line != "" && !inA[line] {
+			onlyB = append(onlyB, line)
+		}
+	}
+	return onlyA, onlyB
+}
@@ -0,0 +1,128 @@
+package poker
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+type BatchResult struct {
+	// Index is the showdown's position in the input.
+	Index int
+	// Winners is what BestHand returns for the showdown, unless Err is set.
+	Winners []string
+	Err     error
+}
+
+// EvaluateBatch runs BestHand on every showdown using up to workers
+// goroutines, GOMAXPROCS when workers is not positive. A showdown that fails
+// only sets its own result's Err. When ctx is cancelled, the showdowns not yet
+// evaluated get ctx's error.
+func EvaluateBatch(ctx context.Context, showdowns [][]string, workers int) []BatchResult {
+	in := make(chan []string)
+	go func() {
+		defer close(in)
+		for _, showdown := range showdowns {
+			select {
+			case in <- showdown:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	results := make([]BatchResult, 0, len(showdowns))
+	for result := range EvaluateStream(ctx, in, workers) {
+		results = append(results, result)
+	}
+	for i := len(results); i < len(showdowns); i++ {
+		results = append(results, BatchResult{Index: i, Err: ctx.Err()})
+	}
+	return results
+}
+
+// EvaluateStream runs BestHand on every showdown received from in using up to
+// workers goroutines, GOMAXPROCS when workers is not positive, and sends the
+// results in input order. The returned channel is closed once in is closed
+// and drained, or as soon as ctx is cancelled.
+func EvaluateStream(ctx context.Context, in <-chan []string, workers int) <-chan BatchResult {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	type job struct {
+		index    int
+		showdown []string
+	}
+	jobs := make(chan job)
+	done := make(chan BatchResult)
+	out := make(chan BatchResult)
+	// Bounds the results waiting to be sent in order behind a slow showdown.
+	slots := make(chan struct{}, 2*workers)
+
+	go func() {
+		defer close(jobs)
+		for index := 0; ; index++ {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case showdown, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case jobs <- job{index, showdown}:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Go(func() {
+			for j := range jobs {
+				winners, err := BestHand(j.showdown)
+				select {
+				case done <- BatchResult{j.index, winners, err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		})
+	}
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	go func() {
+		defer close(out)
+		pending := make(map[int]BatchResult)
+		next := 0
+		for result := range done {
+			pending[result.Index] = result
+			for {
+				result, ok := pending[next]
+				if !ok {
+					break
+				}
+				select {
+				case out <- result:
+				case <-ctx.Done():
+					return
+				}
+				delete(pending, next)
+				next++
+				<-slots
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,119 @@
+package poker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/deck"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+func TestEvaluateBatch(t *testing.T) {
+	showdowns := make([][]string, 0, len(validCases)+len(invalidCases))
+	for _, tc := range validCases {
+		showdowns = append(showdowns, tc.input)
+	}
+	for _, tc := range invalidCases {
+		showdowns = append(showdowns, tc.input)
+	}
+
+	results := EvaluateBatch(context.Background(), showdowns, 4)
+	if len(results) != len(showdowns) {
+		t.Fatalf("expected %d results, got %d", len(showdowns), len(results))
+	}
+	for i, result := range results {
+		if result.Index != i {
+			t.Errorf("result %d has index %d", i, result.Index)
+		}
+		if i < len(validCases) {
+			if result.Err != nil || !slices.Equal(result.Winners, validCases[i].expected) {
+				t.Errorf("%s: expected %v, got %v (%v)", validCases[i].description, validCases[i].expected, result.Winners, result.Err)
+			}
+		} else if result.Err == nil {
+			t.Errorf("%s: expected error", invalidCases[i-len(validCases)].description)
+		}
+	}
+}
+
+func TestEvaluateBatchEmptyShowdown(t *testing.T) {
+	results := EvaluateBatch(context.Background(), [][]string{{}, {"2♢ 2♡ 3♡ 4♡ 5♧"}}, 2)
+	if results[0].Err == nil || results[1].Err != nil {
+		t.Errorf("expected only the empty showdown to fail, got %+v", results)
+	}
+}
+
+func TestEvaluateBatchCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := EvaluateBatch(ctx, randomShowdowns(100, 3), 2)
+	if len(results) != 100 {
+		t.Fatalf("expected 100 results, got %d", len(results))
+	}
+	if !errors.Is(results[99].Err, context.Canceled) {
+		t.Errorf("expected the last showdown to be cancelled, got %v", results[99].Err)
+	}
+}
+
+func TestEvaluateStream(t *testing.T) {
+	showdowns := randomShowdowns(1000, 4)
+	in := make(chan []string)
+	go func() {
+		defer close(in)
+		for _, showdown := range showdowns {
+			in <- showdown
+		}
+	}()
+
+	var i int
+	for result := range EvaluateStream(context.Background(), in, 8) {
+		expected, err := BestHand(showdowns[i])
+		if result.Index != i || result.Err != err || !slices.Equal(result.Winners, expected) {
+			t.Fatalf("result %d: expected %v, got %+v", i, expected, result)
+		}
+		i++
+	}
+	if i != len(showdowns) {
+		t.Errorf("expected %d results, got %d", len(showdowns), i)
+	}
+}
+
+func randomShowdowns(n, players int) [][]string {
+	rng := rand.New(rand.NewPCG(8, 13))
+	showdowns := make([][]string, n)
+	for i := range showdowns {
+		d := deck.New()
+		d.Shuffle(rng.Uint64(), deck.CURRENT_SHUFFLE_VERSION)
+		for range players {
+			cards, _ := d.Deal(eval.CARDS_PER_HAND)
+			showdowns[i] = append(showdowns[i], card.Format(cards))
+		}
+	}
+	return showdowns
+}
+
+func BenchmarkEvaluateBatch(b *testing.B) {
+	showdowns := randomShowdowns(1000, 6)
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for range b.N {
+				EvaluateBatch(context.Background(), showdowns, workers)
+			}
+		})
+	}
+}
+
+func BenchmarkBestHandSequential(b *testing.B) {
+	showdowns := randomShowdowns(1000, 6)
+	b.ResetTimer()
+	for range b.N {
+		for _, showdown := range showdowns {
+			BestHand(showdown)
+		}
+	}
+}
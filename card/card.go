@@ -0,0 +1,169 @@
+// Package card defines playing cards: their ranks and suits, the orders
+// they are compared in and the notation they are parsed from and printed in,
+// such as "10♡" or "A♤".
+package card
+
+import (
+	"cmp"
+	"fmt"
+)
+
+const DECK_SIZE = 52
+
+type Rank int
+
+const (
+	TWO Rank = iota + 2
+	THREE
+	FOUR
+	FIVE
+	SIX
+	SEVEN
+	EIGHT
+	NINE
+	TEN
+	JACK
+	QUEEN
+	KING
+	ACE
+)
+
+// String writes the rank as it appears in a card, "10" for a ten.
+func (r Rank) String() string {
+	switch r {
+	case TWO:
+		return "2"
+	case THREE:
+		return "3"
+	case FOUR:
+		return "4"
+	case FIVE:
+		return "5"
+	case SIX:
+		return "6"
+	case SEVEN:
+		return "7"
+	case EIGHT:
+		return "8"
+	case NINE:
+		return "9"
+	case TEN:
+		return "10"
+	case JACK:
+		return "J"
+	case QUEEN:
+		return "Q"
+	case KING:
+		return "K"
+	case ACE:
+		return "A"
+	default:
+		return fmt.Sprintf("Rank(%d)", int(r))
+	}
+}
+
+type Suit int
+
+const (
+	HEARTS Suit = iota + 1
+	CLUBS
+	SPADES
+	DIAMONDS
+)
+
+// BRIDGE_SUITS lists the suits in bridge order, the order Index gives them.
+var BRIDGE_SUITS = [4]Suit{CLUBS, DIAMONDS, HEARTS, SPADES}
+
+type Card struct {
+	rank Rank
+	suit Suit
+}
+
+// jokerSuit marks JOKER. It is none of the four suits, and not zero, so that
+// a Card left unset is not taken for a joker.
+const jokerSuit Suit = -1
+
+// JOKER is the 53rd card of Joker Poker. Its rank is zero and its suit none
+// of the four.
+var JOKER = Card{suit: jokerSuit}
+
+func New(rank Rank, suit Suit) Card {
+	return Card{rank, suit}
+}
+
+func (c *Card) String() string {
+	if *c == JOKER {
+		return "Joker"
+	}
+	return c.rank.String() + string(suitToRune(c.suit))
+}
+
+func (c Card) Rank() Rank {
+	return c.rank
+}
+
+func (c Card) Suit() Suit {
+	return c.suit
+}
+
+// SuitOrder breaks ties between equal ranks where the rules of the game call
+// for it: stud bring-ins, drawing for the button, awarding odd chips.
+type SuitOrder int
+
+const (
+	// NO_SUIT_ORDER treats all suits as equal.
+	NO_SUIT_ORDER SuitOrder = iota
+	// BRIDGE_SUIT_ORDER ranks clubs < diamonds < hearts < spades.
+	BRIDGE_SUIT_ORDER
+	// ALPHABETICAL_SUIT_ORDER ranks suits by their English names, which gives
+	// the same order as bridge; it exists so house rules can name the one they use.
+	ALPHABETICAL_SUIT_ORDER
+)
+
+func (o SuitOrder) Compare(a, b Suit) int {
+	switch o {
+	case NO_SUIT_ORDER:
+		return 0
+	case BRIDGE_SUIT_ORDER, ALPHABETICAL_SUIT_ORDER:
+		return cmp.Compare(bridgeSuitIndex(a), bridgeSuitIndex(b))
+	default:
+		panic("invalid SuitOrder")
+	}
+}
+
+// Compare orders cards by rank, then by suit according to order.
+func (c Card) Compare(other Card, order SuitOrder) int {
+	if r := cmp.Compare(c.rank, other.rank); r != 0 {
+		return r
+	}
+	return order.Compare(c.suit, other.suit)
+}
+
+// Index maps the card to 0..51, rank major with suits in bridge order, so that
+// comparing indexes is the same as Compare with BRIDGE_SUIT_ORDER. It panics
+// on JOKER and on the zero Card, which are not among the 52.
+func (c Card) Index() int {
+	return int(c.rank-TWO)*4 + bridgeSuitIndex(c.suit)
+}
+
+func FromIndex(index int) (Card, error) {
+	if index < 0 || index >= DECK_SIZE {
+		return Card{}, fmt.Errorf("invalid card index: %d", index)
+	}
+	return Card{TWO + Rank(index/4), BRIDGE_SUITS[index%4]}, nil
+}
+
+func bridgeSuitIndex(suit Suit) int {
+	switch suit {
+	case CLUBS:
+		return 0
+	case DIAMONDS:
+		return 1
+	case HEARTS:
+		return 2
+	case SPADES:
+		return 3
+	default:
+		panic("invalid suit")
+	}
+}
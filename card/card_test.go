@@ -0,0 +1,81 @@
+package card
+
+import (
+	"testing"
+)
+
+func TestCardCompare(t *testing.T) {
+	cases := []struct {
+		description string
+		a, b        string
+		order       SuitOrder
+		expected    int
+	}{
+		{"higher rank wins regardless of suit", "K♧", "Q♤", BRIDGE_SUIT_ORDER, 1},
+		{"lower rank loses regardless of suit", "2♤", "3♧", BRIDGE_SUIT_ORDER, -1},
+		{"spades beat hearts in bridge order", "9♤", "9♡", BRIDGE_SUIT_ORDER, 1},
+		{"diamonds beat clubs in bridge order", "9♢", "9♧", BRIDGE_SUIT_ORDER, 1},
+		{"hearts lose to spades alphabetically", "A♡", "A♤", ALPHABETICAL_SUIT_ORDER, -1},
+		{"suits tie without an order", "A♡", "A♤", NO_SUIT_ORDER, 0},
+		{"same card is equal", "10♢", "10♢", BRIDGE_SUIT_ORDER, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			a, err := Parse(tc.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := Parse(tc.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := a.Compare(b, tc.order); got != tc.expected {
+				t.Errorf("expected %s vs %s to compare %d, got %d", tc.a, tc.b, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCardIndex(t *testing.T) {
+	seen := make(map[Card]bool)
+	for i := range DECK_SIZE {
+		card, err := FromIndex(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen[card] {
+			t.Errorf("card %s returned for more than one index", card.String())
+		}
+		seen[card] = true
+		if card.Index() != i {
+			t.Errorf("expected index %d for %s, got %d", i, card.String(), card.Index())
+		}
+		if i > 0 {
+			prev, _ := FromIndex(i - 1)
+			if prev.Compare(card, BRIDGE_SUIT_ORDER) >= 0 {
+				t.Errorf("expected %s to sort before %s", prev.String(), card.String())
+			}
+		}
+	}
+
+	for _, index := range []int{-1, DECK_SIZE} {
+		if _, err := FromIndex(index); err == nil {
+			t.Errorf("expected error for index %d", index)
+		}
+	}
+}
+
+func TestJoker(t *testing.T) {
+	if JOKER == (Card{}) {
+		t.Error("expected the zero Card not to be a joker")
+	}
+	if JOKER.String() != "Joker" {
+		t.Errorf("expected Joker, got %s", JOKER.String())
+	}
+	for i := range DECK_SIZE {
+		if card, _ := FromIndex(i); card == JOKER {
+			t.Errorf("index %d is the joker", i)
+		}
+	}
+}
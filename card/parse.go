@@ -0,0 +1,150 @@
+package card
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Parse reads a card such as "10♡" or "A♤".
+func Parse(str string) (card Card, err error) {
+	var rank Rank
+	var suit Suit
+
+	chars := []rune(str)
+
+	switch len(chars) {
+	case 2:
+		if rank, err = parseRank(chars[0:1]); err == nil {
+			suit, err = parseSuit(chars[1])
+		}
+	case 3:
+		if rank, err = parseRank(chars[0:2]); err == nil {
+			suit, err = parseSuit(chars[2])
+		}
+	default:
+		err = fmt.Errorf("invalid card: '%s'", str)
+	}
+
+	card = Card{rank, suit}
+	return
+}
+
+// ParseCards reads distinct cards separated by spaces.
+func ParseCards(hand string) ([]Card, error) {
+	strCards := strings.Split(hand, " ")
+	cards := make([]Card, 0, len(strCards))
+
+	cardCounts := make(map[Card]int)
+
+	for _, str := range strCards {
+		if isEmpty(str) {
+			continue
+		}
+		card, err := Parse(str)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := cardCounts[card]; ok {
+			return nil, fmt.Errorf("invalid hand %s: duplicate card %s found", hand, str)
+		} else {
+			cardCounts[card] = 1
+		}
+		cards = append(cards, card)
+	}
+	return cards, nil
+}
+
+// ParseRank reads a rank as it appears in a card, "10" for a ten.
+func ParseRank(str string) (Rank, error) {
+	return parseRank([]rune(str))
+}
+
+// Format writes cards separated by spaces, the form ParseCards reads.
+func Format(cards []Card) string {
+	var sb strings.Builder
+	sb.WriteString(cards[0].String())
+	for _, card := range cards[1:] {
+		sb.WriteString(" ")
+		sb.WriteString(card.String())
+	}
+	return sb.String()
+}
+
+func isEmpty(str string) bool {
+	return len(strings.TrimSpace(str)) == 0
+}
+
+func parseRank(chars []rune) (rank Rank, err error) {
+	if len(chars) == 2 {
+		if chars[0] == '1' && chars[1] == '0' {
+			rank = TEN
+		} else {
+			err = fmt.Errorf("invalid card rank (length %d): '%s'", len(chars), string(chars))
+		}
+		return
+	} else if len(chars) != 1 {
+		err = fmt.Errorf("invalid card rank (length %d) : '%s'", len(chars), string(chars))
+		return
+	}
+
+	switch chars[0] {
+	case '2':
+		rank = TWO
+	case '3':
+		rank = THREE
+	case '4':
+		rank = FOUR
+	case '5':
+		rank = FIVE
+	case '6':
+		rank = SIX
+	case '7':
+		rank = SEVEN
+	case '8':
+		rank = EIGHT
+	case '9':
+		rank = NINE
+	case 'J':
+		rank = JACK
+	case 'Q':
+		rank = QUEEN
+	case 'K':
+		rank = KING
+	case 'A':
+		rank = ACE
+	default:
+		err = fmt.Errorf("invalid card rank: %c", chars[0])
+	}
+	return
+}
+
+func parseSuit(char rune) (suit Suit, err error) {
+	switch char {
+	case '♤':
+		suit = SPADES
+	case '♡':
+		suit = HEARTS
+	case '♧':
+		suit = CLUBS
+	case '♢':
+		suit = DIAMONDS
+	default:
+		err = fmt.Errorf("invalid suit: %c", char)
+	}
+	return
+}
+
+func suitToRune(suit Suit) rune {
+	switch suit {
+	case SPADES:
+		return '♤'
+	case HEARTS:
+		return '♡'
+	case CLUBS:
+		return '♧'
+	case DIAMONDS:
+		return '♢'
+	default:
+		panic("invalid suit")
+	}
+}
@@ -6,6 +6,12 @@ type validCase struct {
 	expected    []string
 }
 
+type rankCase struct {
+	description string
+	input       []string
+	expected    [][]int
+}
+
 type invalidCase struct {
 	description string
 	input       []string
@@ -33,6 +39,11 @@ var validCases = []validCase{
 		input:       []string{"5♢ 2♡ 8♡ 7♡ J♡", "5♡ 2♢ 8♢ A♢ J♢"},
 		expected:    []string{"5♡ 2♢ 8♢ A♢ J♢"},
 	},
+	{
+		description: "HighCards are compared from the highest card down",
+		input:       []string{"2♢ 3♡ 9♤ J♧ A♡", "4♢ 5♡ 6♤ J♢ A♧"},
+		expected:    []string{"2♢ 3♡ 9♤ J♧ A♡"},
+	},
 	{
 		description: "Same scoring HighCards are returned",
 		input:       []string{"5♢ 2♡ 8♡ 7♡ J♡", "2♢ J♤ 7♤ 5♡ 8♧", "2♤ 7♧ 3♤ 4♡ 5♧"},
@@ -58,6 +69,11 @@ var validCases = []validCase{
 		input:       []string{"4♡ 3♤ 3♧ 2♡ 5♡", "3♢ 3♡ 2♤ 4♧ 6♧"},
 		expected:    []string{"3♢ 3♡ 2♤ 4♧ 6♧"},
 	},
+	{
+		description: "Same pairs ranked by highest kicker first",
+		input:       []string{"3♢ 3♡ K♤ 2♧ 4♧", "3♤ 3♧ Q♢ 5♡ 6♡"},
+		expected:    []string{"3♢ 3♡ K♤ 2♧ 4♧"},
+	},
 	{
 		description: "TwoPair beats one pair",
 		input:       []string{"4♡ A♤ A♡ 2♡ 5♡", "3♢ 2♧ 2♤ 3♡ 6♧"},
@@ -93,6 +109,11 @@ var validCases = []validCase{
 		input:       []string{"A♧ 2♡ 3♤ 4♢ 5♢", "A♡ A♤ A♢ K♧ Q♡"},
 		expected:    []string{"A♧ 2♡ 3♤ 4♢ 5♢"},
 	},
+	{
+		description: "Straight starting with Ace is the lowest straight",
+		input:       []string{"A♧ 2♡ 3♤ 4♢ 5♢", "2♧ 3♡ 4♤ 5♡ 6♢"},
+		expected:    []string{"2♧ 3♡ 4♤ 5♡ 6♢"},
+	},
 	{
 		description: "Two equal straights are both returned",
 		input:       []string{"A♡ K♤ Q♢ J♢ 10♧", "10♡ J♤ Q♧ K♧ A♢"},
@@ -108,6 +129,11 @@ var validCases = []validCase{
 		input:       []string{"2♤ 3♤ Q♤ K♤ A♤", "2♡ 4♡ Q♡ K♡ A♡"},
 		expected:    []string{"2♡ 4♡ Q♡ K♡ A♡"},
 	},
+	{
+		description: "flushes are compared from the highest card down",
+		input:       []string{"2♤ 3♤ 9♤ J♤ A♤", "4♡ 5♡ 6♡ J♡ A♡"},
+		expected:    []string{"2♤ 3♤ 9♤ J♤ A♤"},
+	},
 	{
 		description: "full house beats flush",
 		input:       []string{"2♤ 2♡ 3♤ 3♡ 3♢", "6♡ 7♡ Q♡ K♡ A♡"},
@@ -145,7 +171,99 @@ var validCases = []validCase{
 	},
 }
 
+var rankCases = []rankCase{
+	{
+		description: "Single hand is the only tier",
+		input:       []string{"2♢ 2♡ 3♡ 4♡ 5♡"},
+		expected:    [][]int{{0}},
+	},
+	{
+		description: "All hands are ranked, not only winners",
+		input:       []string{"5♢ 2♡ 8♡ 7♡ J♡", "2♢ 2♤ 3♡ 4♡ 5♧", "3♢ 3♧ 4♢ 4♧ 6♧"},
+		expected:    [][]int{{2}, {1}, {0}},
+	},
+	{
+		description: "Tied hands share a tier in input order",
+		input:       []string{"4♡ 3♤ 3♡ 2♡ 5♡", "8♢ 6♧ 2♧ 4♧ 5♧", "3♢ 3♧ 2♤ 4♤ 5♤", "K♢ 7♤ 8♤ 9♤ 10♢"},
+		expected:    [][]int{{0, 2}, {3}, {1}},
+	},
+	{
+		description: "Indexes refer to the input regardless of spacing",
+		input:       []string{"  5♢ 2♡  8♡ 7♡ J♡", "A♤ A♡ 3♢ 4♢ 6♢  "},
+		expected:    [][]int{{1}, {0}},
+	},
+}
+
+// sokoCases are played by SokoRuleset.
+var sokoCases = []validCase{
+	{
+		description: "Four-flush beats a pair",
+		input:       []string{"A♢ A♡ 3♡ 4♤ 9♧", "2♡ 5♡ 8♡ J♡ 3♧"},
+		expected:    []string{"2♡ 5♡ 8♡ J♡ 3♧"},
+	},
+	{
+		description: "Four-straight beats a four-flush",
+		input:       []string{"2♡ 5♡ 8♡ J♡ 3♧", "6♢ 7♧ 8♤ 9♢ K♧"},
+		expected:    []string{"6♢ 7♧ 8♤ 9♢ K♧"},
+	},
+	{
+		description: "Two pair beats a four-straight",
+		input:       []string{"6♢ 7♧ 8♤ 9♢ K♧", "2♢ 2♧ 3♤ 3♢ 5♧"},
+		expected:    []string{"2♢ 2♧ 3♤ 3♢ 5♧"},
+	},
+	{
+		description: "Four-flushes compare from the top suited card",
+		input:       []string{"K♡ 5♡ 4♡ 2♡ A♧", "K♤ 6♤ 3♤ 2♤ 7♧"},
+		expected:    []string{"K♤ 6♤ 3♤ 2♤ 7♧"},
+	},
+	{
+		description: "Four-flush kicker breaks ties",
+		input:       []string{"K♡ 6♡ 3♡ 2♡ 7♧", "K♤ 6♤ 3♤ 2♤ Q♢"},
+		expected:    []string{"K♤ 6♤ 3♤ 2♤ Q♢"},
+	},
+	{
+		description: "Four-flushes tie on equal ranks",
+		input:       []string{"K♡ 6♡ 3♡ 2♡ 7♧", "K♤ 6♤ 3♤ 2♤ 7♢"},
+		expected:    []string{"K♡ 6♡ 3♡ 2♡ 7♧", "K♤ 6♤ 3♤ 2♤ 7♢"},
+	},
+	{
+		description: "Higher four-straight wins",
+		input:       []string{"9♢ 10♧ J♤ Q♢ 2♧", "8♡ 9♧ 10♤ J♡ A♢"},
+		expected:    []string{"9♢ 10♧ J♤ Q♢ 2♧"},
+	},
+	{
+		description: "Four-straight kicker breaks ties",
+		input:       []string{"9♢ 10♧ J♤ Q♢ 2♧", "9♡ 10♢ J♡ Q♤ 4♢"},
+		expected:    []string{"9♡ 10♢ J♡ Q♤ 4♢"},
+	},
+	{
+		description: "Ace plays low in a four-straight",
+		input:       []string{"A♢ 2♧ 3♤ 4♢ 9♧", "K♡ Q♧ 9♤ 5♡ 3♢"},
+		expected:    []string{"A♢ 2♧ 3♤ 4♢ 9♧"},
+	},
+	{
+		description: "Four-straight ranks by its run, not the odd card",
+		input:       []string{"4♡ 5♡ 6♢ 7♡ 9♧", "5♢ 6♧ 7♤ 8♢ 2♧"},
+		expected:    []string{"5♢ 6♧ 7♤ 8♢ 2♧"},
+	},
+	{
+		description: "Four-straight with a paired odd card beats a higher pair",
+		input:       []string{"5♢ 6♧ 7♤ 8♢ 8♧", "A♡ A♤ K♡ Q♢ 3♧"},
+		expected:    []string{"5♢ 6♧ 7♤ 8♢ 8♧"},
+	},
+	{
+		description: "Four-straight uses the highest run",
+		input:       []string{"2♢ 3♧ 4♤ 5♢ 7♧", "3♡ 4♢ 5♡ 6♤ 2♡"},
+		expected:    []string{"3♡ 4♢ 5♡ 6♤ 2♡"},
+	},
+}
+
 var invalidCases = []invalidCase{
+	{
+		description: "Recognizes an empty showdown",
+		input:       []string{},
+		errContains: []string{"no hands"},
+	},
 	{
 		description: "Recognizes invalid card rank",
 		input:       []string{"2♢ 2♡ 3♡ 4♡ 11♡"},
@@ -0,0 +1,229 @@
+package casino
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+	"github.com/sdeboni/go-poker/internal/deal"
+)
+
+// CaribbeanStudStandard is the usual Caribbean Stud pay table for the raise.
+// Pays are to one.
+func CaribbeanStudStandard() Paytable {
+	return Paytable{"Caribbean Stud", NO_WILD, card.TWO, map[PayLine]int{
+		{eval.STRAIGHT_FLUSH, ROYAL_FLUSH}: 100,
+		{eval.STRAIGHT_FLUSH, NO_BONUS}:    50,
+		{eval.FOUR_OF_A_KIND, NO_BONUS}:    20,
+		{eval.FULL_HOUSE, NO_BONUS}:        7,
+		{eval.FLUSH, NO_BONUS}:             5,
+		{eval.STRAIGHT, NO_BONUS}:          4,
+		{eval.THREE_OF_A_KIND, NO_BONUS}:   3,
+		{eval.TWO_PAIR, NO_BONUS}:          2,
+		{eval.PAIR, NO_BONUS}:              1,
+	}}
+}
+
+// caribbeanStudQualifier is the lowest ace-king hand.
+var caribbeanStudQualifier = eval.NewHandValue(eval.HIGH_CARD, card.ACE, card.KING, card.FOUR, card.THREE, card.TWO)
+
+// CaribbeanStud plays Caribbean Stud on a pay table. The player antes and is
+// dealt five cards, and sees one of the dealer's five. The player then folds
+// or raises twice the ante. A dealer below ace-king does not qualify: the
+// ante wins even money and the raise pushes. Otherwise the better hand wins,
+// the ante even money and the raise by the pay table, even money when the
+// table does not list the hand; a tie pushes.
+type CaribbeanStud struct {
+	table Paytable
+	pays  *payArray
+}
+
+func NewCaribbeanStud(table Paytable) (*CaribbeanStud, error) {
+	if table.Wild != NO_WILD {
+		return nil, fmt.Errorf("%s: Caribbean Stud has no wild cards", table.Name)
+	}
+	pays, err := table.payArray()
+	if err != nil {
+		return nil, err
+	}
+	return &CaribbeanStud{table, pays}, nil
+}
+
+// Settle returns the player's net result in units of the ante.
+func (g *CaribbeanStud) Settle(player, dealer []card.Card, raised bool) (int, error) {
+	if len(player) != eval.CARDS_PER_HAND || len(dealer) != eval.CARDS_PER_HAND {
+		return 0, fmt.Errorf("expected %d cards each, got %d and %d", eval.CARDS_PER_HAND, len(player), len(dealer))
+	}
+	if err := deal.Distinct(append(slices.Clone(player), dealer...)...); err != nil {
+		return 0, err
+	}
+	if !raised {
+		return -1, nil
+	}
+	hand, _ := g.table.indexes(player)
+	p, d := eval.Evaluate(player), eval.Evaluate(dealer)
+	switch {
+	case d < caribbeanStudQualifier:
+		return 1, nil
+	case p > d:
+		return 1 + 2*g.raisePay(hand), nil
+	case p < d:
+		return -3, nil
+	default:
+		return 0, nil
+	}
+}
+
+// raisePay pays a winning raise by the pay table, even money for the hands
+// it does not list.
+func (g *CaribbeanStud) raisePay(hand [eval.CARDS_PER_HAND]int) int {
+	return max(g.pays.pay(NO_WILD, g.table.MinPair, hand), 1)
+}
+
+// HouseEdge enumerates every deal, the player raising exactly when that
+// loses less than the ante given the dealer's up card, and returns the
+// expected loss per ante.
+//
+// The dealer hands that beat, tie or lose to each player hand are counted in
+// one pass over all hands in order of value. Counters for every subset of up
+// to five cards hold how many hands seen so far contain it, and the hands
+// disjoint from the player's and containing the up card are counted from
+// them by inclusion-exclusion.
+func (g *CaribbeanStud) HouseEdge() float64 {
+	type hand struct {
+		cards [eval.CARDS_PER_HAND]int
+		value eval.HandValue
+	}
+	hands := make([]hand, 0, binomial[card.DECK_SIZE][eval.CARDS_PER_HAND])
+	classes := make(map[uint64]int64)
+	var cards [eval.CARDS_PER_HAND]int
+	deal.ForEachCombination(card.DECK_SIZE, cards[:], func() {
+		masks := indexMasks(cards[:]...)
+		hands = append(hands, hand{cards, eval.EvaluateMasks(&masks)})
+		classes[suitIsomorphismKey(cards[:])]++
+	})
+	slices.SortFunc(hands, func(a, b hand) int {
+		return cmp.Compare(a.value, b.value)
+	})
+
+	newCounts := func() (counts [eval.CARDS_PER_HAND + 1][]int32) {
+		for k := range counts {
+			counts[k] = make([]int32, binomial[card.DECK_SIZE][k])
+		}
+		return counts
+	}
+	add := func(counts *[eval.CARDS_PER_HAND + 1][]int32, h *[eval.CARDS_PER_HAND]int) {
+		for mask := range 1 << eval.CARDS_PER_HAND {
+			k, rank := subsetRank(h, mask)
+			counts[k][rank]++
+		}
+	}
+	// disjoint[up] counts the hands containing the up card and none of the
+	// player's cards.
+	disjoint := func(counts *[eval.CARDS_PER_HAND + 1][]int32, h *[eval.CARDS_PER_HAND]int, disjoint *[card.DECK_SIZE]int32) {
+		for up := range card.DECK_SIZE {
+			disjoint[up] = 0
+		}
+		var subset [eval.CARDS_PER_HAND]int
+		for mask := range 1<<eval.CARDS_PER_HAND - 1 {
+			var size int
+			for i, c := range h {
+				if mask&(1<<i) != 0 {
+					subset[size] = c
+					size++
+				}
+			}
+			sign := int32(1 - 2*(size%2))
+			for up := range card.DECK_SIZE {
+				// The rank of the subset with the up card inserted in order.
+				var rank int64
+				var k int
+				inserted := false
+				for _, c := range subset[:size] {
+					if !inserted && up < c {
+						k++
+						rank += binomial[up][k]
+						inserted = true
+					}
+					if c == up {
+						k = -1
+						break
+					}
+					k++
+					rank += binomial[c][k]
+				}
+				if k < 0 {
+					continue
+				}
+				if !inserted {
+					k++
+					rank += binomial[up][k]
+				}
+				disjoint[up] += sign * counts[k][rank]
+			}
+		}
+	}
+
+	nonQualifying := newCounts()
+	for i := range hands {
+		if hands[i].value >= caribbeanStudQualifier {
+			break
+		}
+		add(&nonQualifying, &hands[i].cards)
+	}
+
+	type query struct {
+		hand  *hand
+		count int64
+		lower [card.DECK_SIZE]int32
+		nq    [card.DECK_SIZE]int32
+		// notHigher counts the hands up to and including the player's value.
+		notHigher [card.DECK_SIZE]int32
+	}
+	seen := newCounts()
+	dealerHands := int32(binomial[card.DECK_SIZE-eval.CARDS_PER_HAND-1][eval.CARDS_PER_HAND-1])
+	var ev float64
+	for start := 0; start < len(hands); {
+		end := start
+		for end < len(hands) && hands[end].value == hands[start].value {
+			end++
+		}
+
+		var queries []*query
+		for i := start; i < end; i++ {
+			key := suitIsomorphismKey(hands[i].cards[:])
+			if count, ok := classes[key]; ok {
+				delete(classes, key)
+				q := &query{hand: &hands[i], count: count}
+				disjoint(&seen, &q.hand.cards, &q.lower)
+				disjoint(&nonQualifying, &q.hand.cards, &q.nq)
+				queries = append(queries, q)
+			}
+		}
+		for i := start; i < end; i++ {
+			add(&seen, &hands[i].cards)
+		}
+		for _, q := range queries {
+			disjoint(&seen, &q.hand.cards, &q.notHigher)
+			pay := g.raisePay(q.hand.cards)
+			var total int64
+			for up := range card.DECK_SIZE {
+				if slices.Contains(q.hand.cards[:], up) {
+					continue
+				}
+				// Non-qualifying hands may rank above a weak player hand.
+				nq, lower, notHigher := q.nq[up], q.lower[up], q.notHigher[up]
+				wins := max(lower-nq, 0)
+				losses := dealerHands - max(notHigher, nq)
+				raise := int64(nq) + int64(wins)*int64(1+2*pay) - 3*int64(losses)
+				total += max(raise, -int64(dealerHands))
+			}
+			ev += float64(q.count) * float64(total)
+		}
+		start = end
+	}
+	deals := float64(binomial[card.DECK_SIZE][eval.CARDS_PER_HAND]) * float64(card.DECK_SIZE-eval.CARDS_PER_HAND) * float64(dealerHands)
+	return -ev / deals
+}
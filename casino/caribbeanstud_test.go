@@ -0,0 +1,60 @@
+package casino
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCaribbeanStudSettle(t *testing.T) {
+	cases := []struct {
+		description    string
+		player, dealer string
+		raised         bool
+		expected       int
+	}{
+		{"fold", "A♡ A♧ 2♤ 5♢ 9♧", "K♡ Q♧ 8♤ 5♤ 3♧", false, -1},
+		{"dealer does not qualify", "A♡ A♧ 2♤ 5♢ 9♧", "K♡ Q♧ 8♤ 5♤ 3♧", true, 1},
+		{"ace king wins even money", "A♡ K♧ 7♤ 5♢ 3♡", "A♤ K♢ 4♤ 3♧ 2♧", true, 3},
+		{"flush wins", "2♡ 6♡ 9♡ J♡ K♡", "A♤ A♢ 4♤ 3♧ 2♧", true, 11},
+		{"royal flush", "10♧ J♧ Q♧ K♧ A♧", "A♤ A♢ 4♤ 3♡ 2♧", true, 201},
+		{"player loses", "A♡ K♧ 7♤ 5♢ 3♡", "2♤ 2♢ 4♤ 3♧ 5♧", true, -3},
+		{"tie", "A♡ K♧ 7♤ 5♢ 3♡", "A♤ K♢ 7♧ 5♧ 3♤", true, 0},
+	}
+
+	g, err := NewCaribbeanStud(CaribbeanStudStandard())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			got, err := g.Settle(mustParseCards(t, tc.player), mustParseCards(t, tc.dealer), tc.raised)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %d, got %d", tc.expected, got)
+			}
+		})
+	}
+
+	if _, err := g.Settle(mustParseCards(t, "A♡ K♧ 7♤ 5♢ 3♡"), mustParseCards(t, "A♡ K♢ 7♧ 5♧ 3♤"), true); err == nil {
+		t.Error("expected error for a card dealt twice")
+	}
+	if _, err := NewCaribbeanStud(DeucesWildFullPay()); err == nil {
+		t.Error("expected error for wild cards")
+	}
+}
+
+// The published house edge with the best play against the dealer's up card.
+func TestCaribbeanStudHouseEdge(t *testing.T) {
+	if !*houseEdges {
+		t.Skip("run with -house-edges to enumerate the house edge")
+	}
+	g, err := NewCaribbeanStud(CaribbeanStudStandard())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := g.HouseEdge(); math.Abs(got-0.052243) > 1e-6 {
+		t.Errorf("expected 0.052243, got %f", got)
+	}
+}
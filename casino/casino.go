@@ -0,0 +1,17 @@
+// Package casino plays the house-banked games: video poker and the table
+// games played against the house rather than other players. Settling a deal
+// returns the player's net result on each wager in units of that wager, and
+// each game's HouseEdge is the expected loss per unit of the initial bet with
+// the best play.
+package casino
+
+import "github.com/sdeboni/go-poker/card"
+
+// indexMasks returns the ranks held in each suit by cards given as indexes.
+func indexMasks(cards ...int) [card.DIAMONDS + 1]uint16 {
+	var masks [card.DIAMONDS + 1]uint16
+	for _, i := range cards {
+		masks[card.BRIDGE_SUITS[i%4]] |= 1 << (card.TWO + card.Rank(i/4))
+	}
+	return masks
+}
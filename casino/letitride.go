@@ -0,0 +1,104 @@
+package casino
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+	"github.com/sdeboni/go-poker/internal/deal"
+)
+
+// LetItRideStandard is the usual Let It Ride pay table. Pays are to one; a
+// hand that pays nothing loses.
+func LetItRideStandard() Paytable {
+	return Paytable{"Let It Ride", NO_WILD, card.TEN, map[PayLine]int{
+		{eval.STRAIGHT_FLUSH, ROYAL_FLUSH}: 1000,
+		{eval.STRAIGHT_FLUSH, NO_BONUS}:    200,
+		{eval.FOUR_OF_A_KIND, NO_BONUS}:    50,
+		{eval.FULL_HOUSE, NO_BONUS}:        11,
+		{eval.FLUSH, NO_BONUS}:             8,
+		{eval.STRAIGHT, NO_BONUS}:          5,
+		{eval.THREE_OF_A_KIND, NO_BONUS}:   3,
+		{eval.TWO_PAIR, NO_BONUS}:          2,
+		{eval.PAIR, NO_BONUS}:              1,
+	}}
+}
+
+// LetItRide plays Let It Ride on a pay table. The player makes three equal
+// bets and is dealt three cards, with two community cards to come. The player
+// may take back the first bet before the first community card is shown and
+// the second before the last; the third always rides. Every bet left riding
+// is paid by the final five-card hand. There is no dealer hand.
+type LetItRide struct {
+	table Paytable
+	pays  *payArray
+	// sums[k][s] is the total net result of a bet over the final hands
+	// containing the k-subset s, as for VideoPoker.
+	sums [eval.CARDS_PER_HAND + 1][]int64
+}
+
+func NewLetItRide(table Paytable) (*LetItRide, error) {
+	pays, err := table.payArray()
+	if err != nil {
+		return nil, err
+	}
+	g := &LetItRide{table: table, pays: pays}
+	g.sums = paySums(table.deckSize(), func(hand *[eval.CARDS_PER_HAND]int) int {
+		if pay := pays.pay(table.Wild, table.MinPair, *hand); pay > 0 {
+			return pay
+		}
+		return -1
+	})
+	return g, nil
+}
+
+// Ride reports whether a bet is expected to win, and so should be left
+// riding, given the player's three cards and any community card shown.
+func (g *LetItRide) Ride(cards []card.Card) (bool, error) {
+	if len(cards) != 3 && len(cards) != 4 {
+		return false, fmt.Errorf("expected 3 or 4 cards, got %d", len(cards))
+	}
+	if err := deal.Distinct(cards...); err != nil {
+		return false, err
+	}
+	var hand [eval.CARDS_PER_HAND]int
+	for i, c := range cards {
+		hand[i] = c.Index()
+	}
+	slices.Sort(hand[:len(cards)])
+	k, rank := subsetRank(&hand, 1<<len(cards)-1)
+	return g.sums[k][rank] > 0, nil
+}
+
+// Settle returns the net result, in units of one bet, of the final hand with
+// riding bets still up.
+func (g *LetItRide) Settle(cards []card.Card, riding int) (int, error) {
+	if riding < 1 || riding > 3 {
+		return 0, fmt.Errorf("invalid number of riding bets: %d", riding)
+	}
+	hand, err := g.table.indexes(cards)
+	if err != nil {
+		return 0, err
+	}
+	if pay := g.pays.pay(g.table.Wild, g.table.MinPair, hand); pay > 0 {
+		return riding * pay, nil
+	}
+	return -riding, nil
+}
+
+// HouseEdge is the expected loss per bet, of the three, when bets ride
+// exactly when Ride says so.
+func (g *LetItRide) HouseEdge() float64 {
+	deck := g.table.deckSize()
+	ev := float64(g.sums[0][0]) / float64(binomial[deck][eval.CARDS_PER_HAND])
+	for _, k := range []int{3, 4} {
+		var total int64
+		for _, sum := range g.sums[k] {
+			total += max(sum, 0)
+		}
+		draws := binomial[deck-k][eval.CARDS_PER_HAND-k]
+		ev += float64(total) / float64(draws) / float64(binomial[deck][k])
+	}
+	return -ev
+}
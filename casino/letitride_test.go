@@ -0,0 +1,81 @@
+package casino
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+)
+
+func letItRide(t *testing.T) *LetItRide {
+	t.Helper()
+	g, err := NewLetItRide(LetItRideStandard())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestLetItRide(t *testing.T) {
+	g := letItRide(t)
+
+	rides := []struct {
+		cards    string
+		expected bool
+	}{
+		{"10♡ 10♧ 4♤", true},
+		{"9♡ 9♧ 4♤", false},
+		{"J♡ Q♡ K♡", true},
+		{"2♡ 7♧ 9♤", false},
+		{"2♡ 5♡ 9♡ K♡", true},
+		{"5♧ 6♡ 7♤ 8♢", false},
+		{"3♡ 3♧ 8♤ 8♢", true},
+	}
+	for _, tc := range rides {
+		got, err := g.Ride(mustParseCards(t, tc.cards))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.expected {
+			t.Errorf("%s: expected ride %t", tc.cards, tc.expected)
+		}
+	}
+	for _, cards := range [][]card.Card{
+		mustParseCards(t, "10♡ 10♧"),
+		{card.New(card.TEN, card.HEARTS), card.New(card.TEN, card.CLUBS), card.New(card.TEN, card.HEARTS)},
+	} {
+		if _, err := g.Ride(cards); err == nil {
+			t.Errorf("expected error for %s", card.Format(cards))
+		}
+	}
+
+	settles := []struct {
+		cards    string
+		riding   int
+		expected int
+	}{
+		{"J♡ J♧ 2♤ 5♢ 9♧", 3, 3},
+		{"9♡ 9♧ 2♤ 5♢ J♧", 2, -2},
+		{"A♧ K♧ Q♧ J♧ 10♧", 1, 1000},
+		{"4♡ 4♧ 4♤ 9♢ 9♧", 3, 33},
+	}
+	for _, tc := range settles {
+		got, err := g.Settle(mustParseCards(t, tc.cards), tc.riding)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.expected {
+			t.Errorf("%s with %d riding: expected %d, got %d", tc.cards, tc.riding, tc.expected, got)
+		}
+	}
+	if _, err := g.Settle(mustParseCards(t, "J♡ J♧ 2♤ 5♢ 9♧"), 4); err == nil {
+		t.Error("expected error for four bets")
+	}
+}
+
+// The published house edge of the standard table.
+func TestLetItRideHouseEdge(t *testing.T) {
+	if got := letItRide(t).HouseEdge(); math.Abs(got-0.035057) > 1e-6 {
+		t.Errorf("expected 0.035057, got %f", got)
+	}
+}
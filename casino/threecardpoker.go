@@ -0,0 +1,193 @@
+package casino
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+	"github.com/sdeboni/go-poker/internal/deal"
+)
+
+// ThreeCardValue orders three-card hands as Three Card Poker does: with three
+// cards a straight is rarer than a flush and beats it. A greater value is a
+// better hand. The category sits above bit 12, followed by the made rank and
+// kickers in four bits each.
+type ThreeCardValue uint16
+
+// threeCardOrder lists the Three Card Poker categories from worst to best.
+var threeCardOrder = [...]eval.HandRank{eval.HIGH_CARD, eval.PAIR, eval.FLUSH, eval.STRAIGHT, eval.THREE_OF_A_KIND, eval.STRAIGHT_FLUSH}
+
+func (v ThreeCardValue) Rank() eval.HandRank {
+	return threeCardOrder[v>>12]
+}
+
+func (v ThreeCardValue) Compare(other ThreeCardValue) int {
+	return cmp.Compare(v, other)
+}
+
+func newThreeCardValue(rank eval.HandRank, ranks ...card.Rank) ThreeCardValue {
+	v := ThreeCardValue(slices.Index(threeCardOrder[:], rank)) << 12
+	for i, r := range ranks {
+		v |= ThreeCardValue(r) << (8 - 4*i)
+	}
+	return v
+}
+
+// EvaluateThreeCards scores three distinct cards for Three Card Poker, where
+// A-2-3 is the lowest straight.
+func EvaluateThreeCards(cards [3]card.Card) ThreeCardValue {
+	flush := cards[0].Suit() == cards[1].Suit() && cards[1].Suit() == cards[2].Suit()
+	return threeCardValue([3]card.Rank{cards[0].Rank(), cards[1].Rank(), cards[2].Rank()}, flush)
+}
+
+func threeCardValue(ranks [3]card.Rank, flush bool) ThreeCardValue {
+	slices.SortFunc(ranks[:], func(a, b card.Rank) int { return cmp.Compare(b, a) })
+	high, middle, low := ranks[0], ranks[1], ranks[2]
+	wheel := high == card.ACE && middle == card.THREE && low == card.TWO
+	straight := high == middle+1 && middle == low+1 || wheel
+	if wheel {
+		high = card.THREE
+	}
+	switch {
+	case straight && flush:
+		return newThreeCardValue(eval.STRAIGHT_FLUSH, high)
+	case high == low:
+		return newThreeCardValue(eval.THREE_OF_A_KIND, high)
+	case straight:
+		return newThreeCardValue(eval.STRAIGHT, high)
+	case flush:
+		return newThreeCardValue(eval.FLUSH, high, middle, low)
+	case high == middle:
+		return newThreeCardValue(eval.PAIR, high, low)
+	case middle == low:
+		return newThreeCardValue(eval.PAIR, middle, high)
+	default:
+		return newThreeCardValue(eval.HIGH_CARD, high, middle, low)
+	}
+}
+
+// threeCardIndexValue scores three cards given as indexes.
+func threeCardIndexValue(cards [3]int) ThreeCardValue {
+	flush := cards[0]%4 == cards[1]%4 && cards[1]%4 == cards[2]%4
+	return threeCardValue([3]card.Rank{card.TWO + card.Rank(cards[0]/4), card.TWO + card.Rank(cards[1]/4), card.TWO + card.Rank(cards[2]/4)}, flush)
+}
+
+// threeCardQualifier is below every queen-high hand and above every lower one.
+var threeCardQualifier = newThreeCardValue(eval.HIGH_CARD, card.QUEEN)
+
+// ThreeCardPoker is a Three Card Poker pay table. The player antes and sees
+// three cards, then folds or makes a play bet equal to the ante. A dealer
+// below queen high does not qualify: the ante wins and the play bet pushes.
+// Otherwise the better hand wins both bets even money, and a tie pushes.
+// Pays are to one, by the player's hand whatever the dealer holds.
+type ThreeCardPoker struct {
+	Name string
+	// AnteBonus pays on the ante when the player plays, win or lose.
+	AnteBonus map[eval.HandRank]int
+	// PairPlus is the optional side bet; it loses below a pair.
+	PairPlus map[eval.HandRank]int
+}
+
+func ThreeCardPokerStandard() ThreeCardPoker {
+	return ThreeCardPoker{
+		Name: "Three Card Poker 1-4-5, Pair Plus 1-4-6-30-40",
+		AnteBonus: map[eval.HandRank]int{
+			eval.STRAIGHT_FLUSH:  5,
+			eval.THREE_OF_A_KIND: 4,
+			eval.STRAIGHT:        1,
+		},
+		PairPlus: map[eval.HandRank]int{
+			eval.STRAIGHT_FLUSH:  40,
+			eval.THREE_OF_A_KIND: 30,
+			eval.STRAIGHT:        6,
+			eval.FLUSH:           4,
+			eval.PAIR:            1,
+		},
+	}
+}
+
+type ThreeCardPokerResult struct {
+	// Ante and Play are in units of the ante, Play zero when the player
+	// folds; PairPlus is in units of the side bet.
+	Ante, Play, PairPlus int
+}
+
+func (g ThreeCardPoker) Settle(player, dealer [3]card.Card, play bool) (ThreeCardPokerResult, error) {
+	if err := deal.Distinct(append(player[:], dealer[:]...)...); err != nil {
+		return ThreeCardPokerResult{}, err
+	}
+	p, d := EvaluateThreeCards(player), EvaluateThreeCards(dealer)
+	result := ThreeCardPokerResult{PairPlus: g.pairPlus(p)}
+	if !play {
+		result.Ante = -1
+		return result, nil
+	}
+	result.Ante = g.AnteBonus[p.Rank()]
+	switch {
+	case d < threeCardQualifier:
+		result.Ante++
+	case p > d:
+		result.Ante++
+		result.Play++
+	case p < d:
+		result.Ante--
+		result.Play--
+	}
+	return result, nil
+}
+
+func (g ThreeCardPoker) pairPlus(v ThreeCardValue) int {
+	if pay, ok := g.PairPlus[v.Rank()]; ok {
+		return pay
+	}
+	return -1
+}
+
+// HouseEdge enumerates every deal to return the expected loss on the ante,
+// playing exactly when the play bet gains more than folding saves, which is
+// with queen-six-four or better, and on the Pair Plus bet.
+func (g ThreeCardPoker) HouseEdge() (ante, pairPlus float64) {
+	type hand struct {
+		set   uint64
+		value ThreeCardValue
+	}
+	type class struct {
+		hand  hand
+		count int64
+	}
+	var hands []hand
+	classes := make(map[uint64]*class)
+	var cards [3]int
+	deal.ForEachCombination(card.DECK_SIZE, cards[:], func() {
+		h := hand{1<<cards[0] | 1<<cards[1] | 1<<cards[2], threeCardIndexValue(cards)}
+		hands = append(hands, h)
+		key := suitIsomorphismKey(cards[:])
+		if c, ok := classes[key]; ok {
+			c.count++
+		} else {
+			classes[key] = &class{h, 1}
+		}
+	})
+
+	dealerHands := float64(binomial[card.DECK_SIZE-3][3])
+	for _, c := range classes {
+		p := c.hand
+		var play int64
+		for _, d := range hands {
+			switch {
+			case d.set&p.set != 0:
+			case d.value < threeCardQualifier:
+				play++
+			case p.value > d.value:
+				play += 2
+			case p.value < d.value:
+				play -= 2
+			}
+		}
+		ev := max(float64(play)/dealerHands+float64(g.AnteBonus[p.value.Rank()]), -1)
+		ante -= float64(c.count) * ev
+		pairPlus -= float64(c.count) * float64(g.pairPlus(p.value))
+	}
+	return ante / float64(len(hands)), pairPlus / float64(len(hands))
+}
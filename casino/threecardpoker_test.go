@@ -0,0 +1,91 @@
+package casino
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+func threeCards(t *testing.T, str string) [3]card.Card {
+	t.Helper()
+	return [3]card.Card(mustParseCards(t, str))
+}
+
+func TestEvaluateThreeCards(t *testing.T) {
+	// From best to worst.
+	hands := []struct {
+		cards string
+		rank  eval.HandRank
+	}{
+		{"A♡ K♡ Q♡", eval.STRAIGHT_FLUSH},
+		{"3♧ 2♧ A♧", eval.STRAIGHT_FLUSH},
+		{"2♡ 2♧ 2♤", eval.THREE_OF_A_KIND},
+		{"A♡ K♧ Q♤", eval.STRAIGHT},
+		{"3♡ 2♧ A♤", eval.STRAIGHT},
+		{"A♡ 9♡ 4♡", eval.FLUSH},
+		{"K♡ 10♡ 4♡", eval.FLUSH},
+		{"A♡ A♧ 2♤", eval.PAIR},
+		{"K♡ K♧ A♤", eval.PAIR},
+		{"A♡ K♧ J♤", eval.HIGH_CARD},
+		{"Q♡ 3♧ 2♤", eval.HIGH_CARD},
+		{"J♡ 10♧ 8♤", eval.HIGH_CARD},
+	}
+
+	var previous ThreeCardValue
+	for i, h := range hands {
+		v := EvaluateThreeCards(threeCards(t, h.cards))
+		if v.Rank() != h.rank {
+			t.Errorf("%s: expected %s, got %s", h.cards, h.rank, v.Rank())
+		}
+		if i > 0 && v.Compare(previous) >= 0 {
+			t.Errorf("expected %s to lose to %s", h.cards, hands[i-1].cards)
+		}
+		previous = v
+	}
+	if EvaluateThreeCards(threeCards(t, "Q♡ 3♧ 2♤")) < threeCardQualifier || EvaluateThreeCards(threeCards(t, "J♡ 10♧ 8♤")) > threeCardQualifier {
+		t.Error("expected the dealer to qualify with queen high")
+	}
+}
+
+func TestThreeCardPokerSettle(t *testing.T) {
+	cases := []struct {
+		description    string
+		player, dealer string
+		play           bool
+		expected       ThreeCardPokerResult
+	}{
+		{"fold", "7♡ 4♧ 2♤", "Q♡ 3♧ 2♢", false, ThreeCardPokerResult{-1, 0, -1}},
+		{"dealer does not qualify", "7♡ 4♧ 2♤", "J♡ 3♧ 2♢", true, ThreeCardPokerResult{1, 0, -1}},
+		{"player wins", "K♡ K♧ 2♤", "Q♡ 3♧ 2♢", true, ThreeCardPokerResult{1, 1, 1}},
+		{"player loses with a straight", "4♡ 5♧ 6♤", "9♡ 9♧ 9♢", true, ThreeCardPokerResult{0, -1, 6}},
+		{"tie", "Q♡ 7♧ 3♤", "Q♢ 7♤ 3♧", true, ThreeCardPokerResult{0, 0, -1}},
+		{"straight flush", "J♤ Q♤ K♤", "A♡ A♧ 4♢", true, ThreeCardPokerResult{6, 1, 40}},
+	}
+
+	g := ThreeCardPokerStandard()
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			got, err := g.Settle(threeCards(t, tc.player), threeCards(t, tc.dealer), tc.play)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+
+	if _, err := g.Settle(threeCards(t, "J♤ Q♤ K♤"), threeCards(t, "A♡ K♤ 4♢"), true); err == nil {
+		t.Error("expected error for a card dealt twice")
+	}
+}
+
+// Published house edges for the 1-4-5 ante bonus and 1-4-6-30-40 Pair Plus.
+func TestThreeCardPokerHouseEdge(t *testing.T) {
+	ante, pairPlus := ThreeCardPokerStandard().HouseEdge()
+	if math.Abs(ante-0.033730) > 1e-6 || math.Abs(pairPlus-0.023167) > 1e-6 {
+		t.Errorf("expected 0.033730 and 0.023167, got %f and %f", ante, pairPlus)
+	}
+}
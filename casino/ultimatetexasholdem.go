@@ -0,0 +1,435 @@
+package casino
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+	"github.com/sdeboni/go-poker/holdem"
+	"github.com/sdeboni/go-poker/internal/deal"
+	"github.com/sdeboni/go-poker/internal/suitclass"
+)
+
+// UltimateTexasHoldem is an Ultimate Texas Hold'em pay table. The player
+// makes equal ante and blind bets and is dealt two cards, as is the dealer,
+// with a five-card board to come. The player may bet three or four antes
+// before the flop, two on the flop, or one on the river, and otherwise folds.
+// Both make their best hand of seven cards. A dealer below a pair does not
+// qualify and the ante pushes. The better hand wins the ante and the play bet
+// even money and the blind by the pay table, which pushes below a straight;
+// a tie pushes everything.
+type UltimateTexasHoldem struct {
+	Name string
+	// Blind pays to one on winning hands; it pushes for hands not listed.
+	Blind map[PayLine]float64
+	// Trips is the optional side bet, paid to one on the player's hand
+	// whatever the dealer holds; it loses for hands not listed.
+	Trips map[PayLine]int
+}
+
+func UltimateTexasHoldemStandard() UltimateTexasHoldem {
+	return UltimateTexasHoldem{
+		Name: "Ultimate Texas Hold'em",
+		Blind: map[PayLine]float64{
+			{eval.STRAIGHT_FLUSH, ROYAL_FLUSH}: 500,
+			{eval.STRAIGHT_FLUSH, NO_BONUS}:    50,
+			{eval.FOUR_OF_A_KIND, NO_BONUS}:    10,
+			{eval.FULL_HOUSE, NO_BONUS}:        3,
+			{eval.FLUSH, NO_BONUS}:             1.5,
+			{eval.STRAIGHT, NO_BONUS}:          1,
+		},
+		Trips: map[PayLine]int{
+			{eval.STRAIGHT_FLUSH, ROYAL_FLUSH}: 50,
+			{eval.STRAIGHT_FLUSH, NO_BONUS}:    40,
+			{eval.FOUR_OF_A_KIND, NO_BONUS}:    30,
+			{eval.FULL_HOUSE, NO_BONUS}:        9,
+			{eval.FLUSH, NO_BONUS}:             7,
+			{eval.STRAIGHT, NO_BONUS}:          4,
+			{eval.THREE_OF_A_KIND, NO_BONUS}:   3,
+		},
+	}
+}
+
+type UltimateTexasHoldemResult struct {
+	// Ante, Blind and Play are in units of the ante, Trips in units of the
+	// side bet.
+	Ante, Blind, Play, Trips float64
+}
+
+// Settle settles a deal in which the player bet play antes, 0 for a fold.
+func (g UltimateTexasHoldem) Settle(player, dealer holdem.HoleCards, board []card.Card, play int) (UltimateTexasHoldemResult, error) {
+	if len(board) != holdem.BOARD_SIZE {
+		return UltimateTexasHoldemResult{}, fmt.Errorf("invalid board: %d cards", len(board))
+	}
+	if play < 0 || play > 4 {
+		return UltimateTexasHoldemResult{}, fmt.Errorf("invalid play bet: %d antes", play)
+	}
+	if err := deal.Distinct(append(append(player[:], dealer[:]...), board...)...); err != nil {
+		return UltimateTexasHoldemResult{}, err
+	}
+	b := [holdem.BOARD_SIZE]card.Card(board)
+	p := eval.Evaluate7([7]card.Card{player[0], player[1], b[0], b[1], b[2], b[3], b[4]})
+	d := eval.Evaluate7([7]card.Card{dealer[0], dealer[1], b[0], b[1], b[2], b[3], b[4]})
+	return g.settle(p, d, play), nil
+}
+
+func (g UltimateTexasHoldem) settle(p, d eval.HandValue, play int) UltimateTexasHoldemResult {
+	result := UltimateTexasHoldemResult{Trips: g.trips(p)}
+	if play == 0 {
+		result.Ante, result.Blind = -1, -1
+		return result
+	}
+	qualifies := d.Rank() > eval.HIGH_CARD
+	switch {
+	case p > d:
+		if qualifies {
+			result.Ante = 1
+		}
+		result.Blind = g.Blind[payLine(p)]
+		result.Play = float64(play)
+	case p < d:
+		if qualifies {
+			result.Ante = -1
+		}
+		result.Blind = -1
+		result.Play = -float64(play)
+	}
+	return result
+}
+
+func (g UltimateTexasHoldem) trips(v eval.HandValue) float64 {
+	if pay, ok := g.Trips[payLine(v)]; ok {
+		return float64(pay)
+	}
+	return -1
+}
+
+// payLine tells royal flushes from other straight flushes.
+func payLine(v eval.HandValue) PayLine {
+	if v.Rank() == eval.STRAIGHT_FLUSH && v.Top() == card.ACE {
+		return PayLine{eval.STRAIGHT_FLUSH, ROYAL_FLUSH}
+	}
+	return PayLine{v.Rank(), NO_BONUS}
+}
+
+// BasicStrategy returns the play bet, in antes, for the player's hole cards
+// and the board shown so far, 0 to check or fold. Before the flop it bets
+// four antes with any ace, pairs of threes or better, suited kings, and
+// offsuit kings, queens and jacks with kickers of at least five, eight and
+// ten, suited queens and jacks with at least six and eight. On the flop it
+// bets two antes with two pair or better, a pair using a hole card other
+// than pocket deuces, or four to a flush holding a hidden ten or better of
+// the suit. On the river it bets when that is expected to lose less than
+// folding, counting every hand the dealer may hold.
+func (g UltimateTexasHoldem) BasicStrategy(hole holdem.HoleCards, board []card.Card) int {
+	high, low := hole[0], hole[1]
+	if high.Rank() < low.Rank() {
+		high, low = low, high
+	}
+	suited := high.Suit() == low.Suit()
+
+	switch len(board) {
+	case 0:
+		var raise bool
+		switch {
+		case high.Rank() == low.Rank():
+			raise = high.Rank() >= card.THREE
+		case high.Rank() == card.ACE:
+			raise = true
+		case high.Rank() == card.KING:
+			raise = suited || low.Rank() >= card.FIVE
+		case high.Rank() == card.QUEEN:
+			raise = low.Rank() >= card.EIGHT || suited && low.Rank() >= card.SIX
+		case high.Rank() == card.JACK:
+			raise = low.Rank() >= card.TEN || suited && low.Rank() >= card.EIGHT
+		}
+		if raise {
+			return 4
+		}
+	case 3:
+		if flopBet(hole, board) {
+			return 2
+		}
+	case holdem.BOARD_SIZE:
+		if g.riverEV(hole, board) > -2 {
+			return 1
+		}
+	}
+	return 0
+}
+
+// flopBet is the flop rule of BasicStrategy.
+func flopBet(hole holdem.HoleCards, flop []card.Card) bool {
+	if hole[0].Rank() == hole[1].Rank() && hole[0].Rank() > card.TWO {
+		return true
+	}
+	var suitMasks [card.DIAMONDS + 1]uint16
+	var flopRanks uint16
+	for _, c := range flop {
+		suitMasks[c.Suit()] |= 1 << c.Rank()
+		flopRanks |= 1 << c.Rank()
+	}
+	for _, h := range hole {
+		if flopRanks&(1<<h.Rank()) != 0 {
+			return true
+		}
+		suitMasks[h.Suit()] |= 1 << h.Rank()
+	}
+	if eval.EvaluateMasks(&suitMasks).Rank() >= eval.TWO_PAIR {
+		return true
+	}
+	for _, h := range hole {
+		if h.Rank() >= card.TEN && bits.OnesCount16(suitMasks[h.Suit()]) >= 4 {
+			return true
+		}
+	}
+	return false
+}
+
+// riverEV is the expected result, in antes, of betting one ante on the river
+// over the dealer's possible hole cards.
+func (g UltimateTexasHoldem) riverEV(hole holdem.HoleCards, board []card.Card) float64 {
+	seen := eval.NewCardSet(append(hole[:], board...)...)
+	var live []card.Card
+	for i := range card.DECK_SIZE {
+		if c, _ := card.FromIndex(i); !seen.Contains(c) {
+			live = append(live, c)
+		}
+	}
+	var boardMasks [card.DIAMONDS + 1]uint16
+	for _, c := range board {
+		boardMasks[c.Suit()] |= 1 << c.Rank()
+	}
+	masks := boardMasks
+	for _, c := range hole {
+		masks[c.Suit()] |= 1 << c.Rank()
+	}
+	p := eval.EvaluateMasks(&masks)
+
+	var total float64
+	var deals int
+	for i, a := range live {
+		for _, c := range live[i+1:] {
+			masks := boardMasks
+			masks[a.Suit()] |= 1 << a.Rank()
+			masks[c.Suit()] |= 1 << c.Rank()
+			r := g.settle(p, eval.EvaluateMasks(&masks), 1)
+			total += r.Ante + r.Blind + r.Play
+			deals++
+		}
+	}
+	return total / float64(deals)
+}
+
+// HouseEdge enumerates every deal to return the expected loss per ante with
+// BasicStrategy. Each board is dealt once, by suit class, and every holding
+// is ranked against the dealer's holdings on it: a holding's dealer hands are
+// all those ranked on the board less the ones sharing one of its cards.
+func (g UltimateTexasHoldem) HouseEdge() float64 {
+	// Whether to bet before the flop depends on the hole cards alone.
+	var preflop [card.DECK_SIZE][card.DECK_SIZE]bool
+	for i := range card.DECK_SIZE {
+		for j := range card.DECK_SIZE {
+			a, _ := card.FromIndex(i)
+			b, _ := card.FromIndex(j)
+			preflop[i][j] = i != j && g.BasicStrategy(holdem.HoleCards{a, b}, nil) > 0
+		}
+	}
+
+	// flopBet is cached by flop and hole cards, a bit each, as the flops
+	// recur on many boards. Cards in index order give them an index.
+	const holes = card.DECK_SIZE * (card.DECK_SIZE - 1) / 2
+	flopSeen := make([]uint64, (card.DECK_SIZE*(card.DECK_SIZE-1)*(card.DECK_SIZE-2)/6*holes+63)/64)
+	flopBets := make([]uint64, len(flopSeen))
+
+	// blinds holds the Blind pays by rank, but for the royal flush.
+	var blinds [eval.STRAIGHT_FLUSH + 1]float64
+	for r := eval.HIGH_CARD; r <= eval.STRAIGHT_FLUSH; r++ {
+		blinds[r] = g.Blind[PayLine{r, NO_BONUS}]
+	}
+	royal := g.Blind[PayLine{eval.STRAIGHT_FLUSH, ROYAL_FLUSH}]
+
+	const live = card.DECK_SIZE - holdem.BOARD_SIZE
+	const dealers = (live - 2) * (live - 3) / 2
+	// tally counts the holdings ranked below one, those of them the dealer
+	// would qualify with, and those ranked equal.
+	type tally struct{ below, qualifying, equal int }
+	type holding struct {
+		hole  holdem.HoleCards
+		index int
+		a, b  int
+		value eval.HandValue
+		// tallies are among all holdings, then those holding a and b.
+		tallies [3]tally
+		// Without a bet before the flop, flopped is the result of betting
+		// on the flop and checked that of playing on to the river.
+		raise            bool
+		flopped, checked float64
+	}
+	var holdings []holding
+	for a := range live {
+		for b := a + 1; b < live; b++ {
+			holdings = append(holdings, holding{a: a, b: b})
+		}
+	}
+	keys := make([]uint64, len(holdings))
+	order := make([]int, len(holdings))
+	var byCard [live][]int
+	// qualifying counts the qualifying holdings, among all and by card.
+	var qualifying [live + 1]int
+
+	// rank tallies the holdings, given in order of value, into slot 0, or
+	// the slot of card when it is not negative, and counts those qualifying.
+	rank := func(held []int, card int) int {
+		below, qualified := 0, 0
+		for start := 0; start < len(held); {
+			value := holdings[held[start]].value
+			end := start + 1
+			for end < len(held) && holdings[held[end]].value == value {
+				end++
+			}
+			for _, i := range held[start:end] {
+				slot := 0
+				if card >= 0 {
+					slot = 1
+					if holdings[i].b == card {
+						slot = 2
+					}
+				}
+				holdings[i].tallies[slot] = tally{below, qualified, end - start}
+			}
+			below += end - start
+			if value.Rank() > eval.HIGH_CARD {
+				qualified += end - start
+			}
+			start = end
+		}
+		return qualified
+	}
+
+	var total float64
+	var deals int64
+	suitclass.ForEach(holdem.BOARD_SIZE, card.TWO, func(boardMasks *[card.DIAMONDS + 1]uint16, weight int64) {
+		var board, cards []card.Card
+		for i := range card.DECK_SIZE {
+			c, _ := card.FromIndex(i)
+			if boardMasks[c.Suit()]&(1<<c.Rank()) != 0 {
+				board = append(board, c)
+			} else {
+				cards = append(cards, c)
+			}
+		}
+		// The flop is any three of the board.
+		type flop struct {
+			cards [3]card.Card
+			index int
+		}
+		var flops []flop
+		for i := range board {
+			for j := i + 1; j < len(board); j++ {
+				for k := j + 1; k < len(board); k++ {
+					x, y, z := board[i].Index(), board[j].Index(), board[k].Index()
+					flops = append(flops, flop{[3]card.Card{board[i], board[j], board[k]}, z*(z-1)*(z-2)/6 + y*(y-1)/2 + x})
+				}
+			}
+		}
+
+		for i := range holdings {
+			h := &holdings[i]
+			h.hole = holdem.HoleCards{cards[h.a], cards[h.b]}
+			x, y := h.hole[0].Index(), h.hole[1].Index()
+			h.index = y*(y-1)/2 + x
+			masks := *boardMasks
+			for _, c := range h.hole {
+				masks[c.Suit()] |= 1 << c.Rank()
+			}
+			h.value = eval.EvaluateMasks(&masks)
+			keys[i] = uint64(h.value)<<16 | uint64(i)
+		}
+		slices.Sort(keys)
+		for c := range byCard {
+			byCard[c] = byCard[c][:0]
+		}
+		for i, key := range keys {
+			order[i] = int(key & 0xffff)
+			h := &holdings[order[i]]
+			byCard[h.a] = append(byCard[h.a], order[i])
+			byCard[h.b] = append(byCard[h.b], order[i])
+		}
+		qualifying[live] = rank(order, -1)
+		for c, held := range byCard {
+			qualifying[c] = rank(held, c)
+		}
+
+		var sum float64
+		for i := range holdings {
+			h := &holdings[i]
+			all, a, b := h.tallies[0], h.tallies[1], h.tallies[2]
+			below := all.below - a.below - b.below
+			qualifiedBelow := all.qualifying - a.qualifying - b.qualifying
+			equal := all.equal - a.equal - b.equal + 1
+			qualified := qualifying[live] - qualifying[h.a] - qualifying[h.b]
+			if h.value.Rank() > eval.HIGH_CARD {
+				// The holding itself and the dealer's equal to it qualify.
+				qualified += 1 - equal
+			}
+			above, qualifiedAbove := dealers-below-equal, qualified-qualifiedBelow
+			blind := blinds[h.value.Rank()]
+			if payLine(h.value).Bonus == ROYAL_FLUSH {
+				blind = royal
+			}
+			// ev is the expected result of betting play antes.
+			ev := func(play int) float64 {
+				won := float64(qualifiedBelow) + float64(below)*(blind+float64(play))
+				lost := float64(qualifiedAbove) + float64(above)*float64(1+play)
+				return (won - lost) / dealers
+			}
+
+			h.raise = preflop[h.hole[0].Index()][h.hole[1].Index()]
+			if h.raise {
+				sum += ev(4)
+			} else {
+				h.flopped, h.checked = ev(2), max(ev(1), -2)
+			}
+		}
+		var later float64
+		for _, f := range flops {
+			for _, h := range holdings {
+				if h.raise {
+					continue
+				}
+				bit := f.index*holes + h.index
+				word, mask := bit/64, uint64(1)<<(bit%64)
+				if flopSeen[word]&mask == 0 {
+					flopSeen[word] |= mask
+					if flopBet(h.hole, f.cards[:]) {
+						flopBets[word] |= mask
+					}
+				}
+				if flopBets[word]&mask != 0 {
+					later += h.flopped
+				} else {
+					later += h.checked
+				}
+			}
+		}
+		sum += later / float64(len(flops))
+		total += float64(weight) * sum
+		deals += weight * int64(len(holdings))
+	})
+	return -total / float64(deals)
+}
+
+// TripsHouseEdge enumerates every seven-card hand to return the expected
+// loss on the Trips bet.
+func (g UltimateTexasHoldem) TripsHouseEdge() float64 {
+	var total float64
+	var hands int64
+	suitclass.ForEach(2+holdem.BOARD_SIZE, card.TWO, func(suitMasks *[card.DIAMONDS + 1]uint16, count int64) {
+		total += float64(count) * g.trips(eval.EvaluateMasks(suitMasks))
+		hands += count
+	})
+	return -total / float64(hands)
+}
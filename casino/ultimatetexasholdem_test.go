@@ -0,0 +1,107 @@
+package casino
+
+import (
+	"flag"
+	"math"
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/holdem"
+)
+
+var houseEdges = flag.Bool("house-edges", false, "enumerate the Ultimate Texas Hold'em and Caribbean Stud house edges, which takes about a minute")
+
+func mustHoleCards(t *testing.T, str string) holdem.HoleCards {
+	t.Helper()
+	hole, err := holdem.ParseHoleCards(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hole
+}
+
+func TestUltimateTexasHoldemSettle(t *testing.T) {
+	cases := []struct {
+		description           string
+		player, dealer, board string
+		play                  int
+		expected              UltimateTexasHoldemResult
+	}{
+		{"fold", "A♡ K♧", "2♤ 7♢", "3♡ 9♧ J♤ 4♢ 5♧", 0, UltimateTexasHoldemResult{-1, -1, 0, -1}},
+		{"flush beats a qualifying dealer", "A♡ K♡", "J♧ J♢", "3♡ 9♡ J♤ 4♡ 8♧", 4, UltimateTexasHoldemResult{1, 1.5, 4, 7}},
+		{"win against a dealer who does not qualify", "A♡ K♧", "2♤ 7♢", "3♡ 9♧ J♤ 4♢ 5♧", 1, UltimateTexasHoldemResult{0, 0, 1, -1}},
+		{"lose to a dealer who does not qualify", "8♡ 6♧", "A♤ 7♢", "3♡ 9♧ J♤ 4♢ K♧", 1, UltimateTexasHoldemResult{0, -1, -1, -1}},
+		{"lose", "A♡ K♧", "9♤ 7♢", "3♡ 9♧ J♤ 4♢ 5♧", 2, UltimateTexasHoldemResult{-1, -1, -2, -1}},
+		{"board plays", "2♡ 3♧", "2♤ 3♢", "10♡ J♧ Q♤ K♢ A♧", 4, UltimateTexasHoldemResult{0, 0, 0, 4}},
+	}
+
+	g := UltimateTexasHoldemStandard()
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			got, err := g.Settle(mustHoleCards(t, tc.player), mustHoleCards(t, tc.dealer), mustParseCards(t, tc.board), tc.play)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+
+	if _, err := g.Settle(mustHoleCards(t, "A♡ K♧"), mustHoleCards(t, "A♡ 7♢"), mustParseCards(t, "3♡ 9♧ J♤ 4♢ 5♧"), 1); err == nil {
+		t.Error("expected error for a card dealt twice")
+	}
+	if _, err := g.Settle(mustHoleCards(t, "A♡ K♧"), mustHoleCards(t, "2♤ 7♢"), mustParseCards(t, "3♡ 9♧ J♤ 4♢ 5♧"), 5); err == nil {
+		t.Error("expected error for a play bet of five antes")
+	}
+}
+
+func TestUltimateTexasHoldemBasicStrategy(t *testing.T) {
+	cases := []struct {
+		hole, board string
+		expected    int
+	}{
+		{"A♡ 2♧", "", 4},
+		{"3♡ 3♧", "", 4},
+		{"2♡ 2♧", "", 0},
+		{"K♡ 4♧", "", 0},
+		{"Q♡ 6♡", "", 4},
+		{"J♡ 9♧", "", 0},
+		{"2♡ 2♧", "7♤ 9♢ K♧", 0},
+		{"9♡ 4♧", "4♤ 8♢ K♧", 2},
+		{"10♡ 4♧", "2♡ 8♡ K♡", 2},
+		{"9♡ 4♧", "2♡ 8♡ K♡", 0},
+		{"9♡ 4♧", "2♤ 2♢ 8♧ K♧ K♢", 0},
+		{"A♡ 4♧", "4♤ 8♢ K♧ 6♡ 2♢", 1},
+	}
+
+	g := UltimateTexasHoldemStandard()
+	for _, tc := range cases {
+		var board []card.Card
+		if tc.board != "" {
+			board = mustParseCards(t, tc.board)
+		}
+		if got := g.BasicStrategy(mustHoleCards(t, tc.hole), board); got != tc.expected {
+			t.Errorf("%s on %q: expected %d, got %d", tc.hole, tc.board, tc.expected, got)
+		}
+	}
+}
+
+func TestUltimateTexasHoldemHouseEdge(t *testing.T) {
+	g := UltimateTexasHoldemStandard()
+
+	// The Trips pays over the seven-card hand frequencies.
+	expected := 1 - (51*4324+41*37260+31*224848+10*3473184+8*4047644+5*6180020+4*6461620)/133784560.0
+	if got := g.TripsHouseEdge(); math.Abs(got-expected) > 1e-12 {
+		t.Errorf("expected Trips house edge %f, got %f", expected, got)
+	}
+
+	// About 2.19% with the best play; the basic strategy gives up a little
+	// more. Every deal is enumerated, which takes a while.
+	if !*houseEdges {
+		t.Skip("run with -house-edges to enumerate the house edge")
+	}
+	if got := g.HouseEdge(); math.Abs(got-0.023239) > 1e-6 {
+		t.Errorf("expected house edge 0.023239, got %f", got)
+	}
+}
@@ -0,0 +1,527 @@
+package casino
+
+import (
+	"cmp"
+	"fmt"
+	"math/bits"
+	"slices"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+	"github.com/sdeboni/go-poker/internal/deal"
+)
+
+// jokerIndex extends Card.Index to the joker.
+const jokerIndex = card.DECK_SIZE
+
+type WildCard int
+
+const (
+	NO_WILD WildCard = iota + 1
+	// DEUCES_WILD makes the four twos wild.
+	DEUCES_WILD
+	// JOKER_WILD adds JOKER, a wild card, to the deck.
+	JOKER_WILD
+)
+
+// PayBonus refines a HandRank for the paytables that pay some hands of a
+// rank differently.
+type PayBonus int
+
+const (
+	NO_BONUS PayBonus = iota
+	// ROYAL_FLUSH and WILD_ROYAL_FLUSH are ace-high straight flushes, made
+	// without and with wild cards.
+	ROYAL_FLUSH
+	WILD_ROYAL_FLUSH
+	// FIVE_OF_A_KIND and FOUR_DEUCES refine FOUR_OF_A_KIND in wild games.
+	FIVE_OF_A_KIND
+	FOUR_DEUCES
+	// FOUR_ACES and FOUR_TWOS_TO_FOURS refine FOUR_OF_A_KIND in bonus games,
+	// and Double Double Bonus pays them more with a kicker: a two, three or
+	// four with the aces, an ace, two, three or four with the low quads.
+	FOUR_ACES
+	FOUR_TWOS_TO_FOURS
+	FOUR_ACES_WITH_KICKER
+	FOUR_TWOS_TO_FOURS_WITH_KICKER
+)
+
+type PayLine struct {
+	Rank  eval.HandRank
+	Bonus PayBonus
+}
+
+// Paytable pays a final video poker hand. A hand pays the first line of
+// its rank present in Pays, from the most specific bonus to NO_BONUS, and
+// the best such line when wild cards allow several ranks. Pays are per coin
+// with the maximum bet, so a 4000 coin royal for five coins is 800.
+type Paytable struct {
+	Name string
+	Wild WildCard
+	// MinPair is the lowest pair that pays, when PAIR is in Pays.
+	MinPair card.Rank
+	Pays    map[PayLine]int
+}
+
+func JacksOrBetter96() Paytable {
+	return Paytable{"Jacks or Better 9/6", NO_WILD, card.JACK, map[PayLine]int{
+		{eval.STRAIGHT_FLUSH, ROYAL_FLUSH}: 800,
+		{eval.STRAIGHT_FLUSH, NO_BONUS}:    50,
+		{eval.FOUR_OF_A_KIND, NO_BONUS}:    25,
+		{eval.FULL_HOUSE, NO_BONUS}:        9,
+		{eval.FLUSH, NO_BONUS}:             6,
+		{eval.STRAIGHT, NO_BONUS}:          4,
+		{eval.THREE_OF_A_KIND, NO_BONUS}:   3,
+		{eval.TWO_PAIR, NO_BONUS}:          2,
+		{eval.PAIR, NO_BONUS}:              1,
+	}}
+}
+
+func BonusPoker85() Paytable {
+	return Paytable{"Bonus Poker 8/5", NO_WILD, card.JACK, map[PayLine]int{
+		{eval.STRAIGHT_FLUSH, ROYAL_FLUSH}:        800,
+		{eval.STRAIGHT_FLUSH, NO_BONUS}:           50,
+		{eval.FOUR_OF_A_KIND, FOUR_ACES}:          80,
+		{eval.FOUR_OF_A_KIND, FOUR_TWOS_TO_FOURS}: 40,
+		{eval.FOUR_OF_A_KIND, NO_BONUS}:           25,
+		{eval.FULL_HOUSE, NO_BONUS}:               8,
+		{eval.FLUSH, NO_BONUS}:                    5,
+		{eval.STRAIGHT, NO_BONUS}:                 4,
+		{eval.THREE_OF_A_KIND, NO_BONUS}:          3,
+		{eval.TWO_PAIR, NO_BONUS}:                 2,
+		{eval.PAIR, NO_BONUS}:                     1,
+	}}
+}
+
+func DoubleDoubleBonus96() Paytable {
+	return Paytable{"Double Double Bonus 9/6", NO_WILD, card.JACK, map[PayLine]int{
+		{eval.STRAIGHT_FLUSH, ROYAL_FLUSH}:                    800,
+		{eval.STRAIGHT_FLUSH, NO_BONUS}:                       50,
+		{eval.FOUR_OF_A_KIND, FOUR_ACES_WITH_KICKER}:          400,
+		{eval.FOUR_OF_A_KIND, FOUR_TWOS_TO_FOURS_WITH_KICKER}: 160,
+		{eval.FOUR_OF_A_KIND, FOUR_ACES}:                      160,
+		{eval.FOUR_OF_A_KIND, FOUR_TWOS_TO_FOURS}:             80,
+		{eval.FOUR_OF_A_KIND, NO_BONUS}:                       50,
+		{eval.FULL_HOUSE, NO_BONUS}:                           9,
+		{eval.FLUSH, NO_BONUS}:                                6,
+		{eval.STRAIGHT, NO_BONUS}:                             4,
+		{eval.THREE_OF_A_KIND, NO_BONUS}:                      3,
+		{eval.TWO_PAIR, NO_BONUS}:                             1,
+		{eval.PAIR, NO_BONUS}:                                 1,
+	}}
+}
+
+func DeucesWildFullPay() Paytable {
+	return Paytable{"Deuces Wild full pay", DEUCES_WILD, 0, map[PayLine]int{
+		{eval.STRAIGHT_FLUSH, ROYAL_FLUSH}:      800,
+		{eval.FOUR_OF_A_KIND, FOUR_DEUCES}:      200,
+		{eval.STRAIGHT_FLUSH, WILD_ROYAL_FLUSH}: 25,
+		{eval.FOUR_OF_A_KIND, FIVE_OF_A_KIND}:   15,
+		{eval.STRAIGHT_FLUSH, NO_BONUS}:         9,
+		{eval.FOUR_OF_A_KIND, NO_BONUS}:         5,
+		{eval.FULL_HOUSE, NO_BONUS}:             3,
+		{eval.FLUSH, NO_BONUS}:                  2,
+		{eval.STRAIGHT, NO_BONUS}:               2,
+		{eval.THREE_OF_A_KIND, NO_BONUS}:        1,
+	}}
+}
+
+func JokerPokerKingsOrBetter() Paytable {
+	return Paytable{"Joker Poker kings or better", JOKER_WILD, card.KING, map[PayLine]int{
+		{eval.STRAIGHT_FLUSH, ROYAL_FLUSH}:      800,
+		{eval.FOUR_OF_A_KIND, FIVE_OF_A_KIND}:   200,
+		{eval.STRAIGHT_FLUSH, WILD_ROYAL_FLUSH}: 100,
+		{eval.STRAIGHT_FLUSH, NO_BONUS}:         50,
+		{eval.FOUR_OF_A_KIND, NO_BONUS}:         20,
+		{eval.FULL_HOUSE, NO_BONUS}:             7,
+		{eval.FLUSH, NO_BONUS}:                  5,
+		{eval.STRAIGHT, NO_BONUS}:               3,
+		{eval.THREE_OF_A_KIND, NO_BONUS}:        2,
+		{eval.TWO_PAIR, NO_BONUS}:               1,
+		{eval.PAIR, NO_BONUS}:                   1,
+	}}
+}
+
+// Pay returns what a final five-card hand pays per coin.
+func (t Paytable) Pay(cards []card.Card) (int, error) {
+	pays, err := t.payArray()
+	if err != nil {
+		return 0, err
+	}
+	hand, err := t.indexes(cards)
+	if err != nil {
+		return 0, err
+	}
+	return pays.pay(t.Wild, t.MinPair, hand), nil
+}
+
+func (t Paytable) deckSize() int {
+	if t.Wild == JOKER_WILD {
+		return card.DECK_SIZE + 1
+	}
+	return card.DECK_SIZE
+}
+
+// indexes validates five distinct cards of the table's deck.
+func (t Paytable) indexes(cards []card.Card) ([eval.CARDS_PER_HAND]int, error) {
+	var hand [eval.CARDS_PER_HAND]int
+	if len(cards) != eval.CARDS_PER_HAND {
+		return hand, fmt.Errorf("expected %d cards, got %d", eval.CARDS_PER_HAND, len(cards))
+	}
+	var seen eval.CardSet
+	var jokers int
+	for i, c := range cards {
+		switch {
+		case c == card.JOKER && t.Wild == JOKER_WILD:
+			hand[i] = jokerIndex
+			jokers++
+			continue
+		case c.Rank() < card.TWO || c.Rank() > card.ACE || c.Suit() < card.HEARTS || c.Suit() > card.DIAMONDS:
+			return hand, fmt.Errorf("invalid card for %s: rank %d, suit %d", t.Name, c.Rank(), c.Suit())
+		case seen.Contains(c):
+			return hand, fmt.Errorf("card %s used more than once", c.String())
+		}
+		seen = seen.Add(c)
+		hand[i] = c.Index()
+	}
+	if jokers > 1 {
+		return hand, fmt.Errorf("joker used %d times", jokers)
+	}
+	return hand, nil
+}
+
+// payArray holds the pays by rank and bonus, -1 for lines not paid.
+type payArray [eval.STRAIGHT_FLUSH + 1][FOUR_TWOS_TO_FOURS_WITH_KICKER + 1]int
+
+func (t Paytable) payArray() (*payArray, error) {
+	if t.Wild < NO_WILD || t.Wild > JOKER_WILD {
+		return nil, fmt.Errorf("invalid wild card: %d", t.Wild)
+	}
+	var pays payArray
+	for i := range pays {
+		for j := range pays[i] {
+			pays[i][j] = -1
+		}
+	}
+	for line, pay := range t.Pays {
+		if line.Rank < eval.HIGH_CARD || line.Rank > eval.STRAIGHT_FLUSH || line.Bonus < NO_BONUS || line.Bonus > FOUR_TWOS_TO_FOURS_WITH_KICKER {
+			return nil, fmt.Errorf("invalid pay line: %v", line)
+		}
+		if pay < 0 {
+			return nil, fmt.Errorf("negative pay for %v: %d", line, pay)
+		}
+		pays[line.Rank][line.Bonus] = pay
+	}
+	return &pays, nil
+}
+
+// pay classifies a hand of card indexes without allocating.
+func (p *payArray) pay(wild WildCard, minPair card.Rank, hand [eval.CARDS_PER_HAND]int) int {
+	var counts [card.ACE + 1]int
+	var rankMask uint16
+	var suits uint8
+	var wilds int
+	for _, i := range hand {
+		rank := card.TWO + card.Rank(i/4)
+		if i == jokerIndex || (wild == DEUCES_WILD && rank == card.TWO) {
+			wilds++
+			continue
+		}
+		counts[rank]++
+		rankMask |= 1 << rank
+		suits |= 1 << (i % 4)
+	}
+
+	var most, second int
+	var mostRank card.Rank
+	for r := card.ACE; r >= card.TWO; r-- {
+		switch c := counts[r]; {
+		case c > most:
+			second, most, mostRank = most, c, r
+		case c > second:
+			second = c
+		}
+	}
+	flush := bits.OnesCount8(suits) <= 1
+	var straight card.Rank
+	if most <= 1 {
+		straight = straightWithWilds(rankMask)
+	}
+
+	best := 0
+	line := func(rank eval.HandRank, bonuses ...PayBonus) {
+		for _, bonus := range bonuses {
+			if pay := p[rank][bonus]; pay >= 0 {
+				best = max(best, pay)
+				return
+			}
+		}
+	}
+
+	if straight != 0 && flush {
+		switch {
+		case straight == card.ACE && wilds == 0:
+			line(eval.STRAIGHT_FLUSH, ROYAL_FLUSH, NO_BONUS)
+		case straight == card.ACE:
+			line(eval.STRAIGHT_FLUSH, WILD_ROYAL_FLUSH, NO_BONUS)
+		default:
+			line(eval.STRAIGHT_FLUSH, NO_BONUS)
+		}
+	}
+	if wild == DEUCES_WILD && wilds == 4 {
+		line(eval.FOUR_OF_A_KIND, FOUR_DEUCES, NO_BONUS)
+	}
+	if most+wilds >= 5 {
+		line(eval.FOUR_OF_A_KIND, FIVE_OF_A_KIND, NO_BONUS)
+	}
+	if most+wilds >= 4 {
+		kicker := card.Rank(bits.TrailingZeros16(rankMask &^ (1 << mostRank)))
+		switch {
+		case wilds > 0:
+			line(eval.FOUR_OF_A_KIND, NO_BONUS)
+		case mostRank == card.ACE && kicker <= card.FOUR:
+			line(eval.FOUR_OF_A_KIND, FOUR_ACES_WITH_KICKER, FOUR_ACES, NO_BONUS)
+		case mostRank == card.ACE:
+			line(eval.FOUR_OF_A_KIND, FOUR_ACES, NO_BONUS)
+		case mostRank <= card.FOUR && (kicker <= card.FOUR || kicker == card.ACE):
+			line(eval.FOUR_OF_A_KIND, FOUR_TWOS_TO_FOURS_WITH_KICKER, FOUR_TWOS_TO_FOURS, NO_BONUS)
+		case mostRank <= card.FOUR:
+			line(eval.FOUR_OF_A_KIND, FOUR_TWOS_TO_FOURS, NO_BONUS)
+		default:
+			line(eval.FOUR_OF_A_KIND, NO_BONUS)
+		}
+	}
+	if (most == 3 && second == 2) || (wilds == 1 && most == 2 && second == 2) {
+		line(eval.FULL_HOUSE, NO_BONUS)
+	}
+	if flush {
+		line(eval.FLUSH, NO_BONUS)
+	}
+	if straight != 0 {
+		line(eval.STRAIGHT, NO_BONUS)
+	}
+	if most+wilds >= 3 {
+		line(eval.THREE_OF_A_KIND, NO_BONUS)
+	}
+	if wilds == 0 && most == 2 && second == 2 {
+		line(eval.TWO_PAIR, NO_BONUS)
+	}
+	if most+wilds >= 2 {
+		pair := mostRank
+		if most < 2 {
+			pair = card.Rank(bits.Len16(rankMask) - 1)
+		}
+		if pair >= minPair {
+			line(eval.PAIR, NO_BONUS)
+		}
+	}
+	return best
+}
+
+// straightWithWilds returns the high card of the best straight that distinct
+// natural ranks make with wild cards filling the gaps, or 0 if there is none.
+func straightWithWilds(mask uint16) card.Rank {
+	for high := card.ACE; high >= card.FIVE; high-- {
+		m := mask
+		if high == card.FIVE && m&(1<<card.ACE) != 0 {
+			m = m&^(1<<card.ACE) | 1<<1
+		}
+		if m&^(uint16(0x1f)<<(high-4)) == 0 {
+			return high
+		}
+	}
+	return 0
+}
+
+// VideoPoker computes exact expected values for a paytable. Building one
+// scores every final hand once, which takes a moment; after that each dealt
+// hand is analysed in constant time.
+type VideoPoker struct {
+	table Paytable
+	deck  int
+	// sums[k][s] is the total pay of the final hands containing the k-subset
+	// s of the deck, s ranked in the combinatorial number system.
+	sums [eval.CARDS_PER_HAND + 1][]int64
+}
+
+type Hold struct {
+	// Cards are the held cards in dealt order.
+	Cards []card.Card
+	// Mask has bit i set when the i-th dealt card is held.
+	Mask uint8
+	// EV is the expected pay per coin.
+	EV float64
+}
+
+// binomial[n][k] for the deck sizes and hand size used here.
+var binomial = func() (b [card.DECK_SIZE + 2][eval.CARDS_PER_HAND + 1]int64) {
+	for n := range b {
+		b[n][0] = 1
+		for k := 1; k <= min(n, eval.CARDS_PER_HAND); k++ {
+			b[n][k] = b[n-1][k-1] + b[n-1][k]
+		}
+	}
+	return b
+}()
+
+func NewVideoPoker(table Paytable) (*VideoPoker, error) {
+	pays, err := table.payArray()
+	if err != nil {
+		return nil, err
+	}
+
+	v := &VideoPoker{table: table, deck: table.deckSize()}
+	v.sums = paySums(v.deck, func(hand *[eval.CARDS_PER_HAND]int) int {
+		return pays.pay(table.Wild, table.MinPair, *hand)
+	})
+	return v, nil
+}
+
+// paySums totals the pay of every five-card hand from a deck of the given
+// size into each of the hand's subsets: sums[k][s] is the total over the
+// hands containing the k-subset s, ranked by subsetRank.
+func paySums(deck int, pay func(hand *[eval.CARDS_PER_HAND]int) int) [eval.CARDS_PER_HAND + 1][]int64 {
+	var sums [eval.CARDS_PER_HAND + 1][]int64
+	for k := range sums {
+		sums[k] = make([]int64, binomial[deck][k])
+	}
+	var hand [eval.CARDS_PER_HAND]int
+	deal.ForEachCombination(deck, hand[:], func() {
+		if p := pay(&hand); p != 0 {
+			for mask := range 1 << eval.CARDS_PER_HAND {
+				k, rank := subsetRank(&hand, mask)
+				sums[k][rank] += int64(p)
+			}
+		}
+	})
+	return sums
+}
+
+// subsetRank ranks the cards of a sorted hand picked by mask.
+func subsetRank(hand *[eval.CARDS_PER_HAND]int, mask int) (int, int64) {
+	var k int
+	var rank int64
+	for i, c := range hand {
+		if mask&(1<<i) != 0 {
+			k++
+			rank += binomial[c][k]
+		}
+	}
+	return k, rank
+}
+
+// holdEVs fills evs[mask] with the expected pay of holding the cards of a
+// sorted hand picked by mask, counting over the draws from the rest of the
+// deck by inclusion-exclusion on the discarded cards.
+func (v *VideoPoker) holdEVs(hand *[eval.CARDS_PER_HAND]int, evs *[1 << eval.CARDS_PER_HAND]float64) {
+	var sums [1 << eval.CARDS_PER_HAND]int64
+	for mask := range sums {
+		k, rank := subsetRank(hand, mask)
+		sums[mask] = v.sums[k][rank]
+	}
+
+	const all = 1<<eval.CARDS_PER_HAND - 1
+	for held := range evs {
+		var total int64
+		discarded := all &^ held
+		for r := discarded; ; r = (r - 1) & discarded {
+			if bits.OnesCount(uint(r))%2 == 0 {
+				total += sums[held|r]
+			} else {
+				total -= sums[held|r]
+			}
+			if r == 0 {
+				break
+			}
+		}
+		draws := binomial[v.deck-eval.CARDS_PER_HAND][eval.CARDS_PER_HAND-bits.OnesCount(uint(held))]
+		evs[held] = float64(total) / float64(draws)
+	}
+}
+
+// Holds returns all 32 ways to play a dealt hand, best first.
+func (v *VideoPoker) Holds(dealt []card.Card) ([]Hold, error) {
+	hand, err := v.table.indexes(dealt)
+	if err != nil {
+		return nil, err
+	}
+	order := [eval.CARDS_PER_HAND]int{0, 1, 2, 3, 4}
+	slices.SortFunc(order[:], func(a, b int) int {
+		return cmp.Compare(hand[a], hand[b])
+	})
+	var sorted [eval.CARDS_PER_HAND]int
+	for i, j := range order {
+		sorted[i] = hand[j]
+	}
+
+	var evs [1 << eval.CARDS_PER_HAND]float64
+	v.holdEVs(&sorted, &evs)
+
+	holds := make([]Hold, 0, len(evs))
+	for mask := range evs {
+		h := Hold{EV: evs[mask]}
+		for i, j := range order {
+			if mask&(1<<i) != 0 {
+				h.Mask |= 1 << j
+			}
+		}
+		for i, c := range dealt {
+			if h.Mask&(1<<i) != 0 {
+				h.Cards = append(h.Cards, c)
+			}
+		}
+		holds = append(holds, h)
+	}
+	slices.SortStableFunc(holds, func(a, b Hold) int {
+		if c := cmp.Compare(b.EV, a.EV); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Mask, b.Mask)
+	})
+	return holds, nil
+}
+
+// ReturnToPlayer is the expected pay per coin bet when every dealt hand is
+// played with its best hold. Dealt hands identical up to suits are analysed
+// once.
+func (v *VideoPoker) ReturnToPlayer() float64 {
+	type class struct {
+		hand  [eval.CARDS_PER_HAND]int
+		count int64
+	}
+	classes := make(map[uint64]*class)
+
+	var hand [eval.CARDS_PER_HAND]int
+	deal.ForEachCombination(v.deck, hand[:], func() {
+		key := suitIsomorphismKey(hand[:])
+		if c, ok := classes[key]; ok {
+			c.count++
+		} else {
+			classes[key] = &class{hand, 1}
+		}
+	})
+
+	var total float64
+	var evs [1 << eval.CARDS_PER_HAND]float64
+	for _, c := range classes {
+		v.holdEVs(&c.hand, &evs)
+		total += float64(c.count) * slices.Max(evs[:])
+	}
+	return total / float64(binomial[v.deck][eval.CARDS_PER_HAND])
+}
+
+// suitIsomorphismKey packs the ranks held in each suit, sorted, with the
+// number of jokers.
+func suitIsomorphismKey(hand []int) uint64 {
+	var masks [4]uint64
+	var jokers uint64
+	for _, c := range hand {
+		if c == jokerIndex {
+			jokers++
+		} else {
+			masks[c%4] |= 1 << (c / 4)
+		}
+	}
+	slices.Sort(masks[:])
+	return jokers<<52 | masks[0]<<39 | masks[1]<<26 | masks[2]<<13 | masks[3]
+}
@@ -0,0 +1,117 @@
+package casino
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+)
+
+func mustParseCards(t testing.TB, str string) []card.Card {
+	t.Helper()
+	cards, err := card.ParseCards(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cards
+}
+
+func TestPaytablePay(t *testing.T) {
+	cases := []struct {
+		description string
+		table       Paytable
+		cards       []card.Card
+		expected    int
+	}{
+		{"tens do not pay", JacksOrBetter96(), mustParseCards(t, "10♡ 10♧ 2♤ 5♢ 9♧"), 0},
+		{"jacks pay", JacksOrBetter96(), mustParseCards(t, "J♡ J♧ 2♤ 5♢ 9♧"), 1},
+		{"wheel", JacksOrBetter96(), mustParseCards(t, "A♡ 2♧ 3♤ 4♢ 5♧"), 4},
+		{"royal flush", JacksOrBetter96(), mustParseCards(t, "A♡ K♡ Q♡ J♡ 10♡"), 800},
+		{"aces with a low kicker", DoubleDoubleBonus96(), mustParseCards(t, "A♡ A♧ A♤ A♢ 2♧"), 400},
+		{"aces with a high kicker", DoubleDoubleBonus96(), mustParseCards(t, "A♡ A♧ A♤ A♢ K♧"), 160},
+		{"threes with an ace", DoubleDoubleBonus96(), mustParseCards(t, "3♡ 3♧ 3♤ 3♢ A♧"), 160},
+		{"threes in bonus poker", BonusPoker85(), mustParseCards(t, "3♡ 3♧ 3♤ 3♢ A♧"), 40},
+		{"four deuces", DeucesWildFullPay(), mustParseCards(t, "2♡ 2♧ 2♤ 2♢ 7♧"), 200},
+		{"wild royal", DeucesWildFullPay(), mustParseCards(t, "2♡ K♧ Q♧ J♧ 10♧"), 25},
+		{"five of a kind", DeucesWildFullPay(), mustParseCards(t, "2♡ 2♧ 9♤ 9♢ 9♧"), 15},
+		{"natural royal", DeucesWildFullPay(), mustParseCards(t, "A♧ K♧ Q♧ J♧ 10♧"), 800},
+		{"pair with a deuce is nothing", DeucesWildFullPay(), mustParseCards(t, "2♡ K♧ 9♤ 5♢ 7♧"), 0},
+		{"joker pairs a king", JokerPokerKingsOrBetter(), append(mustParseCards(t, "K♧ 9♤ 5♢ 7♧"), card.JOKER), 1},
+		{"joker pairs a queen", JokerPokerKingsOrBetter(), append(mustParseCards(t, "Q♧ 9♤ 5♢ 7♧"), card.JOKER), 0},
+		{"joker fills a royal", JokerPokerKingsOrBetter(), append(mustParseCards(t, "A♤ K♤ J♤ 10♤"), card.JOKER), 100},
+		{"joker makes five aces", JokerPokerKingsOrBetter(), append(mustParseCards(t, "A♡ A♧ A♤ A♢"), card.JOKER), 200},
+		{"joker makes a full house", JokerPokerKingsOrBetter(), append(mustParseCards(t, "8♡ 8♧ 4♤ 4♢"), card.JOKER), 7},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			got, err := tc.table.Pay(tc.cards)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %d, got %d", tc.expected, got)
+			}
+		})
+	}
+
+	if _, err := JacksOrBetter96().Pay(append(mustParseCards(t, "K♧ 9♤ 5♢ 7♧"), card.JOKER)); err == nil {
+		t.Error("expected error for a joker in Jacks or Better")
+	}
+}
+
+func TestHolds(t *testing.T) {
+	v, err := NewVideoPoker(JacksOrBetter96())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	holds, err := v.Holds(mustParseCards(t, "J♡ 2♧ A♡ K♡ Q♡"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(holds) != 32 {
+		t.Fatalf("expected 32 holds, got %d", len(holds))
+	}
+	// The royal, 8 other flushes, 3 straights and 12 high pairs.
+	if best := holds[0]; best.Mask != 0b11101 || math.Abs(best.EV-872.0/47) > 1e-9 {
+		t.Errorf("expected to hold four to the royal for %f, got %+v", 872.0/47, best)
+	}
+
+	holds, err = v.Holds(mustParseCards(t, "A♧ K♧ Q♧ J♧ 10♧"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if holds[0].Mask != 0b11111 || holds[0].EV != 800 || len(holds[0].Cards) != 5 {
+		t.Errorf("expected to hold the royal, got %+v", holds[0])
+	}
+}
+
+// Published returns for the full pay tables.
+func TestReturnToPlayer(t *testing.T) {
+	cases := []struct {
+		table    Paytable
+		expected float64
+	}{
+		{JacksOrBetter96(), 0.995439},
+		{BonusPoker85(), 0.991660},
+		{DoubleDoubleBonus96(), 0.989808},
+		{DeucesWildFullPay(), 1.007620},
+		{JokerPokerKingsOrBetter(), 1.006463},
+	}
+
+	for i, tc := range cases {
+		t.Run(tc.table.Name, func(t *testing.T) {
+			if i > 0 && testing.Short() {
+				t.Skip("skipping in short mode")
+			}
+			v, err := NewVideoPoker(tc.table)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := v.ReturnToPlayer(); math.Abs(got-tc.expected) > 1e-6 {
+				t.Errorf("expected %f, got %f", tc.expected, got)
+			}
+		})
+	}
+}
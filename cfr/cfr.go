@@ -0,0 +1,295 @@
+// Package cfr computes approximate equilibrium strategies of two-player
+// zero-sum games by counterfactual regret minimization. Games are given as
+// trees of States; an Abstraction can merge information sets so that large
+// games, such as hold'em with hands bucketed by strength, are solved in a
+// smaller space.
+package cfr
+
+import "fmt"
+
+// CHANCE is the player to act at chance nodes.
+const CHANCE = -1
+
+// State is a node of a game tree. Next must not change the state it is
+// called on.
+type State interface {
+	Terminal() bool
+	// Payoff is player 0's winnings at a terminal state; player 1 wins the
+	// negation.
+	Payoff() float64
+	// Player is 0 or 1 at decision nodes and CHANCE at chance nodes.
+	Player() int
+	// Actions is the number of actions, or of chance outcomes.
+	Actions() int
+	// Chance is the probability of each outcome at a chance node.
+	Chance() []float64
+	Next(action int) State
+	// InfoSet identifies everything the player to act knows, so that states
+	// the player cannot tell apart share it.
+	InfoSet() string
+}
+
+// Abstraction maps a decision state to the key its strategy is stored under.
+// States with the same key must have the same number of actions.
+type Abstraction func(State) string
+
+// InfoSet is the abstraction that solves the game as it is.
+func InfoSet(s State) string {
+	return s.InfoSet()
+}
+
+type Variant int
+
+const (
+	// VANILLA updates both players each iteration and averages the
+	// strategies uniformly.
+	VANILLA Variant = iota + 1
+	// PLUS floors regrets at zero, alternates the players' updates and
+	// weights the average strategy by iteration.
+	PLUS
+)
+
+func (v Variant) String() string {
+	switch v {
+	case VANILLA:
+		return "CFR"
+	case PLUS:
+		return "CFR+"
+	default:
+		return fmt.Sprintf("Variant(%d)", int(v))
+	}
+}
+
+// Strategy holds the probability of each action by information set key.
+type Strategy map[string][]float64
+
+// Probabilities returns the strategy at a key, uniform over the actions when
+// the key is missing.
+func (s Strategy) Probabilities(key string, actions int) ([]float64, error) {
+	probabilities, ok := s[key]
+	if !ok {
+		return uniform(actions), nil
+	}
+	if len(probabilities) != actions {
+		return nil, fmt.Errorf("information set %q has %d actions, strategy has %d", key, actions, len(probabilities))
+	}
+	return probabilities, nil
+}
+
+func uniform(actions int) []float64 {
+	probabilities := make([]float64, actions)
+	for i := range probabilities {
+		probabilities[i] = 1 / float64(actions)
+	}
+	return probabilities
+}
+
+// infoSet is the solver's record of one information set. Regrets found
+// during a traversal wait in pending until it is over, so every state of the
+// set is played with the same current strategy.
+type infoSet struct {
+	regrets, pending []float64
+	current          []float64
+	strategySum      []float64
+}
+
+func newInfoSet(actions int) *infoSet {
+	return &infoSet{
+		regrets:     make([]float64, actions),
+		pending:     make([]float64, actions),
+		current:     uniform(actions),
+		strategySum: make([]float64, actions),
+	}
+}
+
+// update applies the pending regrets and matches the current strategy to
+// the positive regrets.
+func (n *infoSet) update(floor bool) {
+	var positive float64
+	for a := range n.regrets {
+		n.regrets[a] += n.pending[a]
+		n.pending[a] = 0
+		if floor {
+			n.regrets[a] = max(n.regrets[a], 0)
+		}
+		positive += max(n.regrets[a], 0)
+	}
+	for a := range n.current {
+		if positive > 0 {
+			n.current[a] = max(n.regrets[a], 0) / positive
+		} else {
+			n.current[a] = 1 / float64(len(n.current))
+		}
+	}
+}
+
+type Solver struct {
+	root        State
+	variant     Variant
+	abstraction Abstraction
+	infoSets    map[string]*infoSet
+	iterations  int
+}
+
+// NewSolver starts solving the game at root. A nil abstraction is InfoSet.
+func NewSolver(root State, variant Variant, abstraction Abstraction) (*Solver, error) {
+	if variant != VANILLA && variant != PLUS {
+		return nil, fmt.Errorf("invalid variant: %d", variant)
+	}
+	if abstraction == nil {
+		abstraction = InfoSet
+	}
+	return &Solver{
+		root:        root,
+		variant:     variant,
+		abstraction: abstraction,
+		infoSets:    make(map[string]*infoSet),
+	}, nil
+}
+
+// Run traverses the tree for more iterations.
+func (s *Solver) Run(iterations int) error {
+	for range iterations {
+		s.iterations++
+		var err error
+		if s.variant == VANILLA {
+			_, err = s.traverse(s.root, [2]bool{true, true}, [3]float64{1, 1, 1})
+			s.update()
+		} else {
+			for player := range 2 {
+				update := [2]bool{}
+				update[player] = true
+				if _, err = s.traverse(s.root, update, [3]float64{1, 1, 1}); err != nil {
+					break
+				}
+				s.update()
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Solver) Iterations() int {
+	return s.iterations
+}
+
+func (s *Solver) update() {
+	for _, n := range s.infoSets {
+		n.update(s.variant == PLUS)
+	}
+}
+
+// traverse returns player 0's expected payoff at state under the current
+// strategies. reach holds the probability each player, then chance, plays
+// to the state. Regrets and average strategies are updated for the players
+// marked in update.
+func (s *Solver) traverse(state State, update [2]bool, reach [3]float64) (float64, error) {
+	if state.Terminal() {
+		return state.Payoff(), nil
+	}
+	actions, err := checkActions(state)
+	if err != nil {
+		return 0, err
+	}
+
+	player := state.Player()
+	if player == CHANCE {
+		var value float64
+		for a, p := range state.Chance() {
+			next := reach
+			next[2] *= p
+			v, err := s.traverse(state.Next(a), update, next)
+			if err != nil {
+				return 0, err
+			}
+			value += p * v
+		}
+		return value, nil
+	}
+
+	key := s.abstraction(state)
+	n, ok := s.infoSets[key]
+	if !ok {
+		n = newInfoSet(actions)
+		s.infoSets[key] = n
+	} else if len(n.current) != actions {
+		return 0, fmt.Errorf("information set %q has %d and %d actions", key, len(n.current), actions)
+	}
+
+	values := make([]float64, actions)
+	var value float64
+	for a, p := range n.current {
+		next := reach
+		next[player] *= p
+		if values[a], err = s.traverse(state.Next(a), update, next); err != nil {
+			return 0, err
+		}
+		value += p * values[a]
+	}
+
+	if update[player] {
+		sign := 1.0
+		if player == 1 {
+			sign = -1
+		}
+		counterfactual := reach[1-player] * reach[2]
+		weight := reach[player]
+		if s.variant == PLUS {
+			weight *= float64(s.iterations)
+		}
+		for a, p := range n.current {
+			n.pending[a] += counterfactual * sign * (values[a] - value)
+			n.strategySum[a] += weight * p
+		}
+	}
+	return value, nil
+}
+
+// checkActions validates a non-terminal state and returns its number of
+// actions.
+func checkActions(state State) (int, error) {
+	actions := state.Actions()
+	if actions <= 0 {
+		return 0, fmt.Errorf("state with no actions is not terminal")
+	}
+	switch player := state.Player(); player {
+	case 0, 1:
+	case CHANCE:
+		if len(state.Chance()) != actions {
+			return 0, fmt.Errorf("chance node has %d actions and %d probabilities", actions, len(state.Chance()))
+		}
+	default:
+		return 0, fmt.Errorf("invalid player: %d", player)
+	}
+	return actions, nil
+}
+
+// Strategy returns the average strategy, which converges to an equilibrium.
+func (s *Solver) Strategy() Strategy {
+	strategy := make(Strategy)
+	for key, n := range s.infoSets {
+		sum := n.strategySum
+		var total float64
+		for _, w := range sum {
+			total += w
+		}
+		if total == 0 {
+			strategy[key] = uniform(len(sum))
+			continue
+		}
+		probabilities := make([]float64, len(sum))
+		for a, w := range sum {
+			probabilities[a] = w / total
+		}
+		strategy[key] = probabilities
+	}
+	return strategy
+}
+
+// Exploitability is the exploitability of the average strategy.
+func (s *Solver) Exploitability() (float64, error) {
+	return Exploitability(s.root, s.Strategy(), s.abstraction)
+}
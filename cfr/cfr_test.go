@@ -0,0 +1,361 @@
+package cfr
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+// showdown returns 1 if player 0's cards with the board beat player 1's, -1
+// if they lose and 0 for a split.
+func showdown(hands [2]card.Card, board ...card.Card) float64 {
+	a := eval.NewCardSet(append(board, hands[0])...).Evaluate()
+	b := eval.NewCardSet(append(board, hands[1])...).Evaluate()
+	switch {
+	case a > b:
+		return 1
+	case a < b:
+		return -1
+	default:
+		return 0
+	}
+}
+
+var kuhnDeck = []card.Card{
+	card.New(card.JACK, card.SPADES),
+	card.New(card.QUEEN, card.SPADES),
+	card.New(card.KING, card.SPADES),
+}
+
+// kuhn is Kuhn poker: each player antes 1 and is dealt one of three cards,
+// then there is one round of betting, p for pass and b for a bet of 1.
+type kuhn struct {
+	dealt   bool
+	cards   [2]card.Card
+	history string
+}
+
+var kuhnDeals = [][2]int{{0, 1}, {0, 2}, {1, 0}, {1, 2}, {2, 0}, {2, 1}}
+
+func (k kuhn) Terminal() bool {
+	switch k.history {
+	case "pp", "bp", "bb", "pbp", "pbb":
+		return true
+	}
+	return false
+}
+
+func (k kuhn) Payoff() float64 {
+	switch k.history {
+	case "bp":
+		return 1
+	case "pbp":
+		return -1
+	case "pp":
+		return showdown(k.cards)
+	default:
+		return 2 * showdown(k.cards)
+	}
+}
+
+func (k kuhn) Player() int {
+	if !k.dealt {
+		return CHANCE
+	}
+	return len(k.history) % 2
+}
+
+func (k kuhn) Actions() int {
+	if !k.dealt {
+		return len(kuhnDeals)
+	}
+	return 2
+}
+
+func (k kuhn) Chance() []float64 {
+	return uniform(len(kuhnDeals))
+}
+
+func (k kuhn) Next(action int) State {
+	if !k.dealt {
+		deal := kuhnDeals[action]
+		return kuhn{dealt: true, cards: [2]card.Card{kuhnDeck[deal[0]], kuhnDeck[deal[1]]}}
+	}
+	k.history += "pb"[action : action+1]
+	return k
+}
+
+func (k kuhn) InfoSet() string {
+	return k.cards[k.Player()].String() + " " + k.history
+}
+
+// leduc is Leduc hold'em: a deck of two jacks, queens and kings, an ante of
+// 1, one private card each and a board card dealt between two betting rounds
+// with bets of 2 and then 4 and at most a bet and a raise a round. A pair
+// with the board wins, otherwise the higher card.
+type leduc struct {
+	cards   [2]card.Card
+	board   card.Card
+	deck    []card.Card
+	bets    [2]int
+	round   int
+	raises  int
+	player  int
+	history string
+	folded  bool
+	over    bool
+}
+
+var leducDeck = []card.Card{
+	card.New(card.JACK, card.HEARTS),
+	card.New(card.JACK, card.SPADES),
+	card.New(card.QUEEN, card.HEARTS),
+	card.New(card.QUEEN, card.SPADES),
+	card.New(card.KING, card.HEARTS),
+	card.New(card.KING, card.SPADES),
+}
+
+func newLeduc() leduc {
+	return leduc{deck: leducDeck, bets: [2]int{1, 1}, player: CHANCE}
+}
+
+func (l leduc) Terminal() bool {
+	return l.folded || l.over
+}
+
+func (l leduc) Payoff() float64 {
+	if l.folded {
+		// The player who folded is the one to act.
+		if l.player == 0 {
+			return -float64(l.bets[0])
+		}
+		return float64(l.bets[1])
+	}
+	return float64(l.bets[0]) * showdown(l.cards, l.board)
+}
+
+func (l leduc) Player() int {
+	return l.player
+}
+
+// legal lists the actions to act on: f to fold, c to check or call and r to
+// bet or raise.
+func (l leduc) legal() string {
+	if l.bets[0] != l.bets[1] {
+		if l.raises < 2 {
+			return "fcr"
+		}
+		return "fc"
+	}
+	return "cr"
+}
+
+func (l leduc) Actions() int {
+	if l.player == CHANCE {
+		if l.cards[0] == (card.Card{}) {
+			return len(l.deck) * (len(l.deck) - 1)
+		}
+		return len(l.deck)
+	}
+	return len(l.legal())
+}
+
+func (l leduc) Chance() []float64 {
+	return uniform(l.Actions())
+}
+
+func (l leduc) Next(action int) State {
+	if l.player == CHANCE {
+		return l.deal(action)
+	}
+	move := l.legal()[action]
+	opened := strings.LastIndexByte(l.history, '/') < len(l.history)-1
+	l.history += string(move)
+	switch move {
+	case 'f':
+		l.folded = true
+		return l
+	case 'c':
+		called := l.bets[l.player] != l.bets[1-l.player]
+		l.bets[l.player] = l.bets[1-l.player]
+		if called || opened {
+			if l.round == 1 {
+				l.over = true
+			} else {
+				l.player = CHANCE
+			}
+			return l
+		}
+	case 'r':
+		l.bets[l.player] = l.bets[1-l.player] + 2*(l.round+1)
+		l.raises++
+	}
+	l.player = 1 - l.player
+	return l
+}
+
+func (l leduc) deal(action int) leduc {
+	deck := l.deck
+	l.deck = nil
+	if l.cards[0] == (card.Card{}) {
+		first, second := action/(len(deck)-1), action%(len(deck)-1)
+		if second >= first {
+			second++
+		}
+		l.cards = [2]card.Card{deck[first], deck[second]}
+		for i, c := range deck {
+			if i != first && i != second {
+				l.deck = append(l.deck, c)
+			}
+		}
+		l.player = 0
+		return l
+	}
+	l.board = deck[action]
+	l.round, l.raises, l.player = 1, 0, 0
+	l.history += "/"
+	return l
+}
+
+// InfoSet leaves out the suits, which only tell the cards apart.
+func (l leduc) InfoSet() string {
+	ranks := []byte{"JQK"[l.cards[l.player].Rank()-card.JACK]}
+	if l.round == 1 {
+		ranks = append(ranks, "JQK"[l.board.Rank()-card.JACK])
+	}
+	return string(ranks) + " " + l.history
+}
+
+func TestKuhn(t *testing.T) {
+	for _, variant := range []Variant{VANILLA, PLUS} {
+		solver, err := NewSolver(kuhn{}, variant, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := solver.Run(2000); err != nil {
+			t.Fatal(err)
+		}
+		strategy := solver.Strategy()
+		exploitability, err := solver.Exploitability()
+		if err != nil {
+			t.Fatal(err)
+		}
+		value, err := Value(kuhn{}, strategy, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(strategy) != 12 || exploitability > 0.01 || math.Abs(value+1.0/18) > 0.005 {
+			t.Errorf("%s: expected 12 information sets, value -1/18 and exploitability near 0, got %d, %f and %f",
+				variant, len(strategy), value, exploitability)
+		}
+
+		// The first player bets a king three times as often as a jack, and
+		// the second always calls with a king and never with a jack.
+		jack, king := strategy["J♤ "][1], strategy["K♤ "][1]
+		if math.Abs(king-3*jack) > 0.05 || strategy["K♤ b"][1] < 0.99 || strategy["J♤ b"][1] > 0.01 {
+			t.Errorf("%s: unexpected equilibrium %v", variant, strategy)
+		}
+	}
+}
+
+func TestKuhnAbstraction(t *testing.T) {
+	// Merging the jack and queen leaves the player unable to tell a bluff
+	// from a call, which costs against a best response.
+	abstraction := func(s State) string {
+		return strings.Replace(s.InfoSet(), "J♤", "Q♤", 1)
+	}
+	solver, err := NewSolver(kuhn{}, PLUS, abstraction)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := solver.Run(1000); err != nil {
+		t.Fatal(err)
+	}
+	exploitability, err := solver.Exploitability()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strategy := solver.Strategy(); len(strategy) != 8 || exploitability < 0.01 {
+		t.Errorf("expected 8 information sets and a costly abstraction, got %d and %f", len(strategy), exploitability)
+	}
+}
+
+func TestLeduc(t *testing.T) {
+	iterations, limit := 300, 0.005
+	if testing.Short() {
+		iterations, limit = 50, 0.05
+	}
+	exploitabilities := make(map[Variant]float64)
+	for _, variant := range []Variant{VANILLA, PLUS} {
+		solver, err := NewSolver(newLeduc(), variant, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := solver.Run(iterations); err != nil {
+			t.Fatal(err)
+		}
+		if exploitabilities[variant], err = solver.Exploitability(); err != nil {
+			t.Fatal(err)
+		}
+		if len(solver.Strategy()) != 288 {
+			t.Errorf("%s: expected 288 information sets, got %d", variant, len(solver.Strategy()))
+		}
+		if variant == PLUS {
+			// The first player loses about 0.0856 a hand at equilibrium.
+			value, err := Value(newLeduc(), solver.Strategy(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs(value+0.0856) > limit {
+				t.Errorf("expected a value near -0.0856, got %f", value)
+			}
+		}
+	}
+	if exploitabilities[PLUS] > limit || exploitabilities[PLUS] > exploitabilities[VANILLA] {
+		t.Errorf("expected CFR+ to converge faster, got %v", exploitabilities)
+	}
+}
+
+func TestExploitability(t *testing.T) {
+	// Against always betting and calling in Kuhn poker, either seat gives
+	// up the jack and plays on with the rest, winning (2 + 0 - 1)/3 a hand.
+	aggressive := make(Strategy)
+	for _, card := range kuhnDeck {
+		for _, history := range []string{"", "p", "b", "pb"} {
+			aggressive[card.String()+" "+history] = []float64{0, 1}
+		}
+	}
+	value, err := Value(kuhn{}, aggressive, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exploitability, err := Exploitability(kuhn{}, aggressive, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != 0 || math.Abs(exploitability-1.0/3) > 1e-9 {
+		t.Errorf("expected value 0 and exploitability 1/3, got %f and %f", value, exploitability)
+	}
+}
+
+func TestRejects(t *testing.T) {
+	if _, err := NewSolver(kuhn{}, 0, nil); err == nil {
+		t.Error("expected an invalid variant to be rejected")
+	}
+
+	// Giving every information set the same key merges those facing a bet,
+	// with three actions in Leduc, with those that are not, with two.
+	solver, _ := NewSolver(newLeduc(), PLUS, func(s State) string {
+		return "one"
+	})
+	if err := solver.Run(1); err == nil {
+		t.Error("expected mismatched actions to be rejected")
+	}
+
+	if _, err := Value(kuhn{}, Strategy{"K♤ ": {1}}, nil); err == nil {
+		t.Error("expected a strategy of the wrong size to be rejected")
+	}
+}
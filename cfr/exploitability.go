@@ -0,0 +1,191 @@
+package cfr
+
+import "fmt"
+
+// Value returns player 0's expected payoff when both players follow the
+// strategy, looked up through the abstraction. A nil abstraction is InfoSet.
+func Value(root State, strategy Strategy, abstraction Abstraction) (float64, error) {
+	if abstraction == nil {
+		abstraction = InfoSet
+	}
+	var value func(state State) (float64, error)
+	value = func(state State) (float64, error) {
+		if state.Terminal() {
+			return state.Payoff(), nil
+		}
+		probabilities, err := stateProbabilities(state, strategy, abstraction)
+		if err != nil {
+			return 0, err
+		}
+		var total float64
+		for a, p := range probabilities {
+			if p == 0 {
+				continue
+			}
+			v, err := value(state.Next(a))
+			if err != nil {
+				return 0, err
+			}
+			total += p * v
+		}
+		return total, nil
+	}
+	return value(root)
+}
+
+// Exploitability is how much, on average over the two seats, a player gains
+// by switching to a best response while the other keeps the strategy. It is
+// zero exactly at an equilibrium. The best responses are taken in the game
+// itself, with the strategy looked up through the abstraction; a nil
+// abstraction is InfoSet.
+func Exploitability(root State, strategy Strategy, abstraction Abstraction) (float64, error) {
+	if abstraction == nil {
+		abstraction = InfoSet
+	}
+	var total float64
+	for player := range 2 {
+		br := &bestResponse{
+			player:      player,
+			strategy:    strategy,
+			abstraction: abstraction,
+			states:      make(map[string][]weightedState),
+			actions:     make(map[string]int),
+		}
+		if err := br.collect(root, 1); err != nil {
+			return 0, err
+		}
+		value, err := br.value(root)
+		if err != nil {
+			return 0, err
+		}
+		total += value
+	}
+	return total / 2, nil
+}
+
+// stateProbabilities returns the chances at a chance node, otherwise the
+// strategy of the player to act.
+func stateProbabilities(state State, strategy Strategy, abstraction Abstraction) ([]float64, error) {
+	actions, err := checkActions(state)
+	if err != nil {
+		return nil, err
+	}
+	if state.Player() == CHANCE {
+		return state.Chance(), nil
+	}
+	return strategy.Probabilities(abstraction(state), actions)
+}
+
+type weightedState struct {
+	state State
+	// weight is the probability chance and the other player reach the state.
+	weight float64
+}
+
+// bestResponse finds a player's best response information set by
+// information set. Perfect recall makes the choice at a set depend only on
+// the choices at the sets after it, which are found first.
+type bestResponse struct {
+	player      int
+	strategy    Strategy
+	abstraction Abstraction
+	// states lists the states of each of the player's information sets.
+	states  map[string][]weightedState
+	actions map[string]int
+}
+
+func (br *bestResponse) collect(state State, weight float64) error {
+	if state.Terminal() || weight == 0 {
+		return nil
+	}
+	if state.Player() == br.player {
+		if _, err := checkActions(state); err != nil {
+			return err
+		}
+		key := state.InfoSet()
+		br.states[key] = append(br.states[key], weightedState{state, weight})
+		for a := range state.Actions() {
+			if err := br.collect(state.Next(a), weight); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	probabilities, err := stateProbabilities(state, br.strategy, br.abstraction)
+	if err != nil {
+		return err
+	}
+	for a, p := range probabilities {
+		if err := br.collect(state.Next(a), weight*p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// value returns the player's payoff at state playing the best response.
+func (br *bestResponse) value(state State) (float64, error) {
+	if state.Terminal() {
+		if br.player == 1 {
+			return -state.Payoff(), nil
+		}
+		return state.Payoff(), nil
+	}
+	if state.Player() == br.player {
+		action, err := br.action(state.InfoSet())
+		if err != nil {
+			return 0, err
+		}
+		return br.value(state.Next(action))
+	}
+	probabilities, err := stateProbabilities(state, br.strategy, br.abstraction)
+	if err != nil {
+		return 0, err
+	}
+	var total float64
+	for a, p := range probabilities {
+		if p == 0 {
+			continue
+		}
+		v, err := br.value(state.Next(a))
+		if err != nil {
+			return 0, err
+		}
+		total += p * v
+	}
+	return total, nil
+}
+
+// action returns the best action at one of the player's information sets,
+// the one with the most counterfactual value over its states.
+func (br *bestResponse) action(key string) (int, error) {
+	if action, ok := br.actions[key]; ok {
+		return action, nil
+	}
+	states := br.states[key]
+	if len(states) == 0 {
+		// No state of the set is reached; any action will do.
+		br.actions[key] = 0
+		return 0, nil
+	}
+	actions := states[0].state.Actions()
+	best, bestValue := 0, 0.0
+	for a := range actions {
+		var value float64
+		for _, s := range states {
+			if s.state.Actions() != actions {
+				return 0, fmt.Errorf("information set %q has %d and %d actions", key, actions, s.state.Actions())
+			}
+			v, err := br.value(s.state.Next(a))
+			if err != nil {
+				return 0, err
+			}
+			value += s.weight * v
+		}
+		if a == 0 || value > bestValue {
+			best, bestValue = a, value
+		}
+	}
+	br.actions[key] = best
+	return best, nil
+}
@@ -0,0 +1,99 @@
+// Package deck deals cards from decks shuffled reproducibly: a seed and a
+// shuffle version always give the same order.
+package deck
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/sdeboni/go-poker/card"
+)
+
+// ShuffleVersion identifies a shuffle algorithm. A seed only reproduces a deck
+// under the version it was recorded with, so algorithms are never changed in
+// place: a new one gets a new version.
+type ShuffleVersion int
+
+const (
+	// SHUFFLE_V1 is a Fisher-Yates shuffle of the index-ordered deck driven by
+	// PCG-DXSM seeded with (seed, 0), using rejection sampling for bounds.
+	SHUFFLE_V1 ShuffleVersion = iota + 1
+)
+
+const CURRENT_SHUFFLE_VERSION = SHUFFLE_V1
+
+type Deck struct {
+	cards []card.Card
+}
+
+// New returns all 52 cards in card.Index order.
+func New() *Deck {
+	cards := make([]card.Card, card.DECK_SIZE)
+	for i := range cards {
+		cards[i], _ = card.FromIndex(i)
+	}
+	return &Deck{cards}
+}
+
+func NewShuffled(seed uint64, version ShuffleVersion) (*Deck, error) {
+	d := New()
+	if err := d.Shuffle(seed, version); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// FromCards returns a deck of the given cards, in order, such as a muck to be
+// reshuffled.
+func FromCards(cards []card.Card) *Deck {
+	return &Deck{append([]card.Card(nil), cards...)}
+}
+
+func (d *Deck) Shuffle(seed uint64, version ShuffleVersion) error {
+	switch version {
+	case SHUFFLE_V1:
+		shuffleV1(d.cards, seed)
+		return nil
+	default:
+		return fmt.Errorf("unsupported shuffle version: %d", version)
+	}
+}
+
+func (d *Deck) Remaining() int {
+	return len(d.cards)
+}
+
+// Cards returns the cards left, in the order they would be dealt.
+func (d *Deck) Cards() []card.Card {
+	return append([]card.Card(nil), d.cards...)
+}
+
+func (d *Deck) Deal(n int) ([]card.Card, error) {
+	if n < 0 || n > len(d.cards) {
+		return nil, fmt.Errorf("cannot deal %d cards from a deck of %d", n, len(d.cards))
+	}
+	dealt := make([]card.Card, n)
+	copy(dealt, d.cards[:n])
+	d.cards = d.cards[n:]
+	return dealt, nil
+}
+
+func shuffleV1(cards []card.Card, seed uint64) {
+	src := rand.NewPCG(seed, 0)
+	for i := len(cards) - 1; i > 0; i-- {
+		j := boundedUint64(src, uint64(i+1))
+		cards[i], cards[j] = cards[j], cards[i]
+	}
+}
+
+// boundedUint64 returns a uniform value in [0, n). It is part of SHUFFLE_V1 and
+// must not change, unlike rand.Rand's helpers which are free to.
+func boundedUint64(src *rand.PCG, n uint64) uint64 {
+	limit := -n % n
+	for {
+		v := src.Uint64()
+		if v >= limit {
+			return v % n
+		}
+	}
+}
@@ -0,0 +1,257 @@
+// Package draw deals draw poker hands, five card draw and deuce to seven
+// lowball, through their discards and reshuffles.
+package draw
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/sdeboni/go-poker"
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/deck"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+type Variant int
+
+const (
+	// FIVE_CARD_DRAW has one draw and the best high hand wins.
+	FIVE_CARD_DRAW Variant = iota + 1
+	// DEUCE_TO_SEVEN_SINGLE_DRAW and DEUCE_TO_SEVEN_TRIPLE_DRAW are lowball
+	// with one and three draws: the worst high hand wins, aces are always
+	// high, and straights and flushes count against the hand.
+	DEUCE_TO_SEVEN_SINGLE_DRAW
+	DEUCE_TO_SEVEN_TRIPLE_DRAW
+)
+
+func (v Variant) Draws() int {
+	if v == DEUCE_TO_SEVEN_TRIPLE_DRAW {
+		return 3
+	}
+	return 1
+}
+
+// ReshuffleRule is the house rule for a draw the stub cannot cover.
+type ReshuffleRule int
+
+const (
+	// RESHUFFLE_MUCK deals the rest of the stub, then shuffles the cards
+	// discarded so far, except the drawing player's own, into a new stub.
+	RESHUFFLE_MUCK ReshuffleRule = iota + 1
+	// NO_RESHUFFLE refuses a draw the stub cannot cover.
+	NO_RESHUFFLE
+)
+
+// Game deals a draw poker hand from a seeded deck. Betting is left to the
+// caller, which folds players and calls Draw once per drawing round.
+type Game struct {
+	variant    Variant
+	rule       ReshuffleRule
+	seed       uint64
+	version    deck.ShuffleVersion
+	reshuffles uint64
+	deck       *deck.Deck
+	hands      [][]card.Card
+	folded     []bool
+	muck       []card.Card
+	draws      int
+}
+
+func NewGame(variant Variant, rule ReshuffleRule, players int, seed uint64, version deck.ShuffleVersion) (*Game, error) {
+	if variant < FIVE_CARD_DRAW || variant > DEUCE_TO_SEVEN_TRIPLE_DRAW {
+		return nil, fmt.Errorf("invalid draw variant: %d", variant)
+	}
+	if rule != RESHUFFLE_MUCK && rule != NO_RESHUFFLE {
+		return nil, fmt.Errorf("invalid reshuffle rule: %d", rule)
+	}
+	if players < 2 || players*eval.CARDS_PER_HAND > card.DECK_SIZE {
+		return nil, fmt.Errorf("invalid number of players: %d", players)
+	}
+	deck, err := deck.NewShuffled(seed, version)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &Game{
+		variant: variant,
+		rule:    rule,
+		seed:    seed,
+		version: version,
+		deck:    deck,
+		hands:   make([][]card.Card, players),
+		folded:  make([]bool, players),
+	}
+	for range eval.CARDS_PER_HAND {
+		for player := range g.hands {
+			card, _ := deck.Deal(1)
+			g.hands[player] = append(g.hands[player], card[0])
+		}
+	}
+	return g, nil
+}
+
+// Hand returns a copy of the player's cards in the order they were dealt,
+// drawn cards replacing discards in place, or nil for no such player.
+func (g *Game) Hand(player int) []card.Card {
+	if player < 0 || player >= len(g.hands) {
+		return nil
+	}
+	return slices.Clone(g.hands[player])
+}
+
+func (g *Game) DrawsLeft() int {
+	return g.variant.Draws() - g.draws
+}
+
+func (g *Game) Fold(player int) error {
+	if player < 0 || player >= len(g.hands) || g.folded[player] {
+		return fmt.Errorf("invalid player to fold: %d", player)
+	}
+	if g.live() == 1 {
+		return fmt.Errorf("last player %d cannot fold", player)
+	}
+	g.folded[player] = true
+	g.muck = append(g.muck, g.hands[player]...)
+	return nil
+}
+
+// Opener returns the first live player, starting from first and going
+// around the table, holding a pair of jacks or better.
+func (g *Game) Opener(first int) (int, bool) {
+	for i := range g.hands {
+		player := (first + i) % len(g.hands)
+		if !g.folded[player] && OpensJacksOrBetter(g.hands[player]) {
+			return player, true
+		}
+	}
+	return 0, false
+}
+
+// Draw runs one drawing round in seat order from first. discards[player]
+// holds the positions, within the player's hand, of the cards they throw;
+// folded players' entries are ignored. A round that cannot be dealt in full
+// changes nothing.
+func (g *Game) Draw(first int, discards [][]int) error {
+	if g.DrawsLeft() == 0 {
+		return fmt.Errorf("no draws left")
+	}
+	if first < 0 || first >= len(g.hands) || len(discards) != len(g.hands) {
+		return fmt.Errorf("invalid draw: first player %d, %d discard lists", first, len(discards))
+	}
+	for player, positions := range discards {
+		if g.folded[player] {
+			continue
+		}
+		seen := make(map[int]bool)
+		for _, pos := range positions {
+			if pos < 0 || pos >= eval.CARDS_PER_HAND || seen[pos] {
+				return fmt.Errorf("player %d: invalid discard position %d", player, pos)
+			}
+			seen[pos] = true
+		}
+	}
+
+	// The round works on copies and restores them if a deal fails.
+	stub, muck, reshuffles := g.deck, slices.Clone(g.muck), g.reshuffles
+	hands := make([][]card.Card, len(g.hands))
+	for player, hand := range g.hands {
+		hands[player] = slices.Clone(hand)
+	}
+	g.deck = deck.FromCards(stub.Cards())
+	for i := range g.hands {
+		player := (first + i) % len(g.hands)
+		if g.folded[player] || len(discards[player]) == 0 {
+			continue
+		}
+		drawn, err := g.deal(len(discards[player]))
+		if err != nil {
+			g.deck, g.muck, g.reshuffles, g.hands = stub, muck, reshuffles, hands
+			return fmt.Errorf("player %d: %w", player, err)
+		}
+		for j, pos := range discards[player] {
+			g.muck = append(g.muck, g.hands[player][pos])
+			g.hands[player][pos] = drawn[j]
+		}
+	}
+	g.draws++
+	return nil
+}
+
+// deal takes n cards from the stub, reshuffling the muck into a new stub
+// when it runs out.
+func (g *Game) deal(n int) ([]card.Card, error) {
+	if n <= g.deck.Remaining() {
+		return g.deck.Deal(n)
+	}
+	if g.rule == NO_RESHUFFLE || n > g.deck.Remaining()+len(g.muck) {
+		return nil, fmt.Errorf("cannot draw %d cards from a stub of %d and a muck of %d", n, g.deck.Remaining(), len(g.muck))
+	}
+
+	drawn, _ := g.deck.Deal(g.deck.Remaining())
+	g.reshuffles++
+	g.deck = deck.FromCards(g.muck)
+	g.muck = nil
+	// Each reshuffle gets its own seed so the hand replays from the first.
+	if err := g.deck.Shuffle(g.seed+g.reshuffles, g.version); err != nil {
+		return nil, err
+	}
+	rest, err := g.deck.Deal(n - len(drawn))
+	if err != nil {
+		return nil, err
+	}
+	return append(drawn, rest...), nil
+}
+
+var (
+	highRuleset    = poker.DefaultRuleset()
+	lowballRuleset = poker.KansasCityRuleset()
+)
+
+// Winners settles the hand among the live players: by the best high hand
+// for five card draw, by the Kansas City lowball ruleset for deuce to seven.
+func (g *Game) Winners() ([]int, error) {
+	rules := highRuleset
+	if g.variant != FIVE_CARD_DRAW {
+		rules = lowballRuleset
+	}
+
+	var winners []int
+	var best eval.Hand
+	for player, cards := range g.hands {
+		if g.folded[player] {
+			continue
+		}
+		hand, err := rules.NewHand(slices.Clone(cards))
+		if err != nil {
+			return nil, err
+		}
+		c := 1
+		if best != nil {
+			c = hand.Compare(best)
+		}
+		switch {
+		case c > 0:
+			winners, best = []int{player}, hand
+		case c == 0:
+			winners = append(winners, player)
+		}
+	}
+	return winners, nil
+}
+
+func (g *Game) live() int {
+	var n int
+	for _, folded := range g.folded {
+		if !folded {
+			n++
+		}
+	}
+	return n
+}
+
+// OpensJacksOrBetter reports a five-card hand holding a pair of jacks or
+// better, as needed to open the betting in jackpots draw.
+func OpensJacksOrBetter(cards []card.Card) bool {
+	v := eval.Evaluate(cards)
+	return v.Rank() > eval.PAIR || (v.Rank() == eval.PAIR && v.Top() >= card.JACK)
+}
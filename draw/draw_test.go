@@ -0,0 +1,162 @@
+package draw
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/deck"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+func mustParseCards(t testing.TB, str string) []card.Card {
+	t.Helper()
+	cards, err := card.ParseCards(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cards
+}
+
+func TestOpensJacksOrBetter(t *testing.T) {
+	cases := []struct {
+		hand     string
+		expected bool
+	}{
+		{"J♡ J♧ 2♤ 5♢ 9♧", true},
+		{"10♡ 10♧ 2♤ 5♢ 9♧", false},
+		{"3♡ 3♧ 2♤ 2♢ 9♧", true},
+		{"A♡ K♧ Q♤ J♢ 9♧", false},
+	}
+
+	for _, tc := range cases {
+		if got := OpensJacksOrBetter(mustParseCards(t, tc.hand)); got != tc.expected {
+			t.Errorf("%s: expected %t, got %t", tc.hand, tc.expected, got)
+		}
+	}
+}
+
+func TestDrawGame(t *testing.T) {
+	g, err := NewGame(FIVE_CARD_DRAW, RESHUFFLE_MUCK, 3, 42, deck.SHUFFLE_V1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The same deal as the stud transcript for seed 42.
+	if got := card.Format(g.Hand(2)); got != "2♡ 8♢ 8♧ 4♤ 4♧" {
+		t.Errorf("unexpected deal: %s", got)
+	}
+
+	before := g.Hand(2)
+	if err := g.Draw(1, [][]int{{}, {0, 1, 2}, {0}}); err != nil {
+		t.Fatal(err)
+	}
+	after := g.Hand(2)
+	if after[0] == before[0] || !slices.Equal(after[1:], before[1:]) {
+		t.Errorf("expected only the first card replaced: %s to %s", card.Format(before), card.Format(after))
+	}
+	assertAllCards(t, g)
+
+	if err := g.Draw(0, [][]int{{}, {}, {}}); err == nil {
+		t.Error("expected error for a second draw")
+	}
+
+	winners, err := g.Winners()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var best eval.HandValue
+	for player := range 3 {
+		best = max(best, eval.Evaluate(g.Hand(player)))
+	}
+	for _, player := range winners {
+		if eval.Evaluate(g.Hand(player)) != best {
+			t.Errorf("player %d won without the best hand", player)
+		}
+	}
+}
+
+func TestDrawGameRejectsInvalidDiscards(t *testing.T) {
+	g, err := NewGame(DEUCE_TO_SEVEN_TRIPLE_DRAW, RESHUFFLE_MUCK, 2, 1, deck.SHUFFLE_V1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Hand(-1) != nil || g.Hand(2) != nil {
+		t.Error("expected no hand for a player not in the game")
+	}
+	for _, discards := range [][][]int{{{5}, {}}, {{1, 1}, {}}, {{}}} {
+		if err := g.Draw(0, discards); err == nil {
+			t.Errorf("expected error for discards %v", discards)
+		}
+	}
+}
+
+func TestDrawGameReshuffle(t *testing.T) {
+	// Ten players leave a stub of two cards.
+	discards := make([][]int, 10)
+	discards[0] = []int{0, 1, 2}
+
+	g, err := NewGame(DEUCE_TO_SEVEN_TRIPLE_DRAW, NO_RESHUFFLE, 10, 7, deck.SHUFFLE_V1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Fold(9); err != nil {
+		t.Fatal(err)
+	}
+	hand := g.Hand(1)
+	discards[1] = []int{0}
+	if err := g.Draw(1, discards); err == nil {
+		t.Error("expected error drawing past the stub without reshuffling")
+	}
+	// Player 1 drew before player 0 ran the stub out; the round is undone.
+	if !slices.Equal(g.Hand(1), hand) || g.deck.Remaining() != 2 || len(g.muck) != eval.CARDS_PER_HAND || g.DrawsLeft() != 3 {
+		t.Errorf("expected the failed round to change nothing, got hand %s, a stub of %d, a muck of %d and %d draws left",
+			card.Format(g.Hand(1)), g.deck.Remaining(), len(g.muck), g.DrawsLeft())
+	}
+	assertAllCards(t, g)
+	discards[1] = nil
+
+	g, err = NewGame(DEUCE_TO_SEVEN_TRIPLE_DRAW, RESHUFFLE_MUCK, 10, 7, deck.SHUFFLE_V1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	folded := g.Hand(9)
+	if err := g.Fold(9); err != nil {
+		t.Fatal(err)
+	}
+	for i := range 3 {
+		if err := g.Draw(0, discards); err != nil {
+			t.Fatal(err)
+		}
+		assertAllCards(t, g)
+		if i == 0 && !slices.ContainsFunc(g.Hand(0), func(c card.Card) bool { return slices.Contains(folded, c) }) {
+			t.Error("expected the folded hand to be reshuffled into the stub")
+		}
+	}
+	if g.DrawsLeft() != 0 {
+		t.Errorf("expected no draws left, got %d", g.DrawsLeft())
+	}
+
+	winners, err := g.Winners()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, player := range winners {
+		if player == 9 {
+			t.Error("folded player won")
+		}
+	}
+}
+
+// assertAllCards checks that every card is in exactly one place.
+func assertAllCards(t *testing.T, g *Game) {
+	t.Helper()
+	cards := append(g.deck.Cards(), g.muck...)
+	for player, hand := range g.hands {
+		if !g.folded[player] {
+			cards = append(cards, hand...)
+		}
+	}
+	if len(cards) != card.DECK_SIZE || eval.NewCardSet(cards...).Len() != card.DECK_SIZE {
+		t.Fatalf("expected every card once, found %d cards", len(cards))
+	}
+}
@@ -0,0 +1,187 @@
+// Package eval ranks poker hands. Evaluate and CardSet give every hand of
+// five to seven cards a HandValue, larger for stronger hands; the Hand
+// interface is what the classifiers of each hand category implement.
+package eval
+
+import (
+	"cmp"
+	"math/bits"
+
+	"github.com/sdeboni/go-poker/card"
+)
+
+// HandValue orders hands of five to seven cards by their best five-card hand;
+// a greater value is a better hand. The HandRank sits above bit 20, followed
+// by up to five significant ranks in four bits each: the made ranks first,
+// then kickers, highest first.
+type HandValue uint32
+
+func (v HandValue) Rank() HandRank {
+	return HandRank(v >> 20)
+}
+
+// Top is the most significant rank: the made ranks of pairs, trips and quads,
+// the high card of straights, flushes and high card hands.
+func (v HandValue) Top() card.Rank {
+	return card.Rank(v >> 16 & 0xf)
+}
+
+func (v HandValue) Compare(other HandValue) int {
+	return cmp.Compare(v, other)
+}
+
+// CardSet holds distinct cards as bits indexed by Card.Index.
+type CardSet uint64
+
+func NewCardSet(cards ...card.Card) CardSet {
+	var s CardSet
+	for _, c := range cards {
+		s = s.Add(c)
+	}
+	return s
+}
+
+func (s CardSet) Add(c card.Card) CardSet {
+	return s | 1<<c.Index()
+}
+
+func (s CardSet) Contains(c card.Card) bool {
+	return s&(1<<c.Index()) != 0
+}
+
+func (s CardSet) Len() int {
+	return bits.OnesCount64(uint64(s))
+}
+
+// Evaluate scores the best five-card hand in a set of five to seven cards
+// without allocating.
+func (s CardSet) Evaluate() HandValue {
+	var suitMasks [card.DIAMONDS + 1]uint16
+	for rest := uint64(s); rest != 0; rest &= rest - 1 {
+		index := bits.TrailingZeros64(rest)
+		suitMasks[card.BRIDGE_SUITS[index%4]] |= 1 << (card.TWO + card.Rank(index/4))
+	}
+	return EvaluateMasks(&suitMasks)
+}
+
+// Evaluate7 scores the best five-card hand out of seven distinct cards, such
+// as hole cards and a full board, without allocating.
+func Evaluate7(cards [7]card.Card) HandValue {
+	return Evaluate(cards[:])
+}
+
+func NewHandValue(rank HandRank, ranks ...card.Rank) HandValue {
+	v := HandValue(rank) << 20
+	for i, r := range ranks {
+		v |= HandValue(r) << (16 - 4*i)
+	}
+	return v
+}
+
+// Evaluate scores the best five-card hand out of cards without allocating.
+// Fewer than five cards score by their pairs and trips, then high cards.
+func Evaluate(cards []card.Card) HandValue {
+	var suitMasks [card.DIAMONDS + 1]uint16
+	for _, c := range cards {
+		suitMasks[c.Suit()] |= 1 << c.Rank()
+	}
+	return EvaluateMasks(&suitMasks)
+}
+
+// EvaluateMasks scores the cards given as the ranks held in each suit, bit r
+// of suitMasks[s] for the card of rank r and suit s.
+func EvaluateMasks(suitMasks *[card.DIAMONDS + 1]uint16) HandValue {
+	var counts [card.ACE + 1]int
+	var rankMask uint16
+	for _, mask := range suitMasks {
+		rankMask |= mask
+		for rest := mask; rest != 0; rest &= rest - 1 {
+			counts[bits.TrailingZeros16(rest)]++
+		}
+	}
+
+	for _, mask := range suitMasks {
+		if bits.OnesCount16(mask) >= 5 {
+			if high := straightHigh(mask); high != 0 {
+				return NewHandValue(STRAIGHT_FLUSH, high)
+			}
+			var ranks [5]card.Rank
+			topRanks(mask, ranks[:])
+			return NewHandValue(FLUSH, ranks[:]...)
+		}
+	}
+
+	var quad, trips, highPair, lowPair card.Rank
+	for r := card.ACE; r >= card.TWO; r-- {
+		switch counts[r] {
+		case 4:
+			quad = r
+		case 3:
+			if trips == 0 {
+				trips = r
+			} else if highPair == 0 {
+				highPair = r
+			}
+		case 2:
+			if highPair == 0 {
+				highPair = r
+			} else if lowPair == 0 {
+				lowPair = r
+			}
+		}
+	}
+
+	var kickers [5]card.Rank
+	switch {
+	case quad != 0:
+		topRanks(rankMask&^(1<<quad), kickers[:1])
+		return NewHandValue(FOUR_OF_A_KIND, quad, kickers[0])
+	case trips != 0 && highPair != 0:
+		return NewHandValue(FULL_HOUSE, trips, highPair)
+	}
+
+	if high := straightHigh(rankMask); high != 0 {
+		return NewHandValue(STRAIGHT, high)
+	}
+
+	switch {
+	case trips != 0:
+		topRanks(rankMask&^(1<<trips), kickers[:2])
+		return NewHandValue(THREE_OF_A_KIND, trips, kickers[0], kickers[1])
+	case lowPair != 0:
+		topRanks(rankMask&^(1<<highPair|1<<lowPair), kickers[:1])
+		return NewHandValue(TWO_PAIR, highPair, lowPair, kickers[0])
+	case highPair != 0:
+		topRanks(rankMask&^(1<<highPair), kickers[:3])
+		return NewHandValue(PAIR, highPair, kickers[0], kickers[1], kickers[2])
+	default:
+		topRanks(rankMask, kickers[:])
+		return NewHandValue(HIGH_CARD, kickers[:]...)
+	}
+}
+
+// straightHigh returns the high card of the best straight in mask, FIVE for
+// the wheel, or 0 if there is none.
+func straightHigh(mask uint16) card.Rank {
+	if mask&(1<<card.ACE) != 0 {
+		mask |= 1 << 1
+	}
+	for high := card.ACE; high >= card.FIVE; high-- {
+		run := uint16(0x1f) << (high - 4)
+		if mask&run == run {
+			return high
+		}
+	}
+	return 0
+}
+
+// topRanks fills ranks with the highest ranks set in mask.
+func topRanks(mask uint16, ranks []card.Rank) {
+	i := 0
+	for r := card.ACE; r >= card.TWO && i < len(ranks); r-- {
+		if mask&(1<<r) != 0 {
+			ranks[i] = r
+			i++
+		}
+	}
+}
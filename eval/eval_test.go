@@ -0,0 +1,105 @@
+package eval
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/deck"
+)
+
+func randomSevens(n int) [][7]card.Card {
+	rng := rand.New(rand.NewPCG(21, 34))
+	deck := deck.New().Cards()
+	sevens := make([][7]card.Card, n)
+	for i := range sevens {
+		rng.Shuffle(len(deck), func(i, j int) {
+			deck[i], deck[j] = deck[j], deck[i]
+		})
+		copy(sevens[i][:], deck)
+	}
+	return sevens
+}
+
+func mustParseCards(t testing.TB, str string) []card.Card {
+	t.Helper()
+	cards, err := card.ParseCards(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cards
+}
+
+func TestEvaluatePicksBestFive(t *testing.T) {
+	cases := []struct {
+		description string
+		cards       string
+		expected    string
+	}{
+		{"straight flush over quads", "9♡ 10♡ J♡ Q♡ K♡ K♤ K♧", "9♡ 10♡ J♡ Q♡ K♡"},
+		{"wheel straight", "A♡ 2♤ 3♧ 4♢ 5♡ 9♤ J♧", "A♡ 2♤ 3♧ 4♢ 5♡"},
+		{"best flush of six suited cards", "2♡ 4♡ 6♡ 8♡ 10♡ Q♡ A♤", "4♡ 6♡ 8♡ 10♡ Q♡"},
+		{"full house from two trips", "3♡ 3♤ 3♧ 7♢ 7♡ 7♤ 2♧", "7♢ 7♡ 7♤ 3♡ 3♤"},
+		{"two pair with best kicker from a third pair", "3♡ 3♤ 5♧ 5♢ 9♡ 9♤ 2♧", "9♡ 9♤ 5♧ 5♢ 3♡"},
+		{"trips with two kickers", "8♡ 8♤ 8♧ 2♢ 4♡ 6♤ K♧", "8♡ 8♤ 8♧ K♧ 6♤"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			got, expected := Evaluate(mustParseCards(t, tc.cards)), Evaluate(mustParseCards(t, tc.expected))
+			if got != expected {
+				t.Errorf("expected %x, got %x", expected, got)
+			}
+		})
+	}
+}
+
+func TestCardSetEvaluate(t *testing.T) {
+	for _, cards := range randomSevens(20000) {
+		set := NewCardSet(cards[:]...)
+		if set.Len() != 7 || !set.Contains(cards[6]) {
+			t.Fatalf("set %x does not hold %v", set, cards)
+		}
+		if got, expected := set.Evaluate(), Evaluate7(cards); got != expected {
+			t.Fatalf("%s: set evaluated to %x, cards to %x", card.Format(cards[:]), got, expected)
+		}
+	}
+}
+
+func TestEvaluateDoesNotAllocate(t *testing.T) {
+	cards := randomSevens(1)[0]
+	var sink HandValue
+
+	if allocs := testing.AllocsPerRun(100, func() {
+		sink = Evaluate7(cards)
+	}); allocs != 0 {
+		t.Errorf("Evaluate7 allocated %.0f times", allocs)
+	}
+	if allocs := testing.AllocsPerRun(100, func() {
+		sink = NewCardSet(cards[:]...).Evaluate()
+	}); allocs != 0 {
+		t.Errorf("CardSet.Evaluate allocated %.0f times", allocs)
+	}
+	_ = sink
+}
+
+func BenchmarkEvaluate7(b *testing.B) {
+	sevens := randomSevens(1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := range b.N {
+		Evaluate7(sevens[i%len(sevens)])
+	}
+}
+
+func BenchmarkCardSetEvaluate(b *testing.B) {
+	sets := make([]CardSet, 1000)
+	for i, cards := range randomSevens(len(sets)) {
+		sets[i] = NewCardSet(cards[:]...)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := range b.N {
+		sets[i%len(sets)].Evaluate()
+	}
+}
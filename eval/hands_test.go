@@ -0,0 +1,36 @@
+package eval_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/sdeboni/go-poker"
+	"github.com/sdeboni/go-poker/deck"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+// The evaluator must rank and order hands as the classifiers behind
+// poker.ParseHand do.
+func TestEvaluateAgreesWithHands(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	cards := deck.New().Cards()
+	rules := poker.DefaultRuleset()
+
+	for range 20000 {
+		rng.Shuffle(len(cards), func(i, j int) {
+			cards[i], cards[j] = cards[j], cards[i]
+		})
+		a, b := cards[:5], cards[5:10]
+		ha, _ := rules.NewHand(slices.Clone(a))
+		hb, _ := rules.NewHand(slices.Clone(b))
+		va, vb := eval.Evaluate(a), eval.Evaluate(b)
+
+		if va.Rank() != ha.Rank() {
+			t.Fatalf("%s: evaluate ranked %d, hand ranked %d", ha.String(), va.Rank(), ha.Rank())
+		}
+		if got, expected := va.Compare(vb), ha.Compare(hb); got != expected {
+			t.Fatalf("%s vs %s: evaluate compared %d, hands compared %d", ha.String(), hb.String(), got, expected)
+		}
+	}
+}
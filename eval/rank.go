@@ -0,0 +1,87 @@
+package eval
+
+import (
+	"fmt"
+
+	"github.com/sdeboni/go-poker/card"
+)
+
+// CARDS_PER_HAND is the size of a poker hand.
+const CARDS_PER_HAND = 5
+
+type HandRank int
+
+const (
+	HIGH_CARD HandRank = iota + 1
+	PAIR
+	TWO_PAIR
+	THREE_OF_A_KIND
+	STRAIGHT
+	FLUSH
+	FULL_HOUSE
+	FOUR_OF_A_KIND
+	STRAIGHT_FLUSH
+	// FOUR_FLUSH and FOUR_STRAIGHT are the Sökö hands of four cards of a
+	// suit and four in sequence. Their values do not order them; SokoRuleset
+	// does.
+	FOUR_FLUSH
+	FOUR_STRAIGHT
+)
+
+func (r HandRank) String() string {
+	switch r {
+	case HIGH_CARD:
+		return "high card"
+	case PAIR:
+		return "pair"
+	case TWO_PAIR:
+		return "two pair"
+	case THREE_OF_A_KIND:
+		return "three of a kind"
+	case STRAIGHT:
+		return "straight"
+	case FLUSH:
+		return "flush"
+	case FULL_HOUSE:
+		return "full house"
+	case FOUR_OF_A_KIND:
+		return "four of a kind"
+	case STRAIGHT_FLUSH:
+		return "straight flush"
+	case FOUR_FLUSH:
+		return "four flush"
+	case FOUR_STRAIGHT:
+		return "four straight"
+	default:
+		return fmt.Sprintf("HandRank(%d)", int(r))
+	}
+}
+
+// MarshalText writes the rank by name, as in JSON exports.
+func (r HandRank) MarshalText() ([]byte, error) {
+	if r < HIGH_CARD || r > FOUR_STRAIGHT {
+		return nil, fmt.Errorf("invalid hand rank: %d", int(r))
+	}
+	return []byte(r.String()), nil
+}
+
+func (r *HandRank) UnmarshalText(text []byte) error {
+	for rank := HIGH_CARD; rank <= FOUR_STRAIGHT; rank++ {
+		if rank.String() == string(text) {
+			*r = rank
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid hand rank: %q", text)
+}
+
+type Hand interface {
+	Compare(Hand) int
+	Cards() []card.Card
+	Rank() HandRank
+	// MainRank is the rank of the cards that define the hand within its
+	// category: the quads of four of a kind, the trips of a full house, the
+	// top card of a straight or a flush.
+	MainRank() card.Rank
+	String() string
+}
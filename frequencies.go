@@ -0,0 +1,298 @@
+package poker
+
+import (
+	"cmp"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"maps"
+	"math/bits"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+	"github.com/sdeboni/go-poker/internal/deal"
+	"github.com/sdeboni/go-poker/internal/suitclass"
+)
+
+// Frequencies counts every hand of a size dealt from a ruleset's deck by the
+// best five-card hand it holds, per category and per equivalence class, the
+// hands that tie one another. It marshals to JSON as is.
+type Frequencies struct {
+	Ruleset string `json:"ruleset"`
+	// Known lists the cards every counted hand holds, for conditional odds.
+	Known    []string `json:"known,omitempty"`
+	HandSize int      `json:"handSize"`
+	Hands    int64    `json:"hands"`
+	// Ranks and Classes are ordered best first.
+	Ranks   []RankFrequency  `json:"ranks"`
+	Classes []ClassFrequency `json:"classes"`
+}
+
+type RankFrequency struct {
+	Rank  eval.HandRank `json:"rank"`
+	Count int64         `json:"count"`
+	// Classes is the number of equivalence classes in the category.
+	Classes int `json:"classes"`
+}
+
+type ClassFrequency struct {
+	Rank eval.HandRank `json:"rank"`
+	// Ranks are the ranks that break ties within the category, made ranks
+	// first: "A K" for aces full of kings, "9" for a nine-high straight.
+	Ranks string `json:"ranks"`
+	Count int64  `json:"count"`
+}
+
+// HandFrequencies enumerates every hand of handSize cards. The ruleset must
+// use the nine standard categories, in any order.
+func HandFrequencies(r *Ruleset, handSize int) (*Frequencies, error) {
+	e, err := newRulesetEvaluator(r)
+	if err != nil {
+		return nil, err
+	}
+	if handSize < 1 || handSize > e.deckSize() {
+		return nil, fmt.Errorf("invalid hand size: %d", handSize)
+	}
+	classes := make(map[eval.HandValue]int64)
+	suitclass.ForEach(handSize, r.lowestRank(), func(suitMasks *[card.DIAMONDS + 1]uint16, count int64) {
+		classes[e.best(suitMasks, handSize)] += count
+	})
+	return e.frequencies(nil, handSize, classes), nil
+}
+
+// ConditionalFrequencies enumerates every hand of handSize cards holding the
+// known cards, such as the chance of a flush by the river with two suited
+// hole cards.
+func ConditionalFrequencies(r *Ruleset, known []card.Card, handSize int) (*Frequencies, error) {
+	e, err := newRulesetEvaluator(r)
+	if err != nil {
+		return nil, err
+	}
+	if handSize < max(len(known), 1) || handSize > e.deckSize() {
+		return nil, fmt.Errorf("invalid hand size: %d", handSize)
+	}
+	if err := deal.Distinct(known...); err != nil {
+		return nil, err
+	}
+	var knownMasks [card.DIAMONDS + 1]uint16
+	for _, c := range known {
+		if c == card.JOKER || c.Rank() < r.lowestRank() {
+			return nil, fmt.Errorf("card %s is not in the %s deck", c.String(), r.Name)
+		}
+		knownMasks[c.Suit()] |= 1 << c.Rank()
+	}
+	var live []card.Card
+	for i := range card.DECK_SIZE {
+		c, _ := card.FromIndex(i)
+		if c.Rank() >= r.lowestRank() && knownMasks[c.Suit()]&(1<<c.Rank()) == 0 {
+			live = append(live, c)
+		}
+	}
+
+	classes := make(map[eval.HandValue]int64)
+	draw := make([]int, handSize-len(known))
+	deal.ForEachCombination(len(live), draw, func() {
+		masks := knownMasks
+		for _, i := range draw {
+			masks[live[i].Suit()] |= 1 << live[i].Rank()
+		}
+		classes[e.best(&masks, handSize)]++
+	})
+	return e.frequencies(known, handSize, classes), nil
+}
+
+// Probability is the chance that a hand's best is of the rank.
+func (f *Frequencies) Probability(rank eval.HandRank) float64 {
+	for _, r := range f.Ranks {
+		if r.Rank == rank {
+			return float64(r.Count) / float64(f.Hands)
+		}
+	}
+	return 0
+}
+
+// AtLeast is the chance that a hand's best is of the rank or better.
+func (f *Frequencies) AtLeast(rank eval.HandRank) float64 {
+	var count int64
+	for _, r := range f.Ranks {
+		count += r.Count
+		if r.Rank == rank {
+			return float64(count) / float64(f.Hands)
+		}
+	}
+	return 0
+}
+
+// WriteRanksCSV writes a row per category, best first.
+func (f *Frequencies) WriteRanksCSV(w io.Writer) error {
+	rows := [][]string{{"rank", "count", "classes", "probability"}}
+	for _, r := range f.Ranks {
+		rows = append(rows, []string{r.Rank.String(), strconv.FormatInt(r.Count, 10), strconv.Itoa(r.Classes), f.probability(r.Count)})
+	}
+	return csv.NewWriter(w).WriteAll(rows)
+}
+
+// WriteClassesCSV writes a row per equivalence class, best first, numbered
+// from 1.
+func (f *Frequencies) WriteClassesCSV(w io.Writer) error {
+	rows := [][]string{{"class", "rank", "ranks", "count", "probability"}}
+	for i, c := range f.Classes {
+		rows = append(rows, []string{strconv.Itoa(i + 1), c.Rank.String(), c.Ranks, strconv.FormatInt(c.Count, 10), f.probability(c.Count)})
+	}
+	return csv.NewWriter(w).WriteAll(rows)
+}
+
+func (f *Frequencies) probability(count int64) string {
+	return strconv.FormatFloat(float64(count)/float64(f.Hands), 'g', -1, 64)
+}
+
+// rulesetEvaluator scores hands with evaluateMasks for a ruleset made of the
+// standard categories, whatever their order, deck or wheel and kicker rules.
+type rulesetEvaluator struct {
+	rules *Ruleset
+	// order[rank] is the category's place counting up from the worst.
+	order [eval.STRAIGHT_FLUSH + 1]eval.HandValue
+	// standard rulesets score any number of cards with evaluateMasks alone.
+	standard bool
+}
+
+func newRulesetEvaluator(r *Ruleset) (*rulesetEvaluator, error) {
+	e := &rulesetEvaluator{rules: r}
+	for i, c := range r.Categories {
+		if c.Rank < eval.HIGH_CARD || c.Rank > eval.STRAIGHT_FLUSH || e.order[c.Rank] != 0 {
+			return nil, fmt.Errorf("%s: cannot count %s hands", r.Name, c.Rank)
+		}
+		e.order[c.Rank] = eval.HandValue(len(r.Categories) - i)
+	}
+	if len(r.Categories) != int(eval.STRAIGHT_FLUSH) {
+		return nil, fmt.Errorf("%s: expected the %d standard categories, found %d", r.Name, eval.STRAIGHT_FLUSH, len(r.Categories))
+	}
+	e.standard = r.lowestRank() == card.TWO && r.Wheel == ACE_LOW_WHEEL && r.Kickers == KICKERS_PLAY && !r.Low
+	for rank := eval.HIGH_CARD; rank <= eval.STRAIGHT_FLUSH; rank++ {
+		e.standard = e.standard && e.order[rank] == eval.HandValue(rank)
+	}
+	return e, nil
+}
+
+func (e *rulesetEvaluator) deckSize() int {
+	return int(card.ACE-e.rules.lowestRank()+1) * 4
+}
+
+// value scores up to five cards. The wheel is the ace with the four lowest
+// ranks of the deck, if the ruleset allows it, and kickers that do not play
+// are dropped.
+func (e *rulesetEvaluator) value(suitMasks *[card.DIAMONDS + 1]uint16) eval.HandValue {
+	var rankMask uint16
+	var flush bool
+	for _, mask := range suitMasks {
+		rankMask |= mask
+		flush = flush || bits.OnesCount16(mask) == eval.CARDS_PER_HAND
+	}
+	lowest := e.rules.lowestRank()
+	wheel := []card.Rank{card.ACE, lowest + 3, lowest + 2, lowest + 1, lowest}
+
+	var v eval.HandValue
+	switch {
+	case rankMask != 1<<card.ACE|0xf<<lowest:
+		v = eval.EvaluateMasks(suitMasks)
+	case e.rules.Wheel == NO_WHEEL && flush:
+		v = eval.NewHandValue(eval.FLUSH, wheel...)
+	case e.rules.Wheel == NO_WHEEL:
+		v = eval.NewHandValue(eval.HIGH_CARD, wheel...)
+	case flush:
+		v = eval.NewHandValue(eval.STRAIGHT_FLUSH, lowest+3)
+	default:
+		v = eval.NewHandValue(eval.STRAIGHT, lowest+3)
+	}
+	if e.rules.Kickers == NO_KICKERS {
+		if n := madeRanks[v.Rank()]; n > 0 {
+			v &^= 1<<(20-4*n) - 1
+		}
+	}
+	return v
+}
+
+// madeRanks is the number of ranks of a value that make its category, for
+// the categories that have kickers.
+var madeRanks = [eval.STRAIGHT_FLUSH + 1]int{eval.PAIR: 1, eval.TWO_PAIR: 2, eval.THREE_OF_A_KIND: 1, eval.FOUR_OF_A_KIND: 1}
+
+// score orders values by the ruleset, greater is better.
+func (e *rulesetEvaluator) score(v eval.HandValue) eval.HandValue {
+	s := e.order[v.Rank()]<<20 | v&(1<<20-1)
+	if e.rules.Low {
+		return ^s
+	}
+	return s
+}
+
+// best scores the best five-card hand of size cards.
+func (e *rulesetEvaluator) best(suitMasks *[card.DIAMONDS + 1]uint16, size int) eval.HandValue {
+	if e.standard {
+		return eval.EvaluateMasks(suitMasks)
+	}
+	if size <= eval.CARDS_PER_HAND {
+		return e.value(suitMasks)
+	}
+	var cards []card.Card
+	for suit := card.HEARTS; suit <= card.DIAMONDS; suit++ {
+		for rank := card.TWO; rank <= card.ACE; rank++ {
+			if suitMasks[suit]&(1<<rank) != 0 {
+				cards = append(cards, card.New(rank, suit))
+			}
+		}
+	}
+	var best eval.HandValue
+	var five [eval.CARDS_PER_HAND]int
+	deal.ForEachCombination(size, five[:], func() {
+		var masks [card.DIAMONDS + 1]uint16
+		for _, i := range five {
+			masks[cards[i].Suit()] |= 1 << cards[i].Rank()
+		}
+		if v := e.value(&masks); best == 0 || e.score(v) > e.score(best) {
+			best = v
+		}
+	})
+	return best
+}
+
+func (e *rulesetEvaluator) frequencies(known []card.Card, handSize int, classes map[eval.HandValue]int64) *Frequencies {
+	f := &Frequencies{Ruleset: e.rules.Name, HandSize: handSize}
+	for _, c := range known {
+		f.Known = append(f.Known, c.String())
+	}
+
+	for _, c := range e.rules.Categories {
+		f.Ranks = append(f.Ranks, RankFrequency{Rank: c.Rank})
+	}
+	slices.SortFunc(f.Ranks, func(a, b RankFrequency) int {
+		return cmp.Compare(e.score(eval.HandValue(b.Rank)<<20), e.score(eval.HandValue(a.Rank)<<20))
+	})
+	values := slices.SortedFunc(maps.Keys(classes), func(a, b eval.HandValue) int {
+		return cmp.Compare(e.score(b), e.score(a))
+	})
+	for _, v := range values {
+		count := classes[v]
+		f.Hands += count
+		f.Classes = append(f.Classes, ClassFrequency{v.Rank(), valueRanks(v), count})
+		i := slices.IndexFunc(f.Ranks, func(r RankFrequency) bool {
+			return r.Rank == v.Rank()
+		})
+		f.Ranks[i].Count += count
+		f.Ranks[i].Classes++
+	}
+	return f
+}
+
+// valueRanks lists the significant ranks of a value, highest first.
+func valueRanks(v eval.HandValue) string {
+	var ranks []string
+	for i := range eval.CARDS_PER_HAND {
+		if rank := card.Rank(v >> (16 - 4*i) & 0xf); rank != 0 {
+			ranks = append(ranks, rank.String())
+		}
+	}
+	return strings.Join(ranks, " ")
+}
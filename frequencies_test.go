@@ -0,0 +1,146 @@
+package poker
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+func mustParseCards(t testing.TB, str string) []card.Card {
+	t.Helper()
+	cards, err := card.ParseCards(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cards
+}
+
+func TestHandFrequencies(t *testing.T) {
+	cases := []struct {
+		description string
+		ruleset     *Ruleset
+		handSize    int
+		classes     int
+		// counts best category first
+		counts []int64
+		best   string
+	}{
+		{"five cards", DefaultRuleset(), 5, 7462,
+			[]int64{40, 624, 3744, 5108, 10200, 54912, 123552, 1098240, 1302540}, "A"},
+		{"seven cards", DefaultRuleset(), 7, 4824,
+			[]int64{41584, 224848, 3473184, 4047644, 6180020, 6461620, 31433400, 58627800, 23294460}, "A"},
+		{"short deck", ShortDeckRuleset(), 5, 0,
+			[]int64{24, 288, 480, 1728, 16128, 6120, 36288, 193536, 122400}, "A"},
+		{"short deck seven cards", ShortDeckRuleset(), 7, 762,
+			[]int64{10560, 44640, 175560, 633024, 637560, 1139580, 3157056, 2316600, 233100}, "A"},
+		{"Kansas City lowball", KansasCityRuleset(), 5, 7462,
+			[]int64{1302540 + 1020, 1098240, 123552, 54912, 9180, 5112, 3744, 624, 36}, "7 5 4 3 2"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			if testing.Short() && tc.handSize > 5 {
+				t.Skip("enumerates every seven-card hand")
+			}
+			f, err := HandFrequencies(tc.ruleset, tc.handSize)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var hands int64
+			for i, r := range f.Ranks {
+				if r.Count != tc.counts[i] {
+					t.Errorf("%s: expected %d hands, got %d", r.Rank, tc.counts[i], r.Count)
+				}
+				hands += r.Count
+			}
+			if f.Hands != hands {
+				t.Errorf("expected %d hands in all, got %d", hands, f.Hands)
+			}
+			if tc.classes != 0 && len(f.Classes) != tc.classes {
+				t.Errorf("expected %d classes, got %d", tc.classes, len(f.Classes))
+			}
+			if f.Classes[0].Ranks != tc.best {
+				t.Errorf("expected the best class to be %s, got %s", tc.best, f.Classes[0].Ranks)
+			}
+		})
+	}
+}
+
+func TestConditionalFrequencies(t *testing.T) {
+	f, err := ConditionalFrequencies(DefaultRuleset(), mustParseCards(t, "A♡ K♡"), 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Three, four or five more hearts among the five cards to come, or five
+	// of another suit.
+	flushes := int64(165*741 + 330*39 + 462 + 3*1287)
+	if f.Hands != 2118760 {
+		t.Errorf("expected 2118760 hands, got %d", f.Hands)
+	}
+	if got := f.Ranks[0].Count + f.Ranks[3].Count; got != flushes {
+		t.Errorf("expected %d flushes, got %d", flushes, got)
+	}
+	var flushOrBetter int64
+	for _, r := range f.Ranks[:4] {
+		flushOrBetter += r.Count
+	}
+	if got := f.AtLeast(eval.FLUSH); got != float64(flushOrBetter)/float64(f.Hands) {
+		t.Errorf("expected a flush or better %d times, got %f", flushOrBetter, got)
+	}
+}
+
+func TestFrequenciesRejects(t *testing.T) {
+	if _, err := HandFrequencies(SokoRuleset(), 5); err == nil {
+		t.Error("expected Sökö categories to be rejected")
+	}
+	if _, err := HandFrequencies(DefaultRuleset(), 0); err == nil {
+		t.Error("expected an empty hand to be rejected")
+	}
+	if _, err := ConditionalFrequencies(ShortDeckRuleset(), mustParseCards(t, "A♡ 2♡"), 5); err == nil {
+		t.Error("expected a deuce to be rejected in short deck")
+	}
+	if _, err := ConditionalFrequencies(DefaultRuleset(), mustParseCards(t, "A♡ K♡ Q♡"), 2); err == nil {
+		t.Error("expected more known cards than the hand holds to be rejected")
+	}
+}
+
+func TestFrequenciesExport(t *testing.T) {
+	f, err := HandFrequencies(DefaultRuleset(), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ranks, classes bytes.Buffer
+	if err := f.WriteRanksCSV(&ranks); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.WriteClassesCSV(&classes); err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Split(ranks.String(), "\n"); lines[1] != "straight flush,40,10,1.5390771693292702e-05" || len(lines) != 11 {
+		t.Errorf("unexpected ranks CSV:\n%s", ranks.String())
+	}
+	if lines := strings.Split(classes.String(), "\n"); lines[1] != "1,straight flush,A,4,1.5390771693292702e-06" || len(lines) != 7464 {
+		t.Errorf("unexpected classes CSV starting %q", lines[:2])
+	}
+
+	data, err := json.Marshal(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"rank":"full house","ranks":"A K","count":24`) {
+		t.Errorf("expected aces full of kings in %.200s", data)
+	}
+	var decoded Frequencies
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&decoded, f) {
+		t.Error("JSON did not round trip")
+	}
+}
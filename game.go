@@ -1,83 +1,32 @@
+// Package poker compares five-card hands: parsing them, ranking a showdown,
+// the rulesets of variants and the odds of each category. The games built on
+// it live in their own packages: table, tournament, draw, ofc and casino.
 package poker
 
 import (
-	"cmp"
 	"fmt"
+	"maps"
 	"slices"
 	"strings"
-)
-
-type CardRank int
-
-const (
-	TWO CardRank = iota + 2
-	THREE
-	FOUR
-	FIVE
-	SIX
-	SEVEN
-	EIGHT
-	NINE
-	TEN
-	JACK
-	QUEEN
-	KING
-	ACE
-)
-
-type Suit int
 
-const (
-	HEARTS Suit = iota + 1
-	CLUBS
-	SPADES
-	DIAMONDS
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
 )
 
-type HandRank int
-
-const (
-	HIGH_CARD HandRank = iota + 1
-	PAIR
-	TWO_PAIR
-	THREE_OF_A_KIND
-	STRAIGHT
-	FLUSH
-	FULL_HOUSE
-	FOUR_OF_A_KIND
-	STRAIGHT_FLUSH
-)
-
-type Hand interface {
-	Compare(Hand) int
-	Cards() []Card
-	Rank() HandRank
-	String() string
-}
-
-type Card struct {
-	rank CardRank
-	suit Suit
-}
-
-func (c *Card) String() string {
-	return cardRankToString(c.rank) + string(suitToRune(c.suit))
-}
-
 func BestHand(str []string) ([]string, error) {
-	hands, err := parseHands(str)
+	if len(str) == 0 {
+		return nil, fmt.Errorf("no hands given")
+	}
+	hands, err := parseDistinctHands(str)
 	if err != nil {
 		return nil, err
 	}
+	return bestHands(hands), nil
+}
 
-	cardCounts := getCountsByCard(hands)
-	for card, count := range cardCounts {
-		if count > 1 {
-			return nil, fmt.Errorf("card %s used %d times", card.String(), count)
-		}
-	}
-
-	slices.SortStableFunc(hands, func(a, b Hand) int {
+// bestHands returns the hands tied for best, in input order.
+func bestHands(hands []eval.Hand) []string {
+	slices.SortStableFunc(hands, func(a, b eval.Hand) int {
 		return -a.Compare(b)
 	})
 
@@ -91,238 +40,148 @@ func BestHand(str []string) ([]string, error) {
 		}
 	}
 
-	return result, nil
+	return result
 }
 
-func parseHands(arr []string) ([]Hand, error) {
-	hands := make([]Hand, 0, len(arr))
-
-	for _, str := range arr {
-		if hand, err := parseHand(str); err != nil {
-			return nil, err
-		} else {
-			hands = append(hands, hand)
-		}
-	}
-	return hands, nil
+type RankedHand struct {
+	// Index is the hand's position in the input.
+	Index int
+	Hand  string
 }
 
-func parseHand(str string) (Hand, error) {
-	str = strings.TrimSpace(str)
-
-	cards, err := parseCards(str)
+// RankHands orders all hands into tiers of tied hands, best tier first.
+// Hands within a tier keep their input order.
+func RankHands(str []string) ([][]RankedHand, error) {
+	hands, err := parseDistinctHands(str)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(cards) != 5 {
-		return nil, fmt.Errorf("invalid hand '%s': expected 5 cards, found: %d", str, len(cards))
+	var result [][]RankedHand
+	for _, tier := range rankTiers(hands) {
+		ranked := make([]RankedHand, 0, len(tier))
+		for _, index := range tier {
+			ranked = append(ranked, RankedHand{index, hands[index].String()})
+		}
+		result = append(result, ranked)
 	}
+	return result, nil
+}
 
-	unsortedNormalFormHand := normalFormHand(cards)
-
-	slices.SortFunc(cards, func(a, b Card) int {
-		return cmp.Compare(a.rank, b.rank)
+// rankTiers groups the indexes of hands into tiers of tied hands, best first.
+func rankTiers(hands []eval.Hand) [][]int {
+	order := make([]int, len(hands))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return -hands[a].Compare(hands[b])
 	})
 
-	if hand := newStraightFlush(unsortedNormalFormHand, cards); hand != nil {
-		return hand, nil
-	}
-	if hand := newFourOfAKind(unsortedNormalFormHand, cards); hand != nil {
-		return hand, nil
-	}
-	if hand := newFullHouse(unsortedNormalFormHand, cards); hand != nil {
-		return hand, nil
-	}
-	if hand := newFlush(unsortedNormalFormHand, cards); hand != nil {
-		return hand, nil
-	}
-	if hand := newStraight(unsortedNormalFormHand, cards); hand != nil {
-		return hand, nil
-	}
-	if hand := newThreeOfAKind(unsortedNormalFormHand, cards); hand != nil {
-		return hand, nil
-	}
-	if hand := newTwoPair(unsortedNormalFormHand, cards); hand != nil {
-		return hand, nil
-	}
-	if hand := newPair(unsortedNormalFormHand, cards); hand != nil {
-		return hand, nil
+	var tiers [][]int
+	for i, index := range order {
+		if i == 0 || hands[order[i-1]].Compare(hands[index]) != 0 {
+			tiers = append(tiers, nil)
+		}
+		tiers[len(tiers)-1] = append(tiers[len(tiers)-1], index)
 	}
-	return newHighCard(unsortedNormalFormHand, cards), nil
+	return tiers
 }
 
-func parseCards(hand string) ([]Card, error) {
-	strCards := strings.Split(hand, " ")
-	cards := make([]Card, 0, len(strCards))
+type PlayerID string
 
-	cardCounts := make(map[Card]int)
+type Winner struct {
+	Player PlayerID
+	// Share is the fraction of the pot the player wins.
+	Share float64
+}
 
-	for _, str := range strCards {
-		if isEmpty(str) {
-			continue
-		}
-		card, err := parseCard(str)
-		if err != nil {
-			return nil, err
+// Winners returns the players holding the best hand, ordered by id, with the
+// share of the pot each receives when it is split between them.
+func Winners(hands map[PlayerID]eval.Hand) ([]Winner, error) {
+	if len(hands) == 0 {
+		return nil, fmt.Errorf("no hands given")
+	}
+
+	players := slices.Sorted(maps.Keys(hands))
+	ordered := make([]eval.Hand, 0, len(players))
+	for _, player := range players {
+		if hands[player] == nil {
+			return nil, fmt.Errorf("player %s has no hand", player)
 		}
-		if _, ok := cardCounts[card]; ok {
-			return nil, fmt.Errorf("invalid hand %s: duplicate card %s found", hand, str)
-		} else {
-			cardCounts[card] = 1
+		ordered = append(ordered, hands[player])
+	}
+	for card, count := range getCountsByCard(ordered) {
+		if count > 1 {
+			return nil, fmt.Errorf("card %s used %d times", card.String(), count)
 		}
-		cards = append(cards, card)
 	}
-	return cards, nil
-}
 
-func normalFormHand(cards []Card) string {
-	var sb strings.Builder
-	sb.WriteString(cards[0].String())
-	for _, card := range cards[1:] {
-		sb.WriteString(" ")
-		sb.WriteString(card.String())
+	best := rankTiers(ordered)[0]
+	winners := make([]Winner, 0, len(best))
+	for _, index := range best {
+		winners = append(winners, Winner{players[index], 1 / float64(len(best))})
 	}
-	return sb.String()
+	return winners, nil
 }
 
-func isEmpty(str string) bool {
-	return len(strings.TrimSpace(str)) == 0
+func ParseHand(str string) (eval.Hand, error) {
+	return parseHand(str)
 }
 
-func parseCard(str string) (card Card, err error) {
-	var rank CardRank
-	var suit Suit
-
-	chars := []rune(str)
+// parseDistinctHands parses hands that must not share any card.
+func parseDistinctHands(arr []string) ([]eval.Hand, error) {
+	hands, err := parseHands(arr)
+	if err != nil {
+		return nil, err
+	}
 
-	switch len(chars) {
-	case 2:
-		if rank, err = getCardRank(chars[0:1]); err == nil {
-			suit, err = getSuit(chars[1])
-		}
-	case 3:
-		if rank, err = getCardRank(chars[0:2]); err == nil {
-			suit, err = getSuit(chars[2])
+	cardCounts := getCountsByCard(hands)
+	for card, count := range cardCounts {
+		if count > 1 {
+			return nil, fmt.Errorf("card %s used %d times", card.String(), count)
 		}
-	default:
-		err = fmt.Errorf("invalid card: '%s'", str)
 	}
-
-	card = Card{rank, suit}
-	return
+	return hands, nil
 }
 
-func getCardRank(chars []rune) (rank CardRank, err error) {
-	if len(chars) == 2 {
-		if chars[0] == '1' && chars[1] == '0' {
-			rank = TEN
+func parseHands(arr []string) ([]eval.Hand, error) {
+	hands := make([]eval.Hand, 0, len(arr))
+
+	for _, str := range arr {
+		if hand, err := parseHand(str); err != nil {
+			return nil, err
 		} else {
-			err = fmt.Errorf("invalid card rank (length %d): '%s'", len(chars), string(chars))
+			hands = append(hands, hand)
 		}
-		return
-	} else if len(chars) != 1 {
-		err = fmt.Errorf("invalid card rank (length %d) : '%s'", len(chars), string(chars))
-		return
-	}
-
-	switch chars[0] {
-	case '2':
-		rank = TWO
-	case '3':
-		rank = THREE
-	case '4':
-		rank = FOUR
-	case '5':
-		rank = FIVE
-	case '6':
-		rank = SIX
-	case '7':
-		rank = SEVEN
-	case '8':
-		rank = EIGHT
-	case '9':
-		rank = NINE
-	case 'J':
-		rank = JACK
-	case 'Q':
-		rank = QUEEN
-	case 'K':
-		rank = KING
-	case 'A':
-		rank = ACE
-	default:
-		err = fmt.Errorf("invalid card rank: %c", chars[0])
 	}
-	return
+	return hands, nil
 }
 
-func cardRankToString(rank CardRank) string {
-	switch rank {
-	case TWO:
-		return "2"
-	case THREE:
-		return "3"
-	case FOUR:
-		return "4"
-	case FIVE:
-		return "5"
-	case SIX:
-		return "6"
-	case SEVEN:
-		return "7"
-	case EIGHT:
-		return "8"
-	case NINE:
-		return "9"
-	case TEN:
-		return "10"
-	case JACK:
-		return "J"
-	case QUEEN:
-		return "Q"
-	case KING:
-		return "K"
-	case ACE:
-		return "A"
-	default:
-		panic("invalid CardRank")
+func parseHand(str string) (eval.Hand, error) {
+	str = strings.TrimSpace(str)
+
+	cards, err := card.ParseCards(str)
+	if err != nil {
+		return nil, err
 	}
-}
 
-func getSuit(char rune) (suit Suit, err error) {
-	switch char {
-	case '♤':
-		suit = SPADES
-	case '♡':
-		suit = HEARTS
-	case '♧':
-		suit = CLUBS
-	case '♢':
-		suit = DIAMONDS
-	default:
-		err = fmt.Errorf("invalid suit: %c", char)
+	if len(cards) != 5 {
+		return nil, fmt.Errorf("invalid hand '%s': expected 5 cards, found: %d", str, len(cards))
 	}
-	return
+
+	return newHand(cards), nil
 }
 
-func suitToRune(suit Suit) rune {
-	switch suit {
-	case SPADES:
-		return '♤'
-	case HEARTS:
-		return '♡'
-	case CLUBS:
-		return '♧'
-	case DIAMONDS:
-		return '♢'
-	default:
-		panic("invalid suit")
-	}
+// newHand classifies five distinct cards by the default ruleset. The slice
+// is sorted in place.
+func newHand(cards []card.Card) eval.Hand {
+	hand, _ := defaultRuleset.classify(cards)
+	return hand
 }
 
-func getCountsByCard(hands []Hand) map[Card]int {
-	counts := make(map[Card]int)
+func getCountsByCard(hands []eval.Hand) map[card.Card]int {
+	counts := make(map[card.Card]int)
 	for _, hand := range hands {
 		for _, card := range hand.Cards() {
 			if _, ok := counts[card]; ok {
@@ -1,8 +1,14 @@
 package poker
 
 import (
+	"math/rand/v2"
+	"slices"
 	"strings"
 	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/deck"
+	"github.com/sdeboni/go-poker/eval"
 )
 
 func TestValidCases(t *testing.T) {
@@ -26,6 +32,66 @@ func TestValidCases(t *testing.T) {
 	}
 }
 
+func TestRankHands(t *testing.T) {
+	for _, tc := range rankCases {
+		t.Run(tc.description, func(t *testing.T) {
+			tiers, err := RankHands(tc.input)
+			if err != nil {
+				t.Errorf("\nunexpected error: %s", err.Error())
+				return
+			}
+			if len(tiers) != len(tc.expected) {
+				t.Errorf("expected %d tiers, got %d", len(tc.expected), len(tiers))
+				return
+			}
+			for i, tier := range tiers {
+				if len(tier) != len(tc.expected[i]) {
+					t.Errorf("tier %d: expected %d hands, got %d", i+1, len(tc.expected[i]), len(tier))
+					continue
+				}
+				for j, ranked := range tier {
+					expected := tc.expected[i][j]
+					if ranked.Index != expected {
+						t.Errorf("\ntier %d: expected index %d\ngot index       : %d", i+1, expected, ranked.Index)
+					}
+					if normalized, _ := parseHand(tc.input[expected]); ranked.Hand != normalized.String() {
+						t.Errorf("\ntier %d: expected hand %s\ngot hand       : %s", i+1, normalized.String(), ranked.Hand)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestWinners(t *testing.T) {
+	hands := make(map[PlayerID]eval.Hand)
+	for player, str := range map[PlayerID]string{
+		"carol": "  4♡ 3♤ 3♡ 2♡ 5♡",
+		"alice": "8♢ 6♧ 2♧ 4♧ 5♧",
+		"bob":   "3♢ 3♧ 2♤ 4♤ 5♤  ",
+	} {
+		hand, err := ParseHand(str)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hands[player] = hand
+	}
+
+	winners, err := Winners(hands)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Winner{{"bob", 0.5}, {"carol", 0.5}}
+	if !slices.Equal(winners, expected) {
+		t.Errorf("\nexpected: %v\ngot     : %v", expected, winners)
+	}
+
+	hands["dave"], _ = ParseHand("3♡ 3♢ A♧ K♧ Q♧")
+	if _, err := Winners(hands); err == nil {
+		t.Error("expected error for cards shared between players")
+	}
+}
+
 func TestInvalidCases(t *testing.T) {
 	for _, tc := range invalidCases {
 		t.Run(tc.description, func(t *testing.T) {
@@ -43,3 +109,20 @@ func TestInvalidCases(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkParseHand(b *testing.B) {
+	rng := rand.New(rand.NewPCG(21, 34))
+	cards := deck.New().Cards()
+	hands := make([]string, 1000)
+	for i := range hands {
+		rng.Shuffle(len(cards), func(i, j int) {
+			cards[i], cards[j] = cards[j], cards[i]
+		})
+		hands[i] = card.Format(cards[:5])
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := range b.N {
+		parseHand(hands[i%len(hands)])
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/sdeboni/go-poker
+
+go 1.25
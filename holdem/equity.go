@@ -0,0 +1,206 @@
+package holdem
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+const BOARD_SIZE = 5
+
+// Equity returns each hold'em holding's share of the pot over the runouts of
+// the board: wins count 1, split pots the fraction received. With trials <= 0
+// every runout is enumerated, otherwise trials random runouts are sampled.
+func Equity(holdings []HoleCards, board []card.Card, trials int, rng *rand.Rand) ([]float64, error) {
+	if len(holdings) < 2 {
+		return nil, fmt.Errorf("equity needs at least 2 holdings, got %d", len(holdings))
+	}
+	if len(board) > BOARD_SIZE {
+		return nil, fmt.Errorf("invalid board: %d cards", len(board))
+	}
+
+	var dead [card.DECK_SIZE]bool
+	markDead := func(c card.Card) error {
+		if dead[c.Index()] {
+			return fmt.Errorf("card %s used more than once", c.String())
+		}
+		dead[c.Index()] = true
+		return nil
+	}
+	for _, holding := range holdings {
+		for _, c := range holding {
+			if err := markDead(c); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, c := range board {
+		if err := markDead(c); err != nil {
+			return nil, err
+		}
+	}
+
+	live := make([]card.Card, 0, card.DECK_SIZE)
+	for i, isDead := range dead {
+		if !isDead {
+			c, _ := card.FromIndex(i)
+			live = append(live, c)
+		}
+	}
+
+	s := newRunouts(holdings, board)
+	need := BOARD_SIZE - len(board)
+	if trials <= 0 {
+		s.enumerate(live, need, 0)
+	} else {
+		for range trials {
+			for i := range need {
+				j := i + rng.IntN(len(live)-i)
+				live[i], live[j] = live[j], live[i]
+			}
+			s.settle(live[:need])
+		}
+	}
+	return s.equities(), nil
+}
+
+// runouts accumulates pot shares over hold'em board runouts.
+type runouts struct {
+	hands  [][7]card.Card
+	known  int
+	values []eval.HandValue
+	shares []float64
+	runs   int
+	runout [BOARD_SIZE]card.Card
+}
+
+func newRunouts(holdings []HoleCards, board []card.Card) *runouts {
+	s := &runouts{
+		hands:  make([][7]card.Card, len(holdings)),
+		known:  2 + len(board),
+		values: make([]eval.HandValue, len(holdings)),
+		shares: make([]float64, len(holdings)),
+	}
+	for i, holding := range holdings {
+		copy(s.hands[i][:], holding[:])
+		copy(s.hands[i][2:], board)
+	}
+	return s
+}
+
+func (s *runouts) settle(runout []card.Card) {
+	var best eval.HandValue
+	var winners int
+	for i := range s.hands {
+		copy(s.hands[i][s.known:], runout)
+		s.values[i] = eval.Evaluate(s.hands[i][:])
+		if s.values[i] > best {
+			best, winners = s.values[i], 1
+		} else if s.values[i] == best {
+			winners++
+		}
+	}
+	for i, v := range s.values {
+		if v == best {
+			s.shares[i] += 1 / float64(winners)
+		}
+	}
+	s.runs++
+}
+
+func (s *runouts) enumerate(live []card.Card, need, dealt int) {
+	if dealt == need {
+		s.settle(s.runout[:need])
+		return
+	}
+	for i := range len(live) - (need - dealt) + 1 {
+		s.runout[dealt] = live[i]
+		s.enumerate(live[i+1:], need, dealt+1)
+	}
+}
+
+func (s *runouts) equities() []float64 {
+	result := make([]float64, len(s.shares))
+	for i, share := range s.shares {
+		result[i] = share / float64(s.runs)
+	}
+	return result
+}
+
+// ClassEquities holds the all-in preflop equity of each hand class against
+// each other, indexed [hero][villain].
+type ClassEquities [HAND_CLASSES][HAND_CLASSES]float64
+
+// NewClassEquities estimates class against class equities by sampling trials
+// pairs of non-overlapping holdings and runouts for every matchup.
+func NewClassEquities(trials int, rng *rand.Rand) *ClassEquities {
+	var holdings [HAND_CLASSES][]HoleCards
+	for c := range HandClass(HAND_CLASSES) {
+		holdings[c] = c.Holdings()
+	}
+
+	e := new(ClassEquities)
+	var deck [card.DECK_SIZE]card.Card
+	for i := range deck {
+		deck[i], _ = card.FromIndex(i)
+	}
+
+	for hero := range HandClass(HAND_CLASSES) {
+		e[hero][hero] = 0.5
+		for villain := hero + 1; villain < HAND_CLASSES; villain++ {
+			var share float64
+			var sampled int
+			for range trials {
+				h := holdings[hero][rng.IntN(len(holdings[hero]))]
+				v := holdings[villain][rng.IntN(len(holdings[villain]))]
+				if overlaps(h, v) {
+					continue
+				}
+				share += sampleShare(h, v, deck[:], rng)
+				sampled++
+			}
+			if sampled > 0 {
+				e[hero][villain] = share / float64(sampled)
+			} else {
+				e[hero][villain] = 0.5
+			}
+			e[villain][hero] = 1 - e[hero][villain]
+		}
+	}
+	return e
+}
+
+func overlaps(a, b HoleCards) bool {
+	return a[0] == b[0] || a[0] == b[1] || a[1] == b[0] || a[1] == b[1]
+}
+
+// sampleShare deals one random board and returns hero's share of the pot.
+func sampleShare(hero, villain HoleCards, deck []card.Card, rng *rand.Rand) float64 {
+	h := [7]card.Card{hero[0], hero[1]}
+	v := [7]card.Card{villain[0], villain[1]}
+	for dealt := 0; dealt < BOARD_SIZE; {
+		j := dealt + rng.IntN(len(deck)-dealt)
+		deck[dealt], deck[j] = deck[j], deck[dealt]
+		c := deck[dealt]
+		if c == hero[0] || c == hero[1] || c == villain[0] || c == villain[1] {
+			// Swap the dead card out of reach and draw again.
+			deck[dealt], deck[len(deck)-1] = deck[len(deck)-1], deck[dealt]
+			deck = deck[:len(deck)-1]
+			continue
+		}
+		h[2+dealt], v[2+dealt] = c, c
+		dealt++
+	}
+
+	hv, vv := eval.Evaluate(h[:]), eval.Evaluate(v[:])
+	switch {
+	case hv > vv:
+		return 1
+	case hv < vv:
+		return 0
+	default:
+		return 0.5
+	}
+}
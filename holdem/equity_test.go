@@ -0,0 +1,78 @@
+package holdem
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+)
+
+func mustParseCards(t testing.TB, str string) []card.Card {
+	t.Helper()
+	cards, err := card.ParseCards(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cards
+}
+
+func holding(t testing.TB, str string) HoleCards {
+	t.Helper()
+	h, err := ParseHoleCards(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestEquity(t *testing.T) {
+	cases := []struct {
+		description string
+		holdings    []string
+		board       string
+		expected    []float64
+	}{
+		{"made hand on the river", []string{"A♡ A♤", "K♡ K♤"}, "2♧ 7♢ 9♤ J♧ 3♡", []float64{1, 0}},
+		{"board plays", []string{"2♡ 3♤", "4♡ 5♤"}, "A♧ K♢ Q♤ J♧ 10♡", []float64{0.5, 0.5}},
+		// Of the 44 river cards, 9 hearts and 3 each of the aces and kings win.
+		{"flush draw and overcards on the turn", []string{"A♡ K♡", "Q♤ Q♧"}, "2♡ 7♡ 9♤ J♧", []float64{15.0 / 44, 29.0 / 44}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			holdings := make([]HoleCards, len(tc.holdings))
+			for i, str := range tc.holdings {
+				holdings[i] = holding(t, str)
+			}
+			got, err := Equity(holdings, mustParseCards(t, tc.board), 0, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := range got {
+				if math.Abs(got[i]-tc.expected[i]) > 1e-9 {
+					t.Errorf("expected equities %v, got %v", tc.expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestEquitySampledConverges(t *testing.T) {
+	holdings := []HoleCards{holding(t, "A♡ A♤"), holding(t, "K♢ K♧")}
+	got, err := Equity(holdings, nil, 20000, rand.New(rand.NewPCG(3, 4)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// AA against KK of different suits wins about 82% of the time.
+	if math.Abs(got[0]-0.82) > 0.02 {
+		t.Errorf("expected aces to have about 82%% equity, got %f", got[0])
+	}
+}
+
+func TestEquityRejectsDuplicateCards(t *testing.T) {
+	holdings := []HoleCards{holding(t, "A♡ A♤"), holding(t, "A♡ K♧")}
+	if _, err := Equity(holdings, nil, 0, nil); err == nil {
+		t.Error("expected error for duplicate card")
+	}
+}
@@ -0,0 +1,245 @@
+// Package holdem works out Texas hold'em equities: hole cards and their 169
+// preflop classes, suit isomorphism of deals, all-in equity by enumeration
+// or sampling, and heads-up push/fold charts.
+package holdem
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/sdeboni/go-poker/card"
+)
+
+//go:generate go test -run TestGeneratePreflopTables -generate
+
+// HoleCards are a hold'em player's two private cards.
+type HoleCards [2]card.Card
+
+func NewHoleCards(a, b card.Card) (HoleCards, error) {
+	if a == b {
+		return HoleCards{}, fmt.Errorf("invalid hole cards: %s used twice", a.String())
+	}
+	return HoleCards{a, b}, nil
+}
+
+func ParseHoleCards(str string) (HoleCards, error) {
+	cards, err := card.ParseCards(strings.TrimSpace(str))
+	if err != nil {
+		return HoleCards{}, err
+	}
+	if len(cards) != 2 {
+		return HoleCards{}, fmt.Errorf("invalid hole cards '%s': expected 2 cards, found: %d", str, len(cards))
+	}
+	return HoleCards{cards[0], cards[1]}, nil
+}
+
+func (h HoleCards) String() string {
+	return card.Format(h[:])
+}
+
+func (h HoleCards) Class() HandClass {
+	return NewHandClass(h[0], h[1])
+}
+
+// Canonical returns the representative of the holding's suit isomorphism
+// class, along with the permutation mapping the holding onto it. The higher
+// card comes first and suits are relabelled in order of appearance as clubs,
+// then diamonds.
+func (h HoleCards) Canonical() (HoleCards, SuitPermutation) {
+	if h[0].Rank() < h[1].Rank() {
+		h[0], h[1] = h[1], h[0]
+	}
+	var p SuitPermutation
+	p.assign(h[0].Suit())
+	p.assign(h[1].Suit())
+	p.complete()
+	return HoleCards{p.Apply(h[0]), p.Apply(h[1])}, p
+}
+
+// SuitPermutation relabels suits, indexed by the suit being replaced. Poker
+// hands are strategically unchanged by relabelling suits.
+type SuitPermutation [card.DIAMONDS + 1]card.Suit
+
+func (p *SuitPermutation) Apply(c card.Card) card.Card {
+	return card.New(c.Rank(), p[c.Suit()])
+}
+
+// assign maps suit to the next unused suit in bridge order, unless it has
+// already been mapped.
+func (p *SuitPermutation) assign(suit card.Suit) {
+	if p[suit] != 0 {
+		return
+	}
+	var used int
+	for _, s := range p {
+		if s != 0 {
+			used++
+		}
+	}
+	p[suit] = card.BRIDGE_SUITS[used]
+}
+
+// complete maps the suits left over, in bridge order.
+func (p *SuitPermutation) complete() {
+	for _, s := range card.BRIDGE_SUITS {
+		p.assign(s)
+	}
+}
+
+// HandClass is one of the 169 strategically distinct hold'em starting hands,
+// laid out as the usual 13x13 grid with AA top left: pairs on the diagonal,
+// suited hands above it and offsuit hands below.
+type HandClass int
+
+const HAND_CLASSES = 169
+
+func NewHandClass(a, b card.Card) HandClass {
+	high, low := a.Rank(), b.Rank()
+	if high < low {
+		high, low = low, high
+	}
+	row, col := int(card.ACE-high), int(card.ACE-low)
+	if a.Suit() != b.Suit() {
+		row, col = col, row
+	}
+	return HandClass(row*13 + col)
+}
+
+// ParseHandClass parses the usual notation: "AKs", "T9o", "77".
+func ParseHandClass(str string) (HandClass, error) {
+	chars := []rune(strings.TrimSpace(str))
+	if len(chars) < 2 || len(chars) > 3 {
+		return 0, fmt.Errorf("invalid hand class: '%s'", str)
+	}
+
+	var ranks [2]card.Rank
+	for i, char := range chars[:2] {
+		rank := card.TEN
+		var err error
+		if char != 'T' {
+			rank, err = card.ParseRank(string(char))
+		}
+		if err != nil {
+			return 0, fmt.Errorf("invalid hand class '%s': %w", str, err)
+		}
+		ranks[i] = rank
+	}
+
+	suited := len(chars) == 3 && chars[2] == 's'
+	switch {
+	case len(chars) == 3 && chars[2] != 's' && chars[2] != 'o':
+		return 0, fmt.Errorf("invalid hand class '%s': expected s or o, found: %c", str, chars[2])
+	case ranks[0] == ranks[1] && len(chars) == 3:
+		return 0, fmt.Errorf("invalid hand class '%s': pairs are neither suited nor offsuit", str)
+	case ranks[0] != ranks[1] && len(chars) == 2:
+		return 0, fmt.Errorf("invalid hand class '%s': expected s or o", str)
+	}
+
+	second := card.New(ranks[1], card.CLUBS)
+	if !suited {
+		second = card.New(ranks[1], card.DIAMONDS)
+	}
+	return NewHandClass(card.New(ranks[0], card.CLUBS), second), nil
+}
+
+func (c HandClass) ranks() (high, low card.Rank, suited bool) {
+	row, col := card.Rank(c/13), card.Rank(c%13)
+	if row <= col {
+		return card.ACE - row, card.ACE - col, row < col
+	}
+	return card.ACE - col, card.ACE - row, false
+}
+
+func (c HandClass) Pair() bool {
+	return c/13 == c%13
+}
+
+func (c HandClass) Suited() bool {
+	return c/13 < c%13
+}
+
+// Combos returns how many of the 1326 two-card holdings fall in the class.
+func (c HandClass) Combos() int {
+	switch {
+	case c.Pair():
+		return 6
+	case c.Suited():
+		return 4
+	default:
+		return 12
+	}
+}
+
+// CombosExcluding counts the holdings in the class that use none of dead.
+func (c HandClass) CombosExcluding(dead ...card.Card) int {
+	var n int
+	for _, h := range c.Holdings() {
+		if !slices.Contains(dead, h[0]) && !slices.Contains(dead, h[1]) {
+			n++
+		}
+	}
+	return n
+}
+
+// Holdings lists every two-card holding in the class.
+func (c HandClass) Holdings() []HoleCards {
+	high, low, suited := c.ranks()
+	holdings := make([]HoleCards, 0, c.Combos())
+	for _, s1 := range card.BRIDGE_SUITS {
+		for _, s2 := range card.BRIDGE_SUITS {
+			switch {
+			case c.Pair() && s1 >= s2, suited && s1 != s2, !c.Pair() && !suited && s1 == s2:
+				continue
+			}
+			holdings = append(holdings, HoleCards{card.New(high, s1), card.New(low, s2)})
+		}
+	}
+	return holdings
+}
+
+func (c HandClass) String() string {
+	high, low, suited := c.ranks()
+	str := string(rankChar(high)) + string(rankChar(low))
+	switch {
+	case c.Pair():
+		return str
+	case suited:
+		return str + "s"
+	default:
+		return str + "o"
+	}
+}
+
+// rankChar is the single character notation for ranks used in hand classes,
+// where ten is written T.
+func rankChar(rank card.Rank) rune {
+	if rank == card.TEN {
+		return 'T'
+	}
+	return []rune(rank.String())[0]
+}
+
+// PreflopEquity returns the class's all-in equity against a random holding,
+// from the tables generated into preflop_tables.go.
+func PreflopEquity(class HandClass) float64 {
+	return float64(preflopEquityVsRandom[class]) / PREFLOP_TABLE_SCALE
+}
+
+// PreflopClassEquity returns hero's all-in equity against villain, from the
+// tables generated into preflop_tables.go.
+func PreflopClassEquity(hero, villain HandClass) float64 {
+	return float64(preflopClassEquities[hero][villain]) / PREFLOP_TABLE_SCALE
+}
+
+// PreflopClassEquities returns the generated class against class table in
+// the form NewPushFoldChart takes.
+func PreflopClassEquities() *ClassEquities {
+	e := new(ClassEquities)
+	for hero := range HandClass(HAND_CLASSES) {
+		for villain := range HandClass(HAND_CLASSES) {
+			e[hero][villain] = PreflopClassEquity(hero, villain)
+		}
+	}
+	return e
+}
@@ -0,0 +1,95 @@
+package holdem
+
+import (
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+)
+
+func TestHandClasses(t *testing.T) {
+	var combos int
+	for class := range HandClass(HAND_CLASSES) {
+		holdings := class.Holdings()
+		if len(holdings) != class.Combos() {
+			t.Errorf("%s: expected %d holdings, got %d", class, class.Combos(), len(holdings))
+		}
+		for _, h := range holdings {
+			if got := NewHandClass(h[0], h[1]); got != class {
+				t.Errorf("%s: holding %s%s maps to %s", class, h[0].String(), h[1].String(), got)
+			}
+		}
+		combos += len(holdings)
+	}
+	if combos != 1326 {
+		t.Errorf("expected 1326 holdings, got %d", combos)
+	}
+
+	names := map[string]string{"A♡ K♡": "AKs", "10♤ 9♧": "T9o", "7♢ 7♤": "77", "2♡ A♧": "A2o"}
+	for cards, expected := range names {
+		h := holding(t, cards)
+		if got := NewHandClass(h[0], h[1]).String(); got != expected {
+			t.Errorf("%s: expected %s, got %s", cards, expected, got)
+		}
+	}
+}
+
+func TestParseHandClass(t *testing.T) {
+	for class := range HandClass(HAND_CLASSES) {
+		parsed, err := ParseHandClass(class.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if parsed != class {
+			t.Errorf("%s parsed as %s", class, parsed)
+		}
+	}
+
+	for _, str := range []string{"", "A", "AKx", "AAs", "AK", "1Ks", "AKso"} {
+		if class, err := ParseHandClass(str); err == nil {
+			t.Errorf("expected error parsing '%s', got %s", str, class)
+		}
+	}
+}
+
+func TestHoleCardsCanonical(t *testing.T) {
+	cases := []struct {
+		holding  string
+		expected string
+	}{
+		{"A♡ K♡", "A♧ K♧"},
+		{"K♤ A♢", "A♧ K♢"},
+		{"7♡ 7♤", "7♧ 7♢"},
+		{"2♧ 9♧", "9♧ 2♧"},
+	}
+
+	for _, tc := range cases {
+		h := holding(t, tc.holding)
+		canonical, p := h.Canonical()
+		if got := canonical.String(); got != tc.expected {
+			t.Errorf("%s: expected %s, got %s", tc.holding, tc.expected, got)
+		}
+		if canonical.Class() != h.Class() {
+			t.Errorf("%s: canonical form changed class to %s", tc.holding, canonical.Class())
+		}
+		seen := make(map[card.Suit]bool)
+		for _, s := range []card.Suit{card.HEARTS, card.CLUBS, card.SPADES, card.DIAMONDS} {
+			if seen[p[s]] {
+				t.Errorf("%s: permutation %v maps two suits to %d", tc.holding, p, p[s])
+			}
+			seen[p[s]] = true
+		}
+	}
+}
+
+func TestCombosExcluding(t *testing.T) {
+	aks, _ := ParseHandClass("AKs")
+	aces, _ := ParseHandClass("AA")
+	dead := mustParseCards(t, "A♡ K♤")
+
+	if got := aks.CombosExcluding(dead...); got != 2 {
+		t.Errorf("expected 2 AKs combos with A♡ and K♤ dead, got %d", got)
+	}
+	if got := aces.CombosExcluding(dead...); got != 3 {
+		t.Errorf("expected 3 AA combos with A♡ dead, got %d", got)
+	}
+}
@@ -0,0 +1,143 @@
+package holdem
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+
+	"github.com/sdeboni/go-poker/card"
+)
+
+type Street int
+
+const (
+	PREFLOP Street = iota + 1
+	FLOP
+	TURN
+	RIVER
+)
+
+func streetOf(board []card.Card) (Street, error) {
+	switch len(board) {
+	case 0:
+		return PREFLOP, nil
+	case 3:
+		return FLOP, nil
+	case 4:
+		return TURN, nil
+	case 5:
+		return RIVER, nil
+	default:
+		return 0, fmt.Errorf("invalid board: %d cards", len(board))
+	}
+}
+
+// IsoKey identifies a (hole cards, board) deal up to relabelling suits, so
+// that strategically identical deals share equity and strategy cache
+// entries. Keys are stable across runs and versions.
+//
+// Each suit is described by which ranks it holds in the hole cards, on the
+// flop, the turn and the river, packed 13 bits per street. The key is the four
+// suit descriptions sorted, which is the same whatever the suits are called.
+type IsoKey [4]uint64
+
+// NewIsoKey returns the isomorphism class of hole cards on a board of 0, 3, 4
+// or 5 cards. The order of cards within the hole cards or the flop does not
+// matter.
+func NewIsoKey(hole HoleCards, board []card.Card) (IsoKey, error) {
+	if _, err := streetOf(board); err != nil {
+		return IsoKey{}, err
+	}
+	var seen uint64
+	for _, c := range append(hole[:], board...) {
+		if seen&(1<<c.Index()) != 0 {
+			return IsoKey{}, fmt.Errorf("card %s used more than once", c.String())
+		}
+		seen |= 1 << c.Index()
+	}
+
+	key, _ := isoKey(hole, board)
+	return key, nil
+}
+
+func (k IsoKey) Street() Street {
+	var board int
+	for _, description := range k {
+		board += bits.OnesCount64(description & (1<<(3*13) - 1))
+	}
+	switch board {
+	case 0:
+		return PREFLOP
+	case 3:
+		return FLOP
+	case 4:
+		return TURN
+	default:
+		return RIVER
+	}
+}
+
+// Canonical returns the representative deal of the class: suits relabelled
+// in bridge order from the most significant description down, and cards sorted
+// by rank then suit within the hole cards and the flop.
+func Canonical(hole HoleCards, board []card.Card) (HoleCards, []card.Card, error) {
+	if _, err := NewIsoKey(hole, board); err != nil {
+		return HoleCards{}, nil, err
+	}
+
+	_, p := isoKey(hole, board)
+	hole = HoleCards{p.Apply(hole[0]), p.Apply(hole[1])}
+	canonical := make([]card.Card, len(board))
+	for i, c := range board {
+		canonical[i] = p.Apply(c)
+	}
+
+	byRankThenSuit := func(a, b card.Card) int {
+		return -a.Compare(b, card.BRIDGE_SUIT_ORDER)
+	}
+	slices.SortFunc(hole[:], byRankThenSuit)
+	if len(canonical) >= 3 {
+		slices.SortFunc(canonical[:3], byRankThenSuit)
+	}
+	return hole, canonical, nil
+}
+
+// isoKey computes the key of a valid deal, along with a permutation taking
+// the deal to its canonical suits.
+func isoKey(hole HoleCards, board []card.Card) (IsoKey, SuitPermutation) {
+	var descriptions [card.DIAMONDS + 1]uint64
+	street := func(i int) uint {
+		switch {
+		case i < 3:
+			return 2
+		case i == 3:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	for _, c := range hole {
+		descriptions[c.Suit()] |= 1 << (3*13 + uint(c.Rank()-card.TWO))
+	}
+	for i, c := range board {
+		descriptions[c.Suit()] |= 1 << (street(i)*13 + uint(c.Rank()-card.TWO))
+	}
+
+	// Insertion sort, most significant description first; ties between
+	// identical descriptions do not affect the key.
+	suits := [4]card.Suit{card.HEARTS, card.CLUBS, card.SPADES, card.DIAMONDS}
+	for i := 1; i < len(suits); i++ {
+		for j := i; j > 0 && descriptions[suits[j]] > descriptions[suits[j-1]]; j-- {
+			suits[j], suits[j-1] = suits[j-1], suits[j]
+		}
+	}
+
+	var key IsoKey
+	var p SuitPermutation
+	for i, s := range suits {
+		key[i] = descriptions[s]
+		p[s] = card.BRIDGE_SUITS[i]
+	}
+	return key, p
+}
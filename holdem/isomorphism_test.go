@@ -0,0 +1,175 @@
+package holdem
+
+import (
+	"flag"
+	"slices"
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+)
+
+var isoAllStreets = flag.Bool("iso-all-streets", false, "also count turn and river isomorphism classes, which takes a long time")
+
+func TestIsoKey(t *testing.T) {
+	cases := []struct {
+		description string
+		a, b        string
+		same        bool
+	}{
+		{"suits swapped", "A♡ K♡ | 2♧ 7♢ 9♤", "A♤ K♤ | 2♢ 7♧ 9♡", true},
+		{"hole and flop order ignored", "K♡ A♡ | 9♤ 2♧ 7♢", "A♡ K♡ | 2♧ 7♢ 9♤", true},
+		{"flush draw differs from backdoor", "A♡ K♡ | 2♡ 7♡ 9♤", "A♡ K♡ | 2♤ 7♡ 9♤", false},
+		{"turn and flop are different streets", "A♡ K♡ | 2♧ 7♢ 9♤ J♤", "A♡ K♡ | 2♧ 7♢ J♤ 9♤", false},
+		{"suited vs offsuit", "A♡ K♡", "A♡ K♧", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			a, b := isoKeyOf(t, tc.a), isoKeyOf(t, tc.b)
+			if (a == b) != tc.same {
+				t.Errorf("expected same class: %t, keys %x and %x", tc.same, a, b)
+			}
+		})
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	hole, board, err := Canonical(holding(t, "K♤ A♤"), mustParseCards(t, "9♡ 2♤ 7♡ J♢"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := card.Format(append(hole[:], board...)); got != "A♧ K♧ 9♢ 7♢ 2♧ J♡" {
+		t.Errorf("unexpected canonical deal: %s", got)
+	}
+
+	key, err := NewIsoKey(hole, board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != isoKeyOf(t, "K♤ A♤ | 9♡ 2♤ 7♡ J♢") {
+		t.Error("canonical deal is in a different class")
+	}
+	if key.Street() != TURN {
+		t.Errorf("expected a turn key, got street %d", key.Street())
+	}
+}
+
+func TestIsoKeyRejectsInvalidDeals(t *testing.T) {
+	if _, err := NewIsoKey(holding(t, "A♡ K♡"), mustParseCards(t, "A♡ 2♧ 3♧")); err == nil {
+		t.Error("expected error for duplicate card")
+	}
+	if _, err := NewIsoKey(holding(t, "A♡ K♡"), mustParseCards(t, "2♧ 3♧")); err == nil {
+		t.Error("expected error for two card board")
+	}
+}
+
+// Class counts from Waugh, "A Fast and Optimal Hand Isomorphism Algorithm".
+func TestIsoClassCounts(t *testing.T) {
+	preflop := expandClasses([]isoDeal{{}}, 2)
+	if len(preflop) != 169 {
+		t.Errorf("expected 169 preflop classes, got %d", len(preflop))
+	}
+
+	flop := expandClasses(preflop, 3)
+	if len(flop) != 1_286_792 {
+		t.Errorf("expected 1286792 flop classes, got %d", len(flop))
+	}
+
+	if !*isoAllStreets {
+		t.Skip("run with -iso-all-streets to count turn and river classes")
+	}
+	turn := expandClasses(flop, 1)
+	if len(turn) != 55_190_538 {
+		t.Errorf("expected 55190538 turn classes, got %d", len(turn))
+	}
+	if river := countExpandedClasses(turn); river != 2_428_287_420 {
+		t.Errorf("expected 2428287420 river classes, got %d", river)
+	}
+}
+
+// isoDeal stores a canonical deal compactly as card indexes.
+type isoDeal struct {
+	cards [7]uint8
+	n     uint8
+}
+
+func (d isoDeal) split() (HoleCards, []card.Card) {
+	cards := make([]card.Card, d.n)
+	for i := range cards {
+		cards[i], _ = card.FromIndex(int(d.cards[i]))
+	}
+	var hole HoleCards
+	copy(hole[:], cards)
+	return hole, cards[min(len(cards), 2):]
+}
+
+// expandClasses deals add more cards to one representative of every class and
+// returns one representative of every resulting class. Deals extending
+// different classes cannot be isomorphic, so classes are only deduplicated
+// among the extensions of a single representative.
+func expandClasses(deals []isoDeal, add int) []isoDeal {
+	var result []isoDeal
+	seen := make(map[IsoKey]bool)
+	for _, deal := range deals {
+		clear(seen)
+		forEachExtension(deal, add, 0, func(next isoDeal) {
+			hole, board := next.split()
+			key, _ := isoKey(hole, board)
+			if !seen[key] {
+				seen[key] = true
+				result = append(result, next)
+			}
+		})
+	}
+	return result
+}
+
+func countExpandedClasses(deals []isoDeal) int {
+	var count int
+	seen := make(map[IsoKey]bool)
+	for _, deal := range deals {
+		clear(seen)
+		forEachExtension(deal, 1, 0, func(next isoDeal) {
+			hole, board := next.split()
+			key, _ := isoKey(hole, board)
+			if !seen[key] {
+				seen[key] = true
+				count++
+			}
+		})
+	}
+	return count
+}
+
+// forEachExtension calls f with deal extended by every unordered set of add
+// cards not already in it, adding cards in increasing index order from from.
+func forEachExtension(deal isoDeal, add int, from uint8, f func(isoDeal)) {
+	if add == 0 {
+		f(deal)
+		return
+	}
+	for c := from; c < card.DECK_SIZE; c++ {
+		if slices.Contains(deal.cards[:deal.n], c) {
+			continue
+		}
+		next := deal
+		next.cards[next.n] = c
+		next.n++
+		forEachExtension(next, add-1, c+1, f)
+	}
+}
+
+func isoKeyOf(t *testing.T, str string) IsoKey {
+	t.Helper()
+	var hole, board string
+	if i := slices.Index([]rune(str), '|'); i >= 0 {
+		hole, board = string([]rune(str)[:i]), string([]rune(str)[i+1:])
+	} else {
+		hole = str
+	}
+	key, err := NewIsoKey(holding(t, hole), mustParseCards(t, board))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
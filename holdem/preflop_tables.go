@@ -0,0 +1,2562 @@
+// Code generated by go test -run TestGeneratePreflopTables -generate; DO NOT EDIT.
+
+package holdem
+
+// Equities are exact, over every board and every pair of holdings that do
+// not share a card.
+
+const PREFLOP_TABLE_SCALE = 10000
+
+var preflopEquityVsRandom = [HAND_CLASSES]uint16{
+	8520, 6704, 6621, 6539, 6460, 6278, 6194, 6098, 5991, 5992, 5903, 5822, 5738,
+	6532, 8240, 6340, 6257, 6179, 5999, 5831, 5754, 5664, 5579, 5488, 5405, 5321,
+	6443, 6146, 7993, 6026, 5947, 5766, 5602, 5430, 5361, 5277, 5186, 5102, 5017,
+	6356, 6057, 5813, 7747, 5753, 5566, 5402, 5232, 5061, 4999, 4907, 4823, 4738,
+	6272, 5974, 5729, 5525, 7501, 5403, 5233, 5064, 4894, 4722, 4653, 4569, 4484,
+	6077, 5781, 5536, 5325, 5153, 7206, 5080, 4912, 4743, 4572, 4386, 4326, 4242,
+	5987, 5602, 5360, 5149, 4972, 4810, 6916, 4794, 4624, 4454, 4270, 4087, 4027,
+	5884, 5519, 5177, 4968, 4791, 4630, 4505, 6624, 4537, 4368, 4185, 4004, 3816,
+	5768, 5422, 5102, 4784, 4609, 4449, 4324, 4232, 6328, 4313, 4133, 3953, 3767,
+	5770, 5331, 5012, 4718, 4425, 4267, 4143, 4051, 3994, 6032, 4145, 3969, 3785,
+	5673, 5233, 4913, 4619, 4350, 4067, 3945, 3855, 3801, 3816, 5702, 3864, 3683,
+	5584, 5143, 4822, 4528, 4259, 4002, 3748, 3660, 3608, 3626, 3515, 5369, 3598,
+	5493, 5051, 4730, 4435, 4167, 3910, 3683, 3458, 3408, 3428, 3320, 3230, 5033,
+}
+
+var preflopClassEquities = [HAND_CLASSES][HAND_CLASSES]uint16{
+	{ // AA
+		5000, 8786, 8746, 8706, 8666, 8843, 8803, 8803, 8823, 8669, 8709, 8749, 8789,
+		9317, 8195, 8294, 8253, 8213, 8273, 8392, 8351, 8350, 8376, 8416, 8457, 8498,
+		9274, 8712, 8155, 8089, 8048, 8108, 8227, 8351, 8310, 8335, 8376, 8417, 8457,
+		9230, 8668, 8493, 8115, 7884, 7944, 8063, 8187, 8310, 8295, 8336, 8376, 8417,
+		9186, 8624, 8449, 8274, 8075, 7780, 7899, 8023, 8145, 8295, 8295, 8336, 8376,
+		9378, 8687, 8512, 8336, 8161, 8086, 7794, 7918, 8041, 8190, 8355, 8355, 8396,
+		9335, 8814, 8639, 8464, 8288, 8178, 8046, 7754, 7876, 8026, 8191, 8355, 8355,
+		9335, 8771, 8771, 8595, 8420, 8310, 8134, 8048, 7753, 7902, 8067, 8231, 8396,
+		9356, 8770, 8726, 8726, 8550, 8440, 8265, 8133, 8046, 7750, 7915, 8079, 8244,
+		9190, 8797, 8754, 8710, 8710, 8599, 8424, 8293, 8131, 8089, 7891, 8055, 8220,
+		9234, 8841, 8798, 8754, 8710, 8775, 8600, 8468, 8306, 8281, 8133, 8096, 8260,
+		9278, 8886, 8842, 8798, 8754, 8776, 8776, 8644, 8482, 8457, 8501, 8177, 8301,
+		9322, 8930, 8886, 8842, 8799, 8820, 8776, 8820, 8658, 8633, 8677, 8721, 8222,
+	},
+	{ // AKs
+		1214, 5000, 7128, 7101, 7074, 7119, 7094, 7070, 7093, 6982, 7024, 7067, 7110,
+		5249, 3411, 7143, 7116, 7089, 7273, 7248, 7225, 7231, 7273, 7318, 7363, 7409,
+		7545, 7559, 4605, 6353, 6322, 6445, 6528, 6619, 6583, 6612, 6646, 6680, 6716,
+		7515, 7529, 6644, 4596, 6201, 6324, 6407, 6497, 6583, 6581, 6615, 6650, 6685,
+		7485, 7499, 6612, 6484, 4594, 6202, 6285, 6376, 6461, 6581, 6585, 6619, 6655,
+		7539, 7703, 6741, 6613, 6485, 4742, 6288, 6260, 6345, 6465, 6590, 6594, 6629,
+		7510, 7676, 6829, 6702, 6574, 6581, 4752, 6140, 6225, 6345, 6470, 6596, 6601,
+		7483, 7649, 6924, 6796, 6668, 6550, 6424, 4769, 6106, 6226, 6351, 6477, 6603,
+		7508, 7655, 6886, 6886, 6758, 6640, 6514, 6388, 4769, 6109, 6235, 6360, 6487,
+		7386, 7700, 6915, 6883, 6883, 6765, 6639, 6513, 6392, 4803, 6134, 6260, 6386,
+		7432, 7748, 6952, 6919, 6887, 6897, 6770, 6645, 6524, 6416, 4859, 6295, 6421,
+		7479, 7797, 6989, 6957, 6924, 6901, 6903, 6778, 6656, 6548, 6586, 4921, 6457,
+		7526, 7847, 7027, 6995, 6962, 6939, 6909, 6911, 6790, 6682, 6719, 6757, 4989,
+	},
+	{ // AQs
+		1254, 2872, 5000, 7081, 7055, 7066, 7041, 7053, 7042, 6931, 6973, 7016, 7058,
+		2988, 3185, 7142, 6177, 6146, 6265, 6348, 6331, 6295, 6324, 6358, 6392, 6428,
+		5249, 7558, 3427, 6997, 6970, 7154, 7129, 7244, 7216, 7259, 7304, 7349, 7395,
+		7496, 6467, 7400, 4588, 6220, 6340, 6423, 6528, 6611, 6609, 6644, 6678, 6714,
+		7466, 6434, 7370, 6503, 4586, 6218, 6302, 6406, 6489, 6610, 6613, 6648, 6683,
+		7483, 6560, 7573, 6629, 6501, 4730, 6185, 6290, 6342, 6462, 6587, 6591, 6627,
+		7454, 6648, 7546, 6717, 6589, 6471, 4740, 6170, 6222, 6342, 6468, 6593, 6598,
+		7468, 6630, 7670, 6828, 6700, 6582, 6456, 4811, 6145, 6265, 6390, 6515, 6642,
+		7456, 6592, 7639, 6915, 6787, 6636, 6510, 6429, 4802, 6118, 6243, 6369, 6495,
+		7334, 6621, 7684, 6912, 6912, 6761, 6635, 6554, 6400, 4836, 6142, 6268, 6394,
+		7380, 6658, 7732, 6948, 6916, 6893, 6766, 6686, 6532, 6424, 4891, 6303, 6429,
+		7426, 6695, 7781, 6986, 6953, 6897, 6899, 6819, 6665, 6557, 6594, 4953, 6465,
+		7473, 6733, 7831, 7024, 6991, 6935, 6905, 6952, 6798, 6690, 6727, 6765, 5021,
+	},
+	{ // AJs
+		1294, 2899, 2919, 5000, 6987, 7006, 6980, 6963, 6989, 6844, 6886, 6928, 6970,
+		3017, 3221, 5897, 7127, 6178, 6297, 6380, 6331, 6333, 6332, 6366, 6401, 6436,
+		3034, 6181, 3197, 6990, 6065, 6184, 6267, 6351, 6326, 6325, 6359, 6393, 6429,
+		5249, 7542, 7392, 3451, 6851, 7035, 7010, 7125, 7235, 7244, 7289, 7334, 7380,
+		7394, 6467, 6347, 7240, 4578, 6238, 6321, 6423, 6520, 6638, 6641, 6676, 6711,
+		7420, 6592, 6472, 7444, 6520, 4723, 6174, 6276, 6373, 6486, 6611, 6615, 6650,
+		7391, 6680, 6560, 7417, 6609, 6457, 4733, 6156, 6253, 6366, 6491, 6617, 6622,
+		7372, 6629, 6649, 7540, 6716, 6565, 6439, 4799, 6175, 6262, 6387, 6513, 6639,
+		7401, 6633, 6624, 7660, 6818, 6667, 6541, 6461, 4843, 6156, 6281, 6407, 6534,
+		7242, 6630, 6621, 7668, 6941, 6785, 6659, 6550, 6441, 4868, 6150, 6276, 6402,
+		7288, 6666, 6657, 7716, 6945, 6917, 6790, 6682, 6573, 6433, 4923, 6311, 6437,
+		7334, 6703, 6695, 7765, 6982, 6921, 6923, 6815, 6706, 6565, 6602, 4985, 6473,
+		7380, 6742, 6733, 7815, 7020, 6959, 6929, 6948, 6839, 6699, 6736, 6774, 5054,
+	},
+	{ // ATs
+		1334, 2926, 2945, 3013, 5000, 6915, 6889, 6868, 6865, 6756, 6765, 6806, 6848,
+		3047, 3257, 5905, 5901, 7113, 6328, 6411, 6358, 6334, 6370, 6374, 6409, 6444,
+		3063, 6189, 3234, 5805, 6975, 6215, 6298, 6383, 6326, 6363, 6367, 6402, 6437,
+		3132, 6184, 6082, 3217, 6837, 6102, 6185, 6270, 6346, 6356, 6360, 6394, 6430,
+		5249, 7526, 7376, 7226, 3480, 6916, 6891, 7006, 7116, 7263, 7275, 7320, 7366,
+		7322, 6624, 6504, 6384, 7314, 4715, 6188, 6290, 6384, 6516, 6639, 6643, 6679,
+		7293, 6712, 6592, 6472, 7287, 6471, 4725, 6170, 6265, 6397, 6519, 6645, 6650,
+		7271, 6656, 6682, 6562, 7410, 6579, 6452, 4791, 6161, 6293, 6411, 6536, 6663,
+		7268, 6632, 6623, 6643, 7530, 6678, 6552, 6443, 4831, 6187, 6279, 6405, 6531,
+		7150, 6671, 6662, 6653, 7689, 6816, 6690, 6582, 6473, 4909, 6189, 6315, 6441,
+		7159, 6675, 6666, 6657, 7700, 6946, 6820, 6706, 6569, 6474, 4955, 6319, 6445,
+		7204, 6712, 6703, 6694, 7750, 6950, 6952, 6839, 6702, 6606, 6611, 5017, 6481,
+		7250, 6750, 6741, 6732, 7799, 6989, 6958, 6972, 6835, 6740, 6744, 6782, 5086,
+	},
+	{ // A9s
+		1157, 2881, 2934, 2994, 3085, 5000, 6670, 6652, 6646, 6508, 6553, 6561, 6603,
+		3004, 3195, 5746, 5741, 5738, 7110, 6295, 6242, 6213, 6223, 6265, 6269, 6305,
+		3058, 6025, 3172, 5646, 5642, 6973, 6182, 6266, 6205, 6216, 6258, 6262, 6297,
+		3119, 6020, 5918, 3156, 5547, 6836, 6070, 6154, 6231, 6209, 6251, 6255, 6291,
+		3214, 6015, 5913, 5811, 3146, 6699, 5958, 6042, 6119, 6230, 6245, 6249, 6285,
+		5249, 7529, 7379, 7229, 7081, 3360, 6759, 6873, 6984, 7131, 7280, 7292, 7338,
+		7060, 6594, 6474, 6354, 6235, 7144, 4570, 6137, 6231, 6360, 6497, 6621, 6626,
+		7041, 6538, 6563, 6443, 6325, 7267, 6418, 4636, 6122, 6251, 6389, 6512, 6638,
+		7034, 6508, 6499, 6525, 6406, 7387, 6517, 6403, 4676, 6120, 6257, 6375, 6502,
+		6885, 6519, 6510, 6502, 6523, 7546, 6652, 6538, 6401, 4750, 6167, 6259, 6386,
+		6934, 6565, 6556, 6548, 6540, 7708, 6797, 6683, 6547, 6451, 4849, 6305, 6431,
+		6943, 6569, 6560, 6552, 6545, 7720, 6927, 6814, 6672, 6548, 6598, 4902, 6436,
+		6988, 6607, 6599, 6590, 6583, 7770, 6933, 6947, 6805, 6682, 6731, 6736, 4970,
+	},
+	{ // A8s
+		1197, 2906, 2959, 3020, 3111, 3330, 5000, 6442, 6441, 6300, 6315, 6360, 6368,
+		3032, 3193, 5691, 5690, 5687, 5852, 7234, 6265, 6236, 6242, 6257, 6300, 6305,
+		3086, 5970, 3204, 5619, 5616, 5781, 7097, 6290, 6232, 6238, 6254, 6296, 6301,
+		3147, 5968, 5891, 3193, 5525, 5690, 6960, 6181, 6258, 6231, 6247, 6290, 6294,
+		3242, 5963, 5886, 5789, 3183, 5595, 6823, 6069, 6146, 6257, 6241, 6284, 6288,
+		3475, 6137, 6060, 5962, 5861, 3241, 6729, 6001, 6078, 6189, 6306, 6321, 6326,
+		5249, 7663, 7513, 7364, 7215, 7114, 3405, 6754, 6865, 7012, 7161, 7311, 7323,
+		6815, 6561, 6587, 6471, 6352, 6281, 7138, 4628, 6142, 6271, 6405, 6543, 6667,
+		6814, 6531, 6527, 6553, 6434, 6363, 7258, 6422, 4669, 6134, 6268, 6406, 6530,
+		6661, 6537, 6532, 6524, 6551, 6480, 7416, 6557, 6415, 4742, 6152, 6290, 6409,
+		6678, 6555, 6550, 6542, 6535, 6604, 7578, 6699, 6557, 6434, 4837, 6336, 6429,
+		6728, 6602, 6597, 6589, 6581, 6621, 7741, 6845, 6703, 6580, 6629, 4943, 6475,
+		6737, 6607, 6602, 6594, 6587, 6627, 7754, 6976, 6834, 6706, 6727, 6777, 5002,
+	},
+	{ // A7s
+		1197, 2930, 2947, 3037, 3132, 3348, 3558, 5000, 6153, 6019, 6032, 6047, 6091,
+		3059, 3229, 5699, 5698, 5695, 5860, 5974, 7220, 6267, 6273, 6284, 6300, 6343,
+		3074, 5978, 3188, 5544, 5545, 5710, 5824, 7201, 6229, 6234, 6245, 6261, 6305,
+		3167, 5976, 5815, 3206, 5474, 5640, 5753, 7064, 6254, 6231, 6242, 6259, 6302,
+		3266, 5971, 5814, 5738, 3202, 5549, 5663, 6928, 6146, 6257, 6236, 6253, 6296,
+		3496, 6145, 5988, 5912, 5815, 3260, 5612, 6833, 6078, 6189, 6306, 6290, 6333,
+		3720, 6264, 6106, 6030, 5933, 5880, 3264, 6698, 5967, 6079, 6195, 6313, 6329,
+		5249, 7647, 7627, 7477, 7328, 7227, 7080, 3442, 6760, 6907, 7056, 7206, 7356,
+		6504, 6564, 6523, 6549, 6434, 6363, 6245, 7144, 4629, 6145, 6280, 6414, 6553,
+		6359, 6570, 6529, 6524, 6551, 6480, 6362, 7302, 6426, 4702, 6158, 6293, 6432,
+		6373, 6582, 6541, 6537, 6529, 6604, 6486, 7464, 6568, 6439, 4798, 6313, 6451,
+		6391, 6601, 6560, 6556, 6548, 6588, 6611, 7627, 6711, 6582, 6604, 4899, 6498,
+		6441, 6648, 6607, 6603, 6595, 6636, 6629, 7791, 6857, 6729, 6750, 6801, 5012,
+	},
+	{ // A6s
+		1177, 2907, 2958, 3011, 3135, 3354, 3559, 3847, 5000, 5674, 5696, 5713, 5757,
+		3034, 3193, 5699, 5660, 5660, 5825, 5939, 5937, 7216, 6272, 6283, 6294, 6310,
+		3086, 5978, 3188, 5544, 5545, 5710, 5824, 5937, 7182, 6242, 6252, 6264, 6280,
+		3140, 5937, 5815, 3154, 5391, 5560, 5674, 5788, 7164, 6204, 6214, 6226, 6242,
+		3271, 5936, 5814, 5654, 3179, 5491, 5605, 5718, 7028, 6229, 6212, 6224, 6239,
+		3505, 6109, 5988, 5831, 5756, 3242, 5558, 5672, 6934, 6165, 6282, 6261, 6277,
+		3722, 6228, 6106, 5950, 5874, 5825, 3246, 5578, 6798, 6055, 6171, 6288, 6272,
+		4030, 6225, 6225, 6068, 5993, 5944, 5844, 3263, 6697, 5975, 6092, 6209, 6330,
+		5249, 7643, 7606, 7586, 7438, 7336, 7189, 7078, 3450, 6769, 6918, 7068, 7218,
+		5987, 6569, 6536, 6496, 6522, 6455, 6338, 6253, 7153, 4640, 6140, 6275, 6413,
+		6012, 6582, 6549, 6508, 6505, 6579, 6461, 6377, 7315, 6420, 4735, 6290, 6428,
+		6031, 6595, 6562, 6522, 6518, 6559, 6586, 6502, 7477, 6562, 6579, 4837, 6447,
+		6080, 6613, 6581, 6540, 6537, 6577, 6571, 6630, 7641, 6709, 6725, 6747, 4949,
+	},
+	{ // A5s
+		1331, 3018, 3069, 3156, 3244, 3492, 3700, 3981, 4326, 5000, 5509, 5532, 5543,
+		3154, 3334, 5747, 5717, 5679, 5848, 5961, 5960, 5962, 7243, 6400, 6412, 6423,
+		3206, 6028, 3329, 5601, 5563, 5732, 5846, 5960, 5932, 7210, 6370, 6381, 6393,
+		3297, 5995, 5873, 3331, 5448, 5617, 5731, 5845, 5933, 7178, 6340, 6351, 6363,
+		3389, 5955, 5833, 5712, 3304, 5465, 5582, 5696, 5784, 7160, 6302, 6314, 6325,
+		3655, 6132, 6010, 5889, 5729, 3396, 5556, 5670, 5758, 7066, 6372, 6355, 6367,
+		3876, 6251, 6129, 6008, 5852, 5824, 3406, 5581, 5669, 6931, 6265, 6383, 6362,
+		4176, 6248, 6248, 6126, 5971, 5943, 5847, 3423, 5607, 6829, 6186, 6303, 6420,
+		4548, 6251, 6218, 6219, 6063, 6035, 5939, 5873, 3438, 6698, 6104, 6221, 6338,
+		5250, 7672, 7636, 7600, 7581, 7480, 7332, 7222, 7080, 3647, 6920, 7069, 7218,
+		5805, 6701, 6669, 6637, 6597, 6671, 6558, 6473, 6386, 7312, 4800, 6447, 6582,
+		5832, 6715, 6682, 6650, 6610, 6655, 6682, 6598, 6510, 7474, 6741, 4901, 6598,
+		5845, 6728, 6696, 6664, 6624, 6668, 6662, 6723, 6635, 7636, 6884, 6901, 5010,
+	},
+	{ // A4s
+		1291, 2976, 3027, 3114, 3235, 3447, 3685, 3968, 4304, 4491, 5000, 5276, 5302,
+		3108, 3297, 5739, 5709, 5679, 5809, 5927, 5925, 5928, 6048, 7258, 6404, 6415,
+		3161, 6019, 3293, 5593, 5563, 5694, 5811, 5925, 5897, 6018, 7225, 6373, 6385,
+		3252, 5987, 5865, 3295, 5448, 5579, 5697, 5810, 5898, 5988, 7192, 6343, 6355,
+		3381, 5955, 5833, 5712, 3304, 5465, 5582, 5696, 5784, 5989, 7160, 6314, 6325,
+		3607, 6093, 5971, 5850, 5729, 3344, 5473, 5591, 5679, 5884, 7185, 6320, 6332,
+		3861, 6215, 6094, 5972, 5852, 5740, 3383, 5522, 5610, 5815, 7050, 6347, 6331,
+		4164, 6212, 6212, 6091, 5970, 5862, 5788, 3405, 5552, 5757, 6948, 6272, 6389,
+		4525, 6215, 6183, 6183, 6063, 5955, 5880, 5818, 3420, 5675, 6817, 6189, 6306,
+		4721, 6338, 6305, 6273, 6275, 6167, 6092, 6030, 5942, 3369, 6843, 6102, 6219,
+		5250, 7689, 7652, 7616, 7581, 7610, 7462, 7351, 7210, 7232, 3690, 7084, 7233,
+		5555, 6706, 6674, 6642, 6610, 6618, 6646, 6565, 6478, 6385, 7490, 4869, 6590,
+		5585, 6720, 6687, 6655, 6624, 6632, 6629, 6690, 6603, 6510, 7653, 6893, 4978,
+	},
+	{ // A3s
+		1251, 2933, 2984, 3072, 3194, 3439, 3640, 3953, 4287, 4468, 4724, 5000, 5272,
+		3062, 3261, 5731, 5700, 5670, 5809, 5889, 5891, 5893, 6014, 6048, 7272, 6407,
+		3115, 6011, 3256, 5585, 5555, 5694, 5773, 5891, 5863, 5983, 6018, 7239, 6376,
+		3207, 5978, 5856, 3259, 5440, 5579, 5658, 5776, 5864, 5953, 5988, 7207, 6346,
+		3336, 5946, 5824, 5703, 3268, 5465, 5544, 5661, 5749, 5954, 5959, 7175, 6317,
+		3599, 6093, 5971, 5850, 5729, 3344, 5473, 5591, 5679, 5884, 6004, 7185, 6332,
+		3813, 6176, 6054, 5933, 5813, 5740, 3331, 5439, 5527, 5736, 5856, 7169, 6295,
+		4149, 6177, 6177, 6056, 5935, 5862, 5704, 3382, 5490, 5699, 5819, 7067, 6353,
+		4508, 6180, 6147, 6148, 6027, 5954, 5796, 5755, 3397, 5617, 5736, 6936, 6271,
+		4696, 6303, 6270, 6238, 6239, 6166, 6012, 5971, 5883, 3351, 5653, 6962, 6188,
+		4974, 6340, 6307, 6275, 6244, 6293, 6138, 6097, 6009, 5920, 3413, 7000, 6224,
+		5251, 7705, 7669, 7633, 7598, 7610, 7592, 7482, 7340, 7362, 7404, 3740, 7248,
+		5553, 6712, 6679, 6647, 6615, 6632, 6593, 6654, 6567, 6478, 6516, 7669, 4945,
+	},
+	{ // A2s
+		1211, 2890, 2942, 3030, 3152, 3397, 3632, 3909, 4243, 4457, 4698, 4728, 5000,
+		3016, 3225, 5723, 5692, 5662, 5801, 5889, 5852, 5855, 5979, 6014, 6048, 7287,
+		3069, 6002, 3220, 5577, 5547, 5685, 5773, 5852, 5825, 5949, 5983, 6018, 7254,
+		3161, 5970, 5848, 3222, 5432, 5571, 5658, 5737, 5825, 5919, 5953, 5988, 7221,
+		3290, 5938, 5816, 5695, 3231, 5456, 5544, 5623, 5711, 5920, 5924, 5959, 7189,
+		3554, 6084, 5963, 5841, 5721, 3308, 5473, 5553, 5640, 5849, 5969, 5973, 7200,
+		3805, 6176, 6054, 5933, 5813, 5740, 3331, 5439, 5527, 5736, 5856, 5976, 7169,
+		4101, 6137, 6137, 6016, 5896, 5823, 5704, 3330, 5386, 5620, 5739, 5859, 7186,
+		4461, 6140, 6108, 6108, 5988, 5915, 5796, 5649, 3345, 5537, 5657, 5777, 7055,
+		4686, 6267, 6235, 6203, 6204, 6131, 6012, 5890, 5803, 3333, 5598, 5718, 7081,
+		4947, 6304, 6272, 6240, 6208, 6257, 6138, 6016, 5929, 5865, 3395, 5754, 7119,
+		4979, 6342, 6309, 6277, 6245, 6262, 6264, 6143, 6056, 5992, 6030, 3465, 7157,
+		5251, 7722, 7686, 7650, 7615, 7627, 7593, 7612, 7470, 7493, 7534, 7576, 3796,
+	},
+	{ // AKo
+		683, 4751, 7012, 6983, 6953, 6996, 6968, 6941, 6966, 6846, 6892, 6938, 6984,
+		5000, 3012, 7042, 7013, 6983, 7175, 7149, 7123, 7129, 7176, 7224, 7273, 7323,
+		7439, 7477, 4324, 6107, 6075, 6200, 6284, 6379, 6341, 6373, 6409, 6446, 6483,
+		7407, 7445, 6483, 4315, 5949, 6074, 6158, 6253, 6341, 6340, 6377, 6413, 6451,
+		7374, 7412, 6448, 6312, 4312, 5948, 6032, 6127, 6214, 6341, 6344, 6381, 6418,
+		7424, 7624, 6581, 6445, 6309, 4473, 6033, 6003, 6091, 6217, 6347, 6351, 6389,
+		7393, 7594, 6671, 6535, 6399, 6406, 4484, 5879, 5967, 6093, 6223, 6353, 6358,
+		7363, 7565, 6773, 6637, 6501, 6373, 6239, 4502, 5843, 5969, 6099, 6230, 6361,
+		7389, 7571, 6731, 6731, 6595, 6467, 6333, 6200, 4501, 5847, 5977, 6107, 6239,
+		7258, 7620, 6765, 6730, 6730, 6602, 6468, 6334, 6203, 4538, 5876, 6006, 6138,
+		7308, 7672, 6804, 6769, 6734, 6742, 6608, 6474, 6343, 6235, 4597, 6043, 6175,
+		7358, 7724, 6844, 6809, 6774, 6746, 6748, 6615, 6484, 6376, 6415, 4662, 6212,
+		7408, 7777, 6885, 6850, 6815, 6787, 6754, 6757, 6626, 6517, 6557, 6598, 4735,
+	},
+	{ // KK
+		1805, 6589, 6815, 6779, 6743, 6805, 6807, 6771, 6807, 6666, 6703, 6739, 6775,
+		6988, 5000, 8613, 8573, 8533, 8710, 8842, 8802, 8802, 8800, 8840, 8880, 8920,
+		7179, 9130, 8193, 8230, 8189, 8220, 8259, 8378, 8337, 8335, 8375, 8416, 8456,
+		7139, 9087, 8644, 8154, 8025, 8055, 8094, 8213, 8337, 8294, 8335, 8375, 8416,
+		7100, 9043, 8600, 8425, 8114, 7891, 7930, 8049, 8173, 8294, 8294, 8335, 8376,
+		7164, 9234, 8632, 8456, 8281, 8082, 7825, 7906, 8030, 8151, 8316, 8316, 8356,
+		7167, 9377, 8672, 8496, 8321, 8211, 8087, 7793, 7917, 8039, 8203, 8368, 8368,
+		7127, 9333, 8799, 8624, 8448, 8296, 8178, 8047, 7753, 7874, 8039, 8204, 8368,
+		7167, 9334, 8755, 8755, 8580, 8428, 8309, 8134, 8049, 7751, 7915, 8080, 8244,
+		7015, 9331, 8753, 8709, 8709, 8557, 8438, 8263, 8131, 8048, 7748, 7913, 8077,
+		7055, 9375, 8797, 8753, 8709, 8733, 8614, 8438, 8307, 8129, 8092, 7954, 8118,
+		7095, 9419, 8841, 8797, 8754, 8733, 8790, 8614, 8483, 8305, 8349, 8137, 8159,
+		7135, 9463, 8885, 8842, 8798, 8778, 8790, 8790, 8659, 8481, 8525, 8569, 8181,
+	},
+	{ // KQs
+		1706, 2857, 2858, 4103, 4095, 4254, 4309, 4301, 4301, 4253, 4261, 4269, 4277,
+		2958, 1387, 5000, 7085, 7058, 7068, 7105, 7080, 7055, 7061, 7103, 7146, 7189,
+		2959, 5249, 3534, 7084, 7057, 7101, 7242, 7218, 7195, 7203, 7247, 7292, 7337,
+		4368, 7496, 7492, 4633, 6334, 6432, 6524, 6608, 6699, 6669, 6703, 6738, 6773,
+		4359, 7465, 7462, 6620, 4631, 6311, 6402, 6486, 6577, 6669, 6673, 6707, 6743,
+		4527, 7481, 7514, 6724, 6596, 4744, 6286, 6342, 6402, 6494, 6619, 6623, 6658,
+		4588, 7525, 7671, 6820, 6692, 6574, 4872, 6327, 6299, 6390, 6515, 6641, 6646,
+		4579, 7496, 7644, 6909, 6781, 6633, 6620, 4887, 6179, 6271, 6396, 6522, 6648,
+		4579, 7469, 7618, 7004, 6876, 6696, 6590, 6464, 4909, 6152, 6278, 6403, 6530,
+		4527, 7475, 7626, 6972, 6972, 6791, 6686, 6560, 6435, 4948, 6163, 6288, 6415,
+		4537, 7521, 7674, 7009, 6976, 6923, 6818, 6692, 6567, 6446, 5001, 6323, 6450,
+		4546, 7567, 7722, 7046, 7014, 6928, 6950, 6825, 6700, 6579, 6616, 5060, 6485,
+		4556, 7614, 7772, 7084, 7052, 6966, 6956, 6958, 6833, 6712, 6749, 6787, 5126,
+	},
+	{ // KJs
+		1747, 2884, 3823, 2873, 4099, 4259, 4310, 4302, 4340, 4283, 4291, 4300, 4308,
+		2987, 1427, 2915, 5000, 6991, 7009, 7017, 6991, 7003, 6976, 7018, 7060, 7102,
+		4082, 3025, 3208, 7076, 6159, 6277, 6365, 6448, 6431, 6402, 6436, 6470, 6506,
+		2975, 5249, 7485, 3557, 6938, 6982, 7123, 7099, 7213, 7188, 7232, 7277, 7323,
+		4362, 7394, 6443, 7332, 4623, 6330, 6419, 6503, 6608, 6697, 6701, 6736, 6771,
+		4530, 7419, 6567, 7385, 6615, 4737, 6271, 6327, 6433, 6517, 6642, 6647, 6682,
+		4587, 7431, 6660, 7541, 6708, 6557, 4860, 6224, 6329, 6387, 6513, 6638, 6643,
+		4578, 7402, 6748, 7514, 6797, 6616, 6510, 4875, 6210, 6268, 6393, 6519, 6646,
+		4620, 7416, 6731, 7638, 6908, 6727, 6621, 6496, 4950, 6191, 6316, 6442, 6568,
+		4560, 7385, 6699, 7610, 7001, 6815, 6682, 6556, 6476, 4980, 6171, 6297, 6423,
+		4569, 7430, 6736, 7658, 7005, 6947, 6814, 6688, 6608, 6454, 5033, 6331, 6458,
+		4578, 7476, 6773, 7706, 7043, 6952, 6946, 6821, 6741, 6587, 6624, 5092, 6494,
+		4588, 7523, 6811, 7756, 7081, 6990, 6952, 6954, 6874, 6720, 6758, 6795, 5158,
+	},
+	{ // KTs
+		1787, 2911, 3854, 3822, 2887, 4262, 4313, 4305, 4340, 4321, 4321, 4330, 4338,
+		3017, 1467, 2942, 3009, 5000, 6919, 6933, 6907, 6889, 6898, 6907, 6948, 6990,
+		4114, 3055, 3245, 5879, 7062, 6308, 6396, 6480, 6432, 6440, 6444, 6479, 6514,
+		4079, 3124, 6157, 3228, 6924, 6195, 6283, 6367, 6452, 6433, 6437, 6471, 6507,
+		2990, 5249, 7469, 7319, 3586, 6863, 7004, 6980, 7094, 7207, 7218, 7263, 7308,
+		4533, 7323, 6600, 6480, 7255, 4729, 6286, 6342, 6444, 6548, 6671, 6675, 6710,
+		4590, 7341, 6692, 6572, 7412, 6571, 4852, 6212, 6315, 6418, 6536, 6662, 6667,
+		4581, 7312, 6781, 6661, 7385, 6630, 6496, 4868, 6195, 6299, 6417, 6543, 6669,
+		4619, 7293, 6730, 6751, 7508, 6738, 6604, 6479, 4938, 6222, 6314, 6439, 6566,
+		4600, 7304, 6740, 6732, 7630, 6847, 6713, 6588, 6508, 5022, 6209, 6335, 6462,
+		4600, 7312, 6744, 6735, 7642, 6976, 6838, 6712, 6604, 6495, 5065, 6340, 6466,
+		4610, 7358, 6781, 6773, 7690, 6981, 6970, 6845, 6737, 6628, 6632, 5125, 6502,
+		4620, 7404, 6820, 6811, 7740, 7019, 6976, 6978, 6870, 6761, 6766, 6804, 5190,
+	},
+	{ // K9s
+		1727, 2727, 3735, 3703, 3672, 2890, 4148, 4140, 4175, 4152, 4191, 4191, 4199,
+		2825, 1290, 2932, 2991, 3081, 5000, 6733, 6707, 6686, 6666, 6711, 6719, 6761,
+		3993, 3051, 3183, 5719, 5716, 7060, 6280, 6364, 6311, 6293, 6335, 6339, 6374,
+		3958, 3111, 5993, 3167, 5621, 6923, 6167, 6251, 6336, 6286, 6328, 6332, 6368,
+		3924, 3205, 5988, 5886, 3157, 6786, 6055, 6139, 6224, 6307, 6322, 6326, 6361,
+		2999, 5249, 7471, 7322, 7173, 3463, 6870, 6846, 6961, 7073, 7222, 7233, 7279,
+		4420, 7128, 6573, 6454, 6334, 7267, 4697, 6174, 6276, 6377, 6514, 6637, 6642,
+		4411, 7099, 6662, 6543, 6423, 7240, 6456, 4712, 6157, 6257, 6395, 6518, 6644,
+		4449, 7076, 6607, 6632, 6513, 7364, 6564, 6438, 4783, 6154, 6291, 6410, 6536,
+		4426, 7055, 6588, 6580, 6601, 7486, 6670, 6544, 6436, 4862, 6182, 6275, 6401,
+		4468, 7105, 6634, 6626, 6618, 7647, 6815, 6689, 6581, 6467, 4959, 6320, 6447,
+		4469, 7113, 6639, 6631, 6622, 7660, 6945, 6819, 6706, 6565, 6614, 5009, 6452,
+		4478, 7159, 6677, 6669, 6661, 7709, 6951, 6953, 6840, 6698, 6747, 6753, 5074,
+	},
+	{ // K8s
+		1608, 2752, 3652, 3620, 3589, 3705, 2766, 4026, 4061, 4039, 4073, 4111, 4111,
+		2851, 1158, 2895, 2983, 3067, 3267, 5000, 6438, 6422, 6399, 6414, 6459, 6467,
+		3908, 3016, 3142, 5594, 5591, 5756, 7056, 6279, 6226, 6203, 6219, 6262, 6266,
+		3874, 3108, 5864, 3126, 5496, 5661, 6919, 6167, 6251, 6197, 6213, 6255, 6260,
+		3839, 3195, 5859, 5758, 3116, 5566, 6782, 6055, 6140, 6223, 6207, 6249, 6254,
+		3961, 3408, 6033, 5931, 5830, 3173, 6688, 5955, 6040, 6123, 6239, 6255, 6259,
+		2864, 5249, 7471, 7322, 7173, 7072, 3387, 6716, 6830, 6943, 7091, 7241, 7253,
+		4291, 6810, 6576, 6456, 6337, 6232, 7099, 4594, 6131, 6232, 6366, 6504, 6628,
+		4329, 6794, 6520, 6546, 6427, 6322, 7223, 6411, 4664, 6124, 6258, 6395, 6519,
+		4306, 6769, 6497, 6489, 6515, 6410, 7345, 6517, 6404, 4744, 6122, 6260, 6379,
+		4344, 6786, 6515, 6506, 6499, 6534, 7506, 6659, 6546, 6404, 4837, 6306, 6399,
+		4386, 6836, 6561, 6553, 6545, 6552, 7669, 6805, 6692, 6550, 6599, 4940, 6445,
+		4387, 6845, 6567, 6558, 6551, 6557, 7682, 6936, 6823, 6676, 6697, 6748, 4996,
+	},
+	{ // K7s
+		1649, 2775, 3669, 3669, 3642, 3758, 3735, 2780, 4063, 4040, 4075, 4109, 4148,
+		2877, 1198, 2920, 3009, 3093, 3293, 3562, 5000, 6153, 6137, 6149, 6165, 6209,
+		3927, 3044, 3140, 5539, 5539, 5704, 5836, 7180, 6249, 6227, 6238, 6254, 6297,
+		3925, 3136, 5809, 3158, 5469, 5634, 5765, 7043, 6275, 6224, 6235, 6251, 6294,
+		3896, 3224, 5808, 5731, 3153, 5544, 5675, 6906, 6167, 6250, 6229, 6245, 6288,
+		4018, 3437, 5981, 5905, 5808, 3210, 5624, 6778, 6068, 6151, 6267, 6252, 6295,
+		3993, 3724, 6118, 6042, 5945, 5892, 3267, 6680, 5995, 6078, 6195, 6312, 6328,
+		2880, 5249, 7605, 7456, 7307, 7169, 7063, 3438, 6711, 6824, 6972, 7122, 7272,
+		4330, 6504, 6544, 6570, 6455, 6351, 6275, 7093, 4657, 6143, 6277, 6412, 6550,
+		4307, 6487, 6520, 6516, 6543, 6439, 6363, 7215, 6423, 4736, 6137, 6272, 6410,
+		4345, 6502, 6533, 6529, 6521, 6563, 6487, 7377, 6565, 6418, 4829, 6291, 6430,
+		4383, 6519, 6552, 6548, 6540, 6547, 6612, 7539, 6708, 6561, 6582, 4928, 6476,
+		4426, 6569, 6599, 6595, 6587, 6595, 6630, 7702, 6854, 6707, 6729, 6779, 5037,
+	},
+	{ // K6s
+		1650, 2769, 3705, 3667, 3666, 3787, 3764, 3733, 2784, 4038, 4072, 4107, 4145,
+		2871, 1198, 2945, 2997, 3111, 3314, 3578, 3847, 5000, 5811, 5833, 5845, 5861,
+		3965, 3071, 3176, 5547, 5548, 5713, 5844, 5958, 7165, 6258, 6269, 6280, 6297,
+		3924, 3124, 5817, 3142, 5394, 5563, 5694, 5809, 7147, 6220, 6231, 6242, 6259,
+		3923, 3244, 5816, 5655, 3166, 5494, 5625, 5739, 7011, 6246, 6229, 6240, 6257,
+		4050, 3461, 5989, 5833, 5757, 3229, 5578, 5661, 6883, 6151, 6267, 6247, 6264,
+		4025, 3743, 6127, 5970, 5895, 5846, 3286, 5607, 6785, 6078, 6195, 6312, 6297,
+		3991, 4030, 6246, 6090, 6014, 5932, 5874, 3296, 6650, 5968, 6085, 6202, 6320,
+		2885, 5249, 7589, 7570, 7421, 7283, 7177, 7030, 3480, 6719, 6868, 7017, 7167,
+		4303, 6136, 6553, 6512, 6539, 6439, 6363, 6246, 7102, 4696, 6148, 6283, 6418,
+		4341, 6160, 6565, 6525, 6521, 6563, 6487, 6370, 7263, 6429, 4789, 6298, 6433,
+		4379, 6175, 6579, 6538, 6535, 6542, 6611, 6494, 7425, 6571, 6588, 4888, 6453,
+		4422, 6192, 6598, 6558, 6554, 6561, 6597, 6620, 7589, 6715, 6731, 6753, 4993,
+	},
+	{ // K5s
+		1624, 2727, 3676, 3668, 3630, 3777, 3758, 3727, 3728, 2757, 3952, 3986, 4021,
+		2824, 1200, 2939, 3024, 3102, 3334, 3601, 3863, 4189, 5000, 5520, 5544, 5556,
+		3933, 3065, 3179, 5553, 5515, 5684, 5815, 5929, 5928, 7163, 6295, 6307, 6319,
+		3924, 3154, 5823, 3181, 5400, 5569, 5700, 5814, 5929, 7130, 6265, 6277, 6289,
+		3883, 3236, 5782, 5661, 3153, 5416, 5551, 5666, 5780, 7113, 6228, 6239, 6251,
+		4038, 3484, 5960, 5839, 5679, 3245, 5525, 5609, 5723, 6985, 6266, 6250, 6262,
+		4019, 3770, 6097, 5976, 5820, 5792, 3307, 5558, 5672, 6887, 6198, 6315, 6295,
+		3984, 4049, 6216, 6095, 5939, 5879, 5825, 3317, 5579, 6752, 6088, 6205, 6323,
+		3985, 4399, 6214, 6214, 6059, 5998, 5944, 5845, 3339, 6651, 6009, 6126, 6244,
+		2854, 5250, 7587, 7551, 7532, 7394, 7288, 7141, 7031, 3532, 6730, 6879, 7029,
+		4212, 5821, 6592, 6560, 6520, 6562, 6490, 6373, 6289, 7112, 4746, 6312, 6448,
+		4251, 5848, 6606, 6574, 6534, 6545, 6614, 6497, 6414, 7274, 6602, 4845, 6463,
+		4289, 5863, 6620, 6588, 6548, 6559, 6595, 6623, 6539, 7438, 6745, 6762, 4951,
+	},
+	{ // K4s
+		1584, 2682, 3642, 3634, 3626, 3735, 3743, 3716, 3717, 3600, 2742, 3952, 3986,
+		2776, 1160, 2897, 2982, 3093, 3289, 3586, 3851, 4167, 4480, 5000, 5287, 5314,
+		3897, 3019, 3142, 5545, 5515, 5645, 5780, 5895, 5894, 5925, 7177, 6298, 6310,
+		3888, 3109, 5814, 3145, 5400, 5531, 5665, 5780, 5894, 5895, 7145, 6268, 6280,
+		3879, 3227, 5782, 5661, 3153, 5416, 5551, 5666, 5780, 5897, 7113, 6239, 6251,
+		3995, 3437, 5920, 5799, 5679, 3193, 5442, 5530, 5644, 5761, 7104, 6214, 6226,
+		4003, 3755, 6062, 5941, 5820, 5708, 3284, 5499, 5614, 5731, 7006, 6280, 6264,
+		3974, 4037, 6181, 6060, 5939, 5798, 5766, 3299, 5525, 5642, 6871, 6174, 6291,
+		3974, 4376, 6178, 6179, 6058, 5917, 5885, 5790, 3321, 5580, 6770, 6095, 6213,
+		3849, 4713, 6211, 6179, 6180, 6039, 6006, 5911, 5846, 3349, 6655, 5987, 6104,
+		2838, 5250, 7603, 7568, 7532, 7524, 7418, 7271, 7161, 7035, 3572, 6894, 7044,
+		4214, 5571, 6597, 6565, 6534, 6509, 6578, 6465, 6381, 6266, 7291, 4813, 6455,
+		4253, 5601, 6612, 6579, 6548, 6523, 6562, 6591, 6507, 6392, 7454, 6754, 4919,
+	},
+	{ // K3s
+		1543, 2637, 3608, 3599, 3591, 3731, 3700, 3700, 3706, 3588, 3596, 2728, 3952,
+		2727, 1120, 2854, 2940, 3052, 3281, 3541, 3835, 4155, 4456, 4713, 5000, 5283,
+		3860, 2974, 3106, 5537, 5507, 5645, 5742, 5860, 5859, 5891, 5926, 7192, 6302,
+		3852, 3064, 5806, 3108, 5392, 5531, 5627, 5745, 5860, 5861, 5896, 7159, 6272,
+		3843, 3182, 5774, 5653, 3117, 5416, 5513, 5631, 5745, 5862, 5866, 7127, 6243,
+		3991, 3428, 5920, 5799, 5679, 3192, 5442, 5530, 5644, 5761, 5880, 7104, 6226,
+		3960, 3707, 6022, 5901, 5781, 5708, 3232, 5416, 5535, 5651, 5771, 7125, 6228,
+		3958, 4022, 6145, 6024, 5904, 5798, 5681, 3276, 5467, 5583, 5703, 6990, 6256,
+		3963, 4364, 6143, 6144, 6023, 5917, 5804, 5731, 3303, 5526, 5646, 6889, 6181,
+		3838, 4688, 6176, 6144, 6145, 6039, 5926, 5852, 5790, 3331, 5558, 6774, 6073,
+		3847, 4966, 6213, 6181, 6149, 6165, 6052, 5978, 5917, 5822, 3391, 6812, 6108,
+		2823, 5251, 7620, 7584, 7549, 7524, 7548, 7401, 7291, 7165, 7206, 3619, 7059,
+		4216, 5568, 6603, 6571, 6540, 6523, 6526, 6554, 6475, 6359, 6397, 7470, 4886,
+	},
+	{ // K2s
+		1502, 2591, 3572, 3564, 3556, 3695, 3695, 3657, 3690, 3577, 3585, 3593, 2713,
+		2677, 1080, 2811, 2898, 3010, 3239, 3533, 3791, 4139, 4444, 4686, 4717, 5000,
+		3823, 2928, 3070, 5528, 5498, 5637, 5742, 5822, 5825, 5856, 5891, 5926, 7206,
+		3814, 3018, 5797, 3072, 5383, 5522, 5627, 5707, 5825, 5826, 5861, 5896, 7174,
+		3805, 3137, 5765, 5644, 3081, 5408, 5513, 5593, 5711, 5828, 5832, 5867, 7142,
+		3953, 3383, 5912, 5791, 5670, 3156, 5442, 5491, 5609, 5726, 5846, 5851, 7118,
+		3954, 3699, 6022, 5901, 5781, 5708, 3232, 5416, 5535, 5651, 5771, 5891, 7125,
+		3913, 3975, 6106, 5985, 5864, 5759, 5681, 3224, 5384, 5504, 5624, 5744, 7109,
+		3948, 4349, 6108, 6108, 5988, 5882, 5804, 5646, 3280, 5468, 5587, 5708, 7008,
+		3827, 4677, 6140, 6108, 6109, 6003, 5926, 5772, 5731, 3313, 5503, 5624, 6893,
+		3836, 4939, 6177, 6145, 6114, 6130, 6052, 5898, 5858, 5767, 3373, 5659, 6931,
+		3846, 4972, 6215, 6183, 6152, 6135, 6179, 6025, 5985, 5894, 5932, 3440, 6970,
+		2808, 5251, 7637, 7601, 7566, 7541, 7549, 7531, 7422, 7295, 7337, 7379, 3674,
+	},
+	{ // AQo
+		726, 2455, 4751, 6966, 6937, 6942, 6914, 6926, 6914, 6794, 6839, 6885, 6931,
+		2561, 2821, 7041, 5918, 5886, 6007, 6092, 6073, 6035, 6067, 6103, 6140, 6177,
+		5000, 7452, 3030, 6885, 6855, 7047, 7021, 7143, 7113, 7160, 7209, 7257, 7307,
+		7391, 6288, 7306, 4308, 5973, 6095, 6179, 6286, 6372, 6372, 6408, 6445, 6482,
+		7358, 6253, 7273, 6338, 4305, 5968, 6053, 6160, 6246, 6372, 6376, 6413, 6450,
+		7368, 6382, 7485, 6468, 6332, 4461, 5929, 6036, 6089, 6215, 6346, 6350, 6387,
+		7337, 6472, 7455, 6558, 6422, 6294, 4472, 5912, 5965, 6091, 6221, 6352, 6357,
+		7350, 6453, 7587, 6671, 6535, 6407, 6273, 4547, 5884, 6010, 6140, 6271, 6402,
+		7336, 6411, 7553, 6764, 6628, 6464, 6330, 6244, 4536, 5856, 5986, 6116, 6248,
+		7205, 6445, 7603, 6763, 6763, 6599, 6465, 6379, 6213, 4573, 5885, 6015, 6147,
+		7254, 6484, 7654, 6802, 6767, 6739, 6605, 6519, 6353, 6244, 4632, 6052, 6184,
+		7304, 6524, 7707, 6842, 6807, 6744, 6746, 6659, 6494, 6385, 6425, 4698, 6221,
+		7354, 6565, 7760, 6883, 6848, 6785, 6751, 6801, 6635, 6526, 6566, 6607, 4770,
+	},
+	{ // KQo
+		1288, 2441, 2442, 3819, 3811, 3975, 4030, 4022, 4022, 3972, 3981, 3989, 3998,
+		2523, 870, 4751, 6975, 6945, 6949, 6984, 6956, 6929, 6935, 6981, 7026, 7072,
+		2548, 5000, 3146, 6987, 6956, 6997, 7145, 7119, 7093, 7102, 7149, 7198, 7247,
+		4024, 7396, 7414, 4354, 6092, 6192, 6286, 6371, 6466, 6435, 6471, 6507, 6545,
+		4014, 7362, 7380, 6462, 4351, 6066, 6160, 6245, 6340, 6435, 6438, 6475, 6512,
+		4189, 7372, 7429, 6570, 6434, 4475, 6036, 6092, 6155, 6249, 6379, 6384, 6421,
+		4255, 7412, 7592, 6668, 6532, 6404, 4613, 6074, 6045, 6140, 6270, 6400, 6405,
+		4245, 7381, 7562, 6759, 6623, 6464, 6449, 4629, 5922, 6016, 6146, 6277, 6408,
+		4245, 7351, 7533, 6861, 6725, 6531, 6416, 6283, 4652, 5893, 6023, 6154, 6285,
+		4192, 7357, 7542, 6827, 6827, 6632, 6517, 6384, 6251, 4695, 5904, 6035, 6166,
+		4201, 7406, 7593, 6866, 6831, 6772, 6657, 6524, 6391, 6263, 4751, 6071, 6203,
+		4211, 7455, 7645, 6906, 6871, 6777, 6798, 6665, 6532, 6403, 6443, 4813, 6240,
+		4221, 7505, 7697, 6947, 6912, 6817, 6804, 6806, 6674, 6545, 6585, 6625, 4882,
+	},
+	{ // QQ
+		1845, 5395, 6573, 6803, 6766, 6828, 6796, 6812, 6812, 6671, 6707, 6744, 6780,
+		5676, 1807, 6466, 6792, 6755, 6817, 6858, 6860, 6824, 6821, 6858, 6894, 6930,
+		6970, 6854, 5000, 8417, 8377, 8554, 8686, 8817, 8778, 8775, 8815, 8855, 8895,
+		7165, 7154, 8918, 8197, 8167, 8193, 8207, 8246, 8365, 8322, 8362, 8403, 8443,
+		7126, 7115, 8874, 8577, 8157, 8029, 8042, 8081, 8200, 8322, 8322, 8362, 8403,
+		7190, 7179, 9065, 8604, 8429, 8119, 7899, 7938, 8052, 8174, 8338, 8338, 8379,
+		7155, 7220, 9208, 8618, 8442, 8291, 8087, 7826, 7907, 8028, 8192, 8357, 8357,
+		7172, 7223, 9350, 8658, 8482, 8330, 8211, 8092, 7794, 7916, 8080, 8245, 8409,
+		7172, 7183, 9307, 8785, 8610, 8452, 8297, 8178, 8053, 7751, 7916, 8080, 8245,
+		7020, 7181, 9304, 8739, 8739, 8581, 8426, 8307, 8132, 8052, 7749, 7913, 8078,
+		7060, 7221, 9348, 8783, 8739, 8757, 8602, 8483, 8308, 8130, 8096, 7954, 8119,
+		7100, 7260, 9392, 8827, 8783, 8757, 8778, 8659, 8483, 8306, 8350, 8140, 8159,
+		7140, 7301, 9436, 8871, 8828, 8802, 8778, 8835, 8659, 8481, 8526, 8570, 8185,
+	},
+	{ // QJs
+		1911, 3647, 3003, 3010, 4195, 4354, 4381, 4456, 4456, 4399, 4407, 4415, 4423,
+		3893, 1770, 2916, 2924, 4121, 4281, 4406, 4461, 4453, 4447, 4455, 4463, 4472,
+		3115, 3013, 1583, 5000, 6980, 6997, 7004, 7041, 7016, 6988, 7030, 7072, 7115,
+		3123, 3021, 5249, 3699, 7030, 7040, 7076, 7218, 7194, 7168, 7212, 7257, 7302,
+		4460, 4382, 7379, 7431, 4699, 6447, 6518, 6610, 6695, 6784, 6787, 6822, 6857,
+		4628, 4550, 7402, 7446, 6735, 4809, 6343, 6435, 6516, 6600, 6726, 6730, 6765,
+		4659, 4681, 7413, 7489, 6810, 6629, 4905, 6332, 6388, 6447, 6572, 6697, 6702,
+		4740, 4742, 7457, 7646, 6907, 6726, 6620, 5038, 6374, 6344, 6469, 6594, 6721,
+		4740, 4734, 7428, 7619, 6996, 6812, 6680, 6668, 5057, 6225, 6350, 6476, 6602,
+		4679, 4727, 7398, 7590, 7089, 6900, 6741, 6636, 6511, 5085, 6205, 6330, 6457,
+		4689, 4737, 7443, 7638, 7093, 7032, 6872, 6767, 6643, 6489, 5136, 6365, 6492,
+		4698, 4746, 7489, 7686, 7131, 7036, 7005, 6900, 6775, 6621, 6658, 5192, 6527,
+		4708, 4756, 7535, 7735, 7169, 7074, 7011, 7034, 6909, 6755, 6792, 6830, 5255,
+	},
+	{ // QTs
+		1952, 3678, 3030, 3935, 3025, 4358, 4384, 4455, 4455, 4437, 4437, 4445, 4453,
+		3925, 1811, 2943, 3841, 2938, 4284, 4409, 4461, 4452, 4485, 4485, 4493, 4502,
+		3145, 3044, 1623, 3020, 5000, 6908, 6921, 6931, 6905, 6914, 6922, 6964, 7006,
+		4195, 4096, 3131, 3275, 7017, 6296, 6383, 6471, 6556, 6537, 6541, 6575, 6611,
+		3139, 3037, 5249, 7417, 3728, 6921, 6957, 7099, 7075, 7187, 7198, 7242, 7287,
+		4631, 4553, 7307, 6583, 7317, 4801, 6358, 6447, 6528, 6631, 6754, 6758, 6793,
+		4661, 4684, 7324, 6674, 7359, 6643, 4898, 6317, 6374, 6477, 6595, 6721, 6726,
+		4739, 4741, 7338, 6767, 7516, 6737, 6603, 5026, 6271, 6374, 6466, 6592, 6718,
+		4739, 4732, 7309, 6857, 7489, 6822, 6663, 6558, 5045, 6256, 6348, 6473, 6600,
+		4720, 4768, 7320, 6837, 7611, 6931, 6772, 6667, 6542, 5127, 6243, 6369, 6495,
+		4720, 4768, 7329, 6841, 7622, 7061, 6896, 6764, 6639, 6530, 5168, 6374, 6500,
+		4730, 4778, 7374, 6879, 7670, 7065, 7029, 6896, 6771, 6662, 6667, 5225, 6536,
+		4739, 4787, 7420, 6917, 7719, 7104, 7035, 7030, 6905, 6796, 6800, 6838, 5287,
+	},
+	{ // Q9s
+		1892, 3555, 2846, 3816, 3785, 3027, 4219, 4290, 4290, 4268, 4306, 4306, 4315,
+		3800, 1780, 2899, 3723, 3692, 2940, 4244, 4296, 4287, 4316, 4355, 4355, 4363,
+		2953, 3003, 1446, 3003, 3092, 5000, 6723, 6740, 6714, 6693, 6738, 6746, 6788,
+		4074, 3975, 3119, 3213, 5701, 7015, 6267, 6356, 6440, 6390, 6432, 6436, 6472,
+		4039, 3941, 3212, 5969, 3203, 6878, 6155, 6243, 6328, 6411, 6426, 6430, 6465,
+		3147, 3046, 5249, 7420, 7271, 3603, 6822, 6964, 6940, 7052, 7201, 7212, 7257,
+		4492, 4514, 7113, 6556, 6437, 7213, 4742, 6278, 6335, 6435, 6572, 6696, 6700,
+		4569, 4571, 7134, 6649, 6529, 7370, 6562, 4870, 6205, 6306, 6443, 6562, 6688,
+		4569, 4562, 7105, 6738, 6619, 7343, 6622, 6489, 4889, 6187, 6325, 6443, 6570,
+		4546, 4594, 7084, 6686, 6707, 7465, 6728, 6595, 6470, 4967, 6215, 6308, 6434,
+		4588, 4636, 7133, 6732, 6724, 7626, 6873, 6740, 6615, 6501, 5062, 6354, 6480,
+		4588, 4636, 7142, 6737, 6728, 7638, 7003, 6865, 6740, 6598, 6648, 5108, 6485,
+		4598, 4646, 7188, 6775, 6767, 7687, 7009, 6999, 6874, 6732, 6781, 6786, 5171,
+	},
+	{ // Q8s
+		1773, 3472, 2871, 3733, 3702, 3818, 2903, 4176, 4176, 4154, 4189, 4227, 4227,
+		3716, 1741, 2758, 3635, 3604, 3720, 2944, 4164, 4156, 4185, 4220, 4258, 4258,
+		2979, 2855, 1314, 2996, 3079, 3277, 5000, 6498, 6472, 6448, 6464, 6508, 6516,
+		3990, 3886, 3117, 3173, 5576, 5741, 7011, 6271, 6355, 6301, 6317, 6359, 6364,
+		3955, 3851, 3204, 5840, 3163, 5646, 6875, 6159, 6244, 6327, 6310, 6353, 6358,
+		4077, 3974, 3415, 6013, 5913, 3219, 6746, 6058, 6139, 6222, 6339, 6354, 6359,
+		3013, 3054, 5249, 7420, 7271, 7132, 3524, 6832, 6808, 6920, 7069, 7218, 7229,
+		4449, 4436, 6876, 6563, 6444, 6338, 7228, 4751, 6175, 6275, 6409, 6547, 6671,
+		4449, 4427, 6846, 6652, 6533, 6423, 7201, 6456, 4771, 6157, 6291, 6428, 6552,
+		4426, 4459, 6821, 6595, 6621, 6511, 7322, 6562, 6437, 4848, 6155, 6293, 6412,
+		4464, 4497, 6839, 6612, 6605, 6635, 7483, 6704, 6579, 6437, 4939, 6339, 6432,
+		4506, 4539, 6889, 6659, 6651, 6653, 7645, 6850, 6725, 6583, 6633, 5039, 6478,
+		4507, 4540, 6898, 6664, 6657, 6658, 7658, 6981, 6856, 6709, 6731, 6781, 5092,
+	},
+	{ // Q7s
+		1649, 3381, 2756, 3649, 3617, 3734, 3710, 2799, 4063, 4040, 4075, 4109, 4148,
+		3621, 1622, 2782, 3552, 3520, 3636, 3721, 2820, 4042, 4071, 4105, 4140, 4178,
+		2857, 2881, 1183, 2959, 3069, 3260, 3502, 5000, 6134, 6119, 6131, 6146, 6191,
+		3904, 3801, 3082, 3132, 5451, 5616, 5747, 7007, 6271, 6216, 6227, 6243, 6286,
+		3869, 3766, 3198, 5712, 3122, 5521, 5652, 6871, 6159, 6242, 6221, 6237, 6280,
+		3992, 3888, 3402, 5885, 5784, 3178, 5570, 6743, 6055, 6138, 6255, 6239, 6282,
+		3967, 3977, 3660, 6022, 5921, 5834, 3234, 6645, 5956, 6039, 6155, 6272, 6288,
+		2900, 2920, 5249, 7420, 7271, 7133, 7027, 3454, 6678, 6790, 6938, 7087, 7237,
+		4330, 4306, 6484, 6566, 6447, 6337, 6233, 7060, 4652, 6131, 6265, 6400, 6538,
+		4307, 4338, 6467, 6508, 6535, 6425, 6321, 7181, 6410, 4730, 6125, 6260, 6398,
+		4345, 4376, 6481, 6521, 6513, 6549, 6444, 7342, 6552, 6405, 4820, 6279, 6417,
+		4383, 4414, 6499, 6539, 6532, 6534, 6569, 7504, 6695, 6548, 6569, 4916, 6464,
+		4426, 4457, 6549, 6587, 6579, 6581, 6588, 7667, 6842, 6695, 6716, 6766, 5023,
+	},
+	{ // Q6s
+		1690, 3417, 2784, 3674, 3674, 3795, 3768, 3771, 2818, 4068, 4103, 4137, 4175,
+		3659, 1663, 2805, 3569, 3568, 3689, 3774, 3751, 2835, 4072, 4106, 4141, 4175,
+		2887, 2907, 1222, 2984, 3095, 3286, 3528, 3866, 5000, 5811, 5833, 5845, 5861,
+		3931, 3819, 3110, 3130, 5396, 5565, 5696, 5827, 7131, 6239, 6250, 6262, 6279,
+		3929, 3817, 3227, 5656, 3154, 5495, 5626, 5758, 6995, 6265, 6248, 6259, 6276,
+		4057, 3945, 3430, 5833, 5757, 3215, 5548, 5680, 6862, 6165, 6282, 6261, 6278,
+		4028, 4033, 3689, 5970, 5895, 5813, 3271, 5625, 6735, 6067, 6183, 6300, 6285,
+		4032, 4009, 4051, 6108, 6033, 5951, 5893, 3333, 6637, 5995, 6112, 6229, 6347,
+		2921, 2935, 5249, 7554, 7405, 7262, 7124, 7019, 3509, 6671, 6819, 6968, 7118,
+		4335, 4338, 6135, 6532, 6558, 6453, 6349, 6274, 7052, 4722, 6144, 6279, 6414,
+		4373, 4376, 6160, 6544, 6541, 6577, 6473, 6398, 7213, 6424, 4812, 6294, 6429,
+		4411, 4414, 6174, 6558, 6554, 6556, 6598, 6523, 7375, 6567, 6583, 4909, 6449,
+		4454, 4453, 6192, 6577, 6574, 6576, 6583, 6648, 7537, 6710, 6727, 6749, 5011,
+	},
+	{ // Q5s
+		1665, 3388, 2741, 3675, 3637, 3784, 3762, 3766, 3758, 2790, 3982, 4017, 4051,
+		3627, 1665, 2797, 3598, 3560, 3707, 3797, 3773, 3742, 2837, 4075, 4109, 4144,
+		2840, 2898, 1225, 3012, 3086, 3307, 3552, 3881, 4189, 5000, 5520, 5544, 5556,
+		3931, 3850, 3140, 3169, 5402, 5570, 5701, 5833, 5948, 7114, 6285, 6296, 6308,
+		3890, 3809, 3218, 5662, 3141, 5418, 5553, 5684, 5799, 7097, 6247, 6259, 6271,
+		4045, 3964, 3454, 5839, 5679, 3231, 5496, 5627, 5738, 6964, 6281, 6264, 6276,
+		4021, 4058, 3716, 5976, 5820, 5759, 3292, 5576, 5660, 6837, 6186, 6303, 6283,
+		4026, 4034, 4069, 6114, 5958, 5897, 5843, 3354, 5606, 6739, 6115, 6232, 6350,
+		4017, 3999, 4399, 6234, 6078, 6012, 5930, 5873, 3369, 6605, 6005, 6122, 6240,
+		2890, 2938, 5250, 7536, 7516, 7373, 7235, 7130, 6984, 3559, 6681, 6830, 6979,
+		4245, 4341, 5821, 6580, 6540, 6576, 6476, 6401, 6285, 7062, 4770, 6308, 6444,
+		4283, 4380, 5848, 6593, 6553, 6559, 6601, 6526, 6409, 7224, 6597, 4866, 6459,
+		4321, 4418, 5863, 6607, 6568, 6574, 6581, 6651, 6535, 7386, 6741, 6758, 4968,
+	},
+	{ // Q4s
+		1624, 3354, 2696, 3641, 3633, 3742, 3746, 3755, 3748, 3630, 2775, 3982, 4017,
+		3591, 1625, 2753, 3564, 3556, 3665, 3781, 3762, 3731, 3705, 2823, 4074, 4109,
+		2791, 2851, 1185, 2970, 3078, 3262, 3536, 3869, 4167, 4480, 5000, 5287, 5314,
+		3895, 3814, 3095, 3132, 5402, 5532, 5667, 5799, 5914, 5915, 7129, 6288, 6300,
+		3886, 3805, 3209, 5662, 3141, 5418, 5553, 5684, 5799, 5916, 7097, 6258, 6271,
+		4002, 3921, 3406, 5799, 5679, 3179, 5413, 5548, 5658, 5775, 7083, 6229, 6241,
+		4006, 4043, 3700, 5941, 5820, 5675, 3269, 5518, 5602, 5719, 6956, 6268, 6252,
+		4015, 4023, 4058, 6079, 5958, 5817, 5784, 3336, 5552, 5668, 6858, 6200, 6318,
+		4006, 3989, 4376, 6199, 6078, 5932, 5871, 5818, 3351, 5577, 6724, 6091, 6209,
+		3881, 3960, 4713, 6199, 6200, 6053, 5993, 5940, 5841, 3377, 6609, 5983, 6100,
+		2874, 2923, 5250, 7552, 7517, 7503, 7365, 7260, 7113, 6987, 3596, 6845, 6994,
+		4246, 4343, 5571, 6585, 6553, 6523, 6565, 6493, 6377, 6262, 7240, 4834, 6451,
+		4285, 4381, 5601, 6599, 6568, 6537, 6549, 6619, 6503, 6387, 7403, 6750, 4936,
+	},
+	{ // Q3s
+		1583, 3320, 2651, 3607, 3598, 3738, 3704, 3739, 3736, 3619, 3627, 2761, 3982,
+		3554, 1584, 2708, 3530, 3521, 3661, 3738, 3746, 3720, 3693, 3702, 2808, 4074,
+		2743, 2802, 1145, 2928, 3036, 3254, 3492, 3854, 4155, 4456, 4713, 5000, 5283,
+		3858, 3778, 3050, 3096, 5393, 5532, 5628, 5764, 5879, 5880, 5915, 7143, 6292,
+		3849, 3769, 3164, 5653, 3104, 5418, 5514, 5650, 5765, 5882, 5886, 7111, 6262,
+		3997, 3917, 3398, 5799, 5679, 3179, 5413, 5548, 5658, 5775, 5895, 7083, 6241,
+		3962, 3999, 3653, 5901, 5781, 5675, 3217, 5435, 5523, 5640, 5759, 7075, 6217,
+		3999, 4007, 4042, 6043, 5923, 5817, 5700, 3313, 5493, 5610, 5730, 6977, 6283,
+		3995, 3978, 4364, 6163, 6043, 5932, 5791, 5759, 3333, 5522, 5642, 6843, 6177,
+		3870, 3949, 4688, 6163, 6164, 6053, 5913, 5881, 5786, 3359, 5554, 6728, 6069,
+		3880, 3958, 4966, 6200, 6169, 6179, 6039, 6007, 5912, 5818, 3416, 6766, 6104,
+		2859, 2908, 5251, 7568, 7533, 7503, 7495, 7390, 7244, 7117, 7159, 3641, 7009,
+		4248, 4344, 5568, 6591, 6559, 6537, 6513, 6583, 6470, 6355, 6393, 7419, 4904,
+	},
+	{ // Q2s
+		1543, 3284, 2605, 3571, 3563, 3703, 3699, 3695, 3720, 3607, 3615, 3624, 2746,
+		3517, 1544, 2663, 3494, 3486, 3626, 3734, 3703, 3703, 3681, 3690, 3698, 2794,
+		2693, 2753, 1105, 2885, 2994, 3212, 3484, 3809, 4139, 4444, 4686, 4717, 5000,
+		3821, 3740, 3004, 3060, 5385, 5524, 5628, 5726, 5845, 5846, 5880, 5916, 7158,
+		3812, 3731, 3119, 5645, 3068, 5409, 5514, 5611, 5730, 5847, 5851, 5886, 7126,
+		3960, 3879, 3353, 5791, 5670, 3143, 5413, 5510, 5624, 5741, 5860, 5865, 7098,
+		3957, 3994, 3645, 5901, 5781, 5675, 3217, 5435, 5523, 5640, 5759, 5880, 7075,
+		3955, 3963, 3995, 6004, 5883, 5778, 5700, 3261, 5410, 5531, 5651, 5771, 7096,
+		3980, 3961, 4349, 6128, 6007, 5896, 5791, 5675, 3310, 5464, 5583, 5704, 6962,
+		3859, 3937, 4677, 6128, 6129, 6018, 5912, 5800, 5727, 3341, 5500, 5620, 6847,
+		3869, 3947, 4939, 6165, 6133, 6144, 6039, 5926, 5853, 5763, 3398, 5655, 6885,
+		3878, 3956, 4972, 6203, 6171, 6149, 6166, 6053, 5980, 5890, 5928, 3461, 6924,
+		2844, 2893, 5251, 7585, 7550, 7520, 7496, 7520, 7374, 7248, 7289, 7332, 3692,
+	},
+	{ // AJo
+		770, 2485, 2504, 4751, 6868, 6881, 6853, 6833, 6860, 6703, 6748, 6793, 6839,
+		2593, 2861, 5632, 7025, 5921, 6042, 6126, 6075, 6076, 6076, 6112, 6148, 6186,
+		2609, 5976, 2835, 6877, 5805, 5926, 6010, 6096, 6069, 6069, 6105, 6142, 6179,
+		5000, 7435, 7274, 3055, 6727, 6919, 6892, 7015, 7133, 7145, 7193, 7242, 7291,
+		7285, 6289, 6165, 7135, 4298, 5992, 6077, 6180, 6279, 6404, 6407, 6444, 6481,
+		7304, 6418, 6294, 7347, 6359, 4454, 5920, 6024, 6122, 6242, 6372, 6376, 6414,
+		7272, 6508, 6384, 7317, 6448, 6284, 4465, 5899, 5998, 6118, 6248, 6378, 6383,
+		7250, 6453, 6475, 7448, 6558, 6394, 6260, 4535, 5917, 6009, 6139, 6269, 6401,
+		7280, 6455, 6447, 7576, 6662, 6498, 6364, 6278, 4581, 5897, 6027, 6157, 6289,
+		7109, 6454, 6446, 7585, 6796, 6626, 6492, 6376, 6257, 4608, 5893, 6024, 6155,
+		7158, 6493, 6485, 7637, 6800, 6766, 6632, 6516, 6397, 6253, 4667, 6061, 6192,
+		7207, 6533, 6525, 7689, 6840, 6771, 6773, 6657, 6538, 6394, 6434, 4733, 6230,
+		7257, 6574, 6566, 7743, 6881, 6812, 6779, 6798, 6680, 6536, 6576, 6616, 4805,
+	},
+	{ // KJo
+		1332, 2471, 3533, 2458, 3816, 3980, 4032, 4024, 4063, 4005, 4013, 4022, 4030,
+		2555, 913, 2504, 4751, 6876, 6889, 6892, 6864, 6876, 6846, 6891, 6936, 6982,
+		3712, 2604, 2846, 6979, 5904, 6025, 6114, 6199, 6181, 6150, 6186, 6222, 6260,
+		2565, 5000, 7381, 3171, 6828, 6869, 7017, 6991, 7114, 7086, 7134, 7182, 7231,
+		4019, 7290, 6268, 7241, 4344, 6090, 6180, 6266, 6373, 6466, 6470, 6507, 6544,
+		4194, 7308, 6396, 7290, 6460, 4468, 6024, 6080, 6188, 6276, 6406, 6410, 6447,
+		4255, 7315, 6491, 7453, 6555, 6391, 4601, 5971, 6078, 6138, 6268, 6399, 6404,
+		4246, 7283, 6581, 7424, 6646, 6451, 6337, 4618, 5954, 6015, 6145, 6275, 6407,
+		4290, 7296, 6563, 7555, 6760, 6565, 6450, 6317, 4697, 5934, 6064, 6195, 6326,
+		4227, 7263, 6528, 7524, 6860, 6659, 6515, 6381, 6296, 4730, 5913, 6043, 6175,
+		4237, 7311, 6567, 7575, 6864, 6799, 6655, 6521, 6436, 6272, 4786, 6080, 6212,
+		4246, 7360, 6607, 7627, 6904, 6804, 6796, 6662, 6577, 6413, 6452, 4848, 6249,
+		4256, 7410, 6648, 7680, 6945, 6845, 6801, 6804, 6718, 6554, 6594, 6635, 4918,
+	},
+	{ // QJo
+		1507, 3356, 2600, 2608, 3918, 4082, 4109, 4185, 4185, 4127, 4135, 4144, 4152,
+		3517, 1356, 2508, 2515, 3843, 4007, 4136, 4191, 4183, 4177, 4186, 4194, 4203,
+		2694, 2586, 1082, 4751, 6869, 6881, 6883, 6918, 6890, 6860, 6905, 6950, 6996,
+		2726, 2619, 5000, 3327, 6936, 6940, 6974, 7122, 7096, 7068, 7115, 7163, 7212,
+		4125, 4044, 7278, 7356, 4424, 6214, 6285, 6380, 6465, 6559, 6563, 6599, 6637,
+		4300, 4219, 7296, 7364, 6588, 4545, 6101, 6195, 6277, 6365, 6495, 6499, 6537,
+		4334, 4355, 7301, 7404, 6664, 6470, 4650, 6085, 6142, 6203, 6333, 6464, 6469,
+		4419, 4421, 7342, 7567, 6764, 6569, 6455, 4793, 6125, 6094, 6224, 6355, 6486,
+		4419, 4412, 7310, 7538, 6855, 6656, 6515, 6501, 4814, 5971, 6101, 6232, 6363,
+		4356, 4405, 7277, 7506, 6955, 6751, 6580, 6466, 6333, 4844, 5949, 6080, 6211,
+		4366, 4415, 7326, 7557, 6959, 6891, 6720, 6606, 6473, 6309, 4897, 6117, 6248,
+		4376, 4425, 7375, 7609, 6999, 6896, 6861, 6747, 6614, 6450, 6490, 4957, 6286,
+		4386, 4434, 7424, 7661, 7040, 6937, 6866, 6888, 6756, 6592, 6632, 6672, 5023,
+	},
+	{ // JJ
+		1885, 5404, 5412, 6549, 6783, 6844, 6807, 6794, 6846, 6669, 6705, 6741, 6778,
+		5685, 1846, 5367, 6443, 6772, 6833, 6874, 6842, 6858, 6819, 6855, 6892, 6928,
+		5692, 5646, 1803, 6301, 6725, 6787, 6827, 6868, 6870, 6831, 6868, 6904, 6940,
+		6945, 6829, 6673, 5000, 8218, 8394, 8525, 8657, 8789, 8746, 8786, 8826, 8866,
+		7144, 7133, 7083, 8703, 8199, 8170, 8179, 8193, 8232, 8348, 8348, 8389, 8429,
+		7208, 7197, 7147, 8893, 8580, 8161, 8031, 8045, 8084, 8200, 8364, 8365, 8405,
+		7167, 7238, 7188, 9035, 8589, 8432, 8123, 7899, 7938, 8049, 8214, 8378, 8378,
+		7153, 7204, 7230, 9177, 8603, 8445, 8290, 8091, 7825, 7903, 8068, 8233, 8397,
+		7209, 7221, 7233, 9320, 8643, 8485, 8330, 8211, 8097, 7791, 7956, 8120, 8285,
+		7018, 7178, 7190, 9273, 8767, 8609, 8449, 8294, 8175, 8054, 7748, 7913, 8077,
+		7058, 7218, 7230, 9317, 8768, 8785, 8625, 8470, 8351, 8129, 8098, 7953, 8118,
+		7098, 7258, 7270, 9361, 8812, 8786, 8800, 8646, 8527, 8305, 8349, 8142, 8159,
+		7138, 7298, 7310, 9405, 8856, 8830, 8801, 8821, 8703, 8481, 8525, 8569, 8187,
+	},
+	{ // JTs
+		2116, 3799, 3780, 3149, 3163, 4453, 4475, 4526, 4609, 4552, 4552, 4560, 4568,
+		4051, 1975, 3666, 3062, 3076, 4379, 4504, 4531, 4606, 4600, 4600, 4608, 4617,
+		4027, 3908, 1833, 2970, 2983, 4299, 4424, 4549, 4604, 4598, 4598, 4607, 4615,
+		3273, 3172, 3064, 1782, 5000, 6897, 6909, 6918, 6955, 6927, 6935, 6977, 7019,
+		3288, 3186, 3078, 5249, 3869, 7011, 7018, 7054, 7196, 7170, 7180, 7224, 7268,
+		4728, 4650, 4564, 7291, 7412, 4877, 6470, 6541, 6634, 6717, 6840, 6844, 6879,
+		4755, 4781, 4695, 7308, 7422, 6758, 4969, 6388, 6481, 6560, 6678, 6803, 6808,
+		4809, 4812, 4826, 7320, 7465, 6833, 6675, 5070, 6378, 6433, 6524, 6650, 6776,
+		4900, 4893, 4888, 7364, 7622, 6931, 6772, 6667, 5206, 6418, 6422, 6548, 6674,
+		4839, 4887, 4882, 7333, 7593, 7018, 6856, 6725, 6713, 5232, 6277, 6402, 6529,
+		4839, 4887, 4882, 7341, 7604, 7148, 6980, 6821, 6717, 6563, 5271, 6407, 6533,
+		4849, 4897, 4891, 7387, 7652, 7153, 7113, 6954, 6850, 6696, 6700, 5325, 6569,
+		4858, 4906, 4901, 7433, 7700, 7191, 7118, 7088, 6983, 6829, 6834, 6872, 5384,
+	},
+	{ // J9s
+		2056, 3676, 3660, 2965, 3898, 3164, 4310, 4360, 4440, 4383, 4421, 4421, 4429,
+		3926, 1945, 3568, 3018, 3805, 3077, 4339, 4366, 4437, 4431, 4469, 4469, 4478,
+		3905, 3808, 1807, 2960, 3704, 2985, 4259, 4384, 4435, 4430, 4468, 4468, 4476,
+		3081, 3131, 3060, 1606, 3103, 5000, 6712, 6728, 6739, 6711, 6756, 6764, 6806,
+		4155, 4057, 3950, 3219, 3249, 6956, 6248, 6336, 6425, 6507, 6522, 6526, 6562,
+		3295, 3194, 3086, 5249, 7354, 3744, 6868, 6905, 7046, 7020, 7168, 7179, 7224,
+		4585, 4611, 4525, 7097, 6532, 7262, 4813, 6343, 6433, 6512, 6649, 6773, 6777,
+		4639, 4642, 4657, 7117, 6624, 7304, 6628, 4914, 6304, 6359, 6496, 6615, 6741,
+		4725, 4719, 4714, 7133, 6718, 7461, 6722, 6589, 5046, 6256, 6394, 6486, 6612,
+		4665, 4713, 4708, 7102, 6805, 7432, 6806, 6647, 6543, 5072, 6243, 6336, 6462,
+		4707, 4755, 4750, 7152, 6822, 7593, 6951, 6792, 6688, 6529, 5164, 6381, 6508,
+		4707, 4755, 4750, 7160, 6827, 7604, 7081, 6917, 6785, 6626, 6675, 5208, 6513,
+		4717, 4765, 4760, 7206, 6865, 7653, 7087, 7051, 6918, 6759, 6809, 6814, 5267,
+	},
+	{ // J8s
+		1937, 3593, 3577, 2990, 3815, 3930, 3040, 4247, 4326, 4269, 4303, 4342, 4342,
+		3842, 1906, 3476, 2877, 3717, 3833, 3081, 4235, 4306, 4300, 4335, 4373, 4373,
+		3821, 3714, 1793, 2924, 3617, 3733, 2989, 4253, 4304, 4299, 4333, 4372, 4372,
+		3108, 2983, 3026, 1475, 3091, 3288, 5000, 6487, 6510, 6481, 6497, 6541, 6549,
+		4071, 3967, 3861, 3211, 3209, 5720, 6952, 6252, 6341, 6423, 6407, 6450, 6454,
+		4192, 4089, 3983, 3422, 5987, 3264, 6822, 6148, 6237, 6320, 6436, 6452, 6457,
+		3161, 3202, 3094, 5249, 7354, 7213, 3663, 6771, 6913, 6887, 7035, 7184, 7195,
+		4519, 4507, 4522, 6860, 6538, 6430, 7160, 4794, 6272, 6327, 6461, 6599, 6723,
+		4605, 4584, 4579, 6888, 6632, 6523, 7317, 6556, 4927, 6199, 6333, 6471, 6590,
+		4545, 4578, 4573, 6856, 6719, 6611, 7288, 6614, 6482, 4953, 6182, 6320, 6439,
+		4583, 4616, 4611, 6874, 6703, 6734, 7449, 6756, 6624, 6465, 5041, 6366, 6459,
+		4625, 4658, 4653, 6923, 6750, 6752, 7611, 6902, 6769, 6610, 6660, 5138, 6505,
+		4626, 4659, 4654, 6932, 6755, 6758, 7623, 7033, 6895, 6736, 6758, 6808, 5188,
+	},
+	{ // J7s
+		1813, 3503, 3472, 2875, 3730, 3846, 3819, 2936, 4212, 4155, 4190, 4224, 4263,
+		3747, 1787, 3392, 2901, 3633, 3749, 3833, 2957, 4191, 4186, 4220, 4255, 4293,
+		3714, 3629, 1754, 2782, 3529, 3644, 3729, 2993, 4173, 4167, 4201, 4236, 4274,
+		2985, 3009, 2878, 1343, 3082, 3272, 3513, 5000, 6204, 6175, 6187, 6203, 6247,
+		3985, 3882, 3771, 3207, 3168, 5595, 5725, 6949, 6256, 6339, 6318, 6334, 6377,
+		4107, 4004, 3893, 3410, 5859, 3224, 5640, 6818, 6153, 6236, 6352, 6337, 6380,
+		4078, 4092, 3981, 3667, 5996, 5905, 3278, 6691, 6053, 6132, 6248, 6366, 6382,
+		3048, 3068, 3103, 5249, 7354, 7213, 7075, 3589, 6781, 6755, 6903, 7052, 7201,
+		4486, 4463, 4443, 6560, 6545, 6437, 6332, 7175, 4808, 6168, 6302, 6437, 6575,
+		4426, 4457, 4437, 6527, 6633, 6525, 6415, 7146, 6449, 4834, 6152, 6286, 6425,
+		4464, 4495, 4475, 6542, 6612, 6649, 6539, 7306, 6591, 6432, 4922, 6306, 6444,
+		4502, 4533, 4513, 6559, 6630, 6633, 6664, 7468, 6734, 6575, 6596, 5015, 6491,
+		4545, 4576, 4556, 6609, 6678, 6681, 6682, 7630, 6880, 6721, 6743, 6793, 5119,
+	},
+	{ // J6s
+		1690, 3417, 3389, 2765, 3654, 3769, 3742, 3746, 2836, 4067, 4102, 4136, 4175,
+		3659, 1663, 3301, 2787, 3548, 3664, 3749, 3725, 2853, 4071, 4106, 4140, 4175,
+		3628, 3534, 1635, 2806, 3444, 3560, 3645, 3729, 2869, 4052, 4086, 4121, 4155,
+		2867, 2886, 2904, 1211, 3045, 3261, 3490, 3796, 5000, 5793, 5815, 5827, 5842,
+		3908, 3796, 3685, 3173, 3127, 5470, 5600, 5732, 6945, 6254, 6233, 6244, 6261,
+		4030, 3917, 3807, 3403, 5730, 3183, 5515, 5646, 6815, 6151, 6268, 6247, 6264,
+		4001, 4006, 3895, 3648, 5867, 5777, 3237, 5564, 6687, 6048, 6164, 6282, 6267,
+		4005, 3981, 3984, 3976, 6005, 5915, 5828, 3298, 6590, 5949, 6065, 6183, 6301,
+		2941, 2955, 2969, 5249, 7354, 7213, 7075, 6970, 3523, 6625, 6773, 6921, 7070,
+		4334, 4337, 4315, 6115, 6547, 6438, 6329, 6225, 7005, 4716, 6126, 6261, 6396,
+		4372, 4375, 4353, 6140, 6525, 6562, 6453, 6349, 7165, 6405, 4803, 6275, 6411,
+		4411, 4414, 4392, 6154, 6539, 6542, 6578, 6474, 7327, 6548, 6564, 4897, 6431,
+		4453, 4452, 4430, 6172, 6558, 6561, 6563, 6599, 7489, 6691, 6707, 6730, 4996,
+	},
+	{ // J5s
+		1705, 3419, 3391, 2756, 3644, 3791, 3769, 3769, 3796, 2822, 4012, 4047, 4081,
+		3660, 1706, 3331, 2812, 3567, 3714, 3803, 3776, 3780, 2870, 4105, 4139, 4174,
+		3628, 3565, 1678, 2832, 3463, 3610, 3699, 3784, 3761, 2886, 4085, 4120, 4154,
+		2855, 2914, 2932, 1254, 3073, 3289, 3519, 3825, 4207, 5000, 5520, 5544, 5556,
+		3896, 3816, 3705, 3203, 3128, 5412, 5547, 5678, 5811, 7066, 6259, 6271, 6283,
+		4051, 3970, 3860, 3434, 5672, 3217, 5486, 5618, 5750, 6936, 6294, 6277, 6289,
+		4027, 4064, 3954, 3679, 5813, 5748, 3277, 5540, 5673, 6804, 6194, 6312, 6292,
+		4027, 4035, 4042, 4007, 5951, 5886, 5804, 3337, 5618, 6677, 6097, 6214, 6332,
+		4057, 4040, 4018, 4419, 6089, 6024, 5942, 5885, 3404, 6580, 6025, 6143, 6261,
+		2925, 2974, 2987, 5250, 7485, 7344, 7201, 7064, 6960, 3585, 6620, 6768, 6917,
+		4276, 4373, 4351, 5821, 6552, 6589, 6484, 6381, 6306, 6999, 4793, 6298, 6433,
+		4314, 4411, 4389, 5848, 6565, 6572, 6609, 6506, 6431, 7160, 6587, 4886, 6449,
+		4353, 4450, 4428, 5863, 6580, 6586, 6589, 6631, 6557, 7322, 6730, 6747, 4985,
+	},
+	{ // J4s
+		1664, 3385, 3356, 2711, 3640, 3749, 3753, 3758, 3786, 3660, 2808, 4012, 4047,
+		3623, 1665, 3297, 2768, 3563, 3672, 3787, 3765, 3769, 3735, 2855, 4104, 4139,
+		3592, 3529, 1638, 2788, 3459, 3568, 3683, 3773, 3750, 3715, 2871, 4085, 4120,
+		2807, 2866, 2885, 1214, 3065, 3244, 3503, 3813, 4185, 4480, 5000, 5287, 5314,
+		3892, 3812, 3701, 3195, 3128, 5412, 5547, 5678, 5811, 5928, 7066, 6271, 6283,
+		4008, 3927, 3816, 3386, 5672, 3165, 5403, 5538, 5671, 5788, 7055, 6242, 6254,
+		4012, 4049, 3938, 3664, 5813, 5663, 3254, 5482, 5614, 5727, 6923, 6276, 6260,
+		4017, 4025, 4032, 3995, 5951, 5805, 5745, 3320, 5564, 5650, 6796, 6182, 6300,
+		4047, 4029, 4007, 4397, 6089, 5944, 5883, 5830, 3386, 5597, 6699, 6111, 6229,
+		3913, 3991, 3970, 4713, 6212, 6066, 6001, 5920, 5863, 3404, 6551, 5972, 6090,
+		2910, 2958, 2972, 5250, 7486, 7474, 7331, 7194, 7090, 6927, 3620, 6783, 6932,
+		4278, 4374, 4352, 5571, 6565, 6536, 6572, 6473, 6399, 6251, 7176, 4854, 6441,
+		4316, 4413, 4391, 5601, 6580, 6550, 6557, 6599, 6524, 6376, 7339, 6739, 4953,
+	},
+	{ // J3s
+		1624, 3350, 3322, 2666, 3606, 3745, 3710, 3741, 3774, 3649, 3657, 2793, 4012,
+		3587, 1625, 3262, 2723, 3529, 3668, 3745, 3749, 3758, 3723, 3732, 2841, 4104,
+		3555, 3493, 1597, 2743, 3425, 3564, 3641, 3757, 3738, 3704, 3712, 2857, 4084,
+		2758, 2818, 2837, 1174, 3023, 3236, 3459, 3797, 4173, 4456, 4713, 5000, 5283,
+		3856, 3775, 3665, 3150, 3091, 5412, 5508, 5644, 5776, 5894, 5898, 7081, 6274,
+		4003, 3923, 3812, 3378, 5672, 3165, 5403, 5538, 5671, 5788, 5908, 7055, 6254,
+		3968, 4005, 3894, 3617, 5773, 5663, 3202, 5399, 5535, 5648, 5768, 7042, 6225,
+		4001, 4009, 4016, 3980, 5915, 5805, 5661, 3297, 5506, 5592, 5712, 6915, 6265,
+		4036, 4018, 3996, 4385, 6054, 5944, 5803, 5771, 3368, 5542, 5662, 6818, 6198,
+		3902, 3981, 3959, 4688, 6176, 6066, 5920, 5860, 5808, 3386, 5544, 6670, 6059,
+		3911, 3990, 3968, 4966, 6181, 6192, 6047, 5987, 5934, 5807, 3440, 6708, 6094,
+		2895, 2943, 2957, 5251, 7502, 7475, 7461, 7324, 7220, 7057, 7099, 3662, 6947,
+		4280, 4376, 4354, 5568, 6571, 6550, 6520, 6563, 6492, 6344, 6382, 7355, 4921,
+	},
+	{ // J2s
+		1583, 3315, 3286, 2620, 3570, 3709, 3706, 3698, 3758, 3637, 3645, 3654, 2779,
+		3549, 1584, 3227, 2677, 3493, 3632, 3740, 3706, 3741, 3711, 3720, 3728, 2826,
+		3518, 3455, 1557, 2698, 3389, 3528, 3636, 3714, 3721, 3692, 3700, 3708, 2842,
+		2709, 2769, 2788, 1134, 2981, 3194, 3451, 3753, 4158, 4444, 4686, 4717, 5000,
+		3818, 3738, 3627, 3105, 3055, 5404, 5508, 5605, 5742, 5859, 5863, 5899, 7095,
+		3966, 3885, 3775, 3333, 5663, 3129, 5403, 5500, 5636, 5754, 5873, 5878, 7070,
+		3963, 4000, 3889, 3608, 5773, 5663, 3202, 5399, 5535, 5648, 5767, 5888, 7042,
+		3956, 3964, 3971, 3933, 5876, 5766, 5660, 3245, 5423, 5513, 5633, 5753, 7034,
+		4020, 4001, 3979, 4370, 6018, 5908, 5803, 5687, 3345, 5484, 5604, 5724, 6937,
+		3891, 3969, 3947, 4677, 6141, 6031, 5920, 5780, 5749, 3368, 5489, 5610, 6789,
+		3900, 3978, 3956, 4938, 6145, 6157, 6046, 5906, 5875, 5752, 3422, 5645, 6827,
+		3910, 3988, 3966, 4972, 6183, 6162, 6173, 6033, 6002, 5879, 5917, 3482, 6866,
+		2880, 2928, 2942, 5251, 7519, 7492, 7462, 7455, 7350, 7187, 7229, 7271, 3709,
+	},
+	{ // ATo
+		814, 2515, 2534, 2606, 4751, 6786, 6758, 6734, 6729, 6611, 6619, 6664, 6710,
+		2626, 2900, 5641, 5638, 7010, 6076, 6161, 6104, 6077, 6117, 6121, 6157, 6195,
+		2642, 5986, 2874, 5540, 6861, 5961, 6045, 6131, 6071, 6110, 6114, 6151, 6188,
+		2715, 5981, 5875, 2856, 6712, 5845, 5929, 6015, 6092, 6104, 6108, 6144, 6182,
+		5000, 7417, 7257, 7096, 3087, 6790, 6764, 6887, 7005, 7165, 7177, 7226, 7275,
+		7203, 6455, 6331, 6206, 7208, 4447, 5939, 6042, 6138, 6274, 6403, 6408, 6445,
+		7171, 6545, 6420, 6296, 7178, 6305, 4458, 5918, 6013, 6150, 6279, 6410, 6415,
+		7145, 6484, 6511, 6387, 7310, 6415, 6281, 4528, 5905, 6041, 6165, 6296, 6427,
+		7140, 6456, 6448, 6470, 7437, 6516, 6382, 6265, 4570, 5929, 6025, 6156, 6287,
+		7013, 6498, 6490, 6482, 7608, 6660, 6526, 6410, 6291, 4654, 5935, 6065, 6197,
+		7021, 6502, 6494, 6486, 7620, 6800, 6665, 6543, 6394, 6298, 4702, 6070, 6201,
+		7070, 6542, 6534, 6526, 7672, 6804, 6806, 6684, 6535, 6439, 6443, 4768, 6239,
+		7119, 6583, 6575, 6567, 7725, 6845, 6812, 6826, 6677, 6580, 6585, 6625, 4840,
+	},
+	{ // KTo
+		1376, 2501, 3566, 3533, 2474, 3985, 4037, 4029, 4064, 4045, 4045, 4054, 4062,
+		2588, 957, 2535, 2606, 4751, 6795, 6805, 6776, 6756, 6764, 6773, 6818, 6863,
+		3747, 2638, 2885, 5618, 6963, 6059, 6149, 6234, 6183, 6191, 6195, 6231, 6269,
+		3711, 2710, 5956, 2867, 6814, 5943, 6033, 6118, 6204, 6184, 6188, 6225, 6262,
+		2583, 5000, 7364, 7203, 3202, 6741, 6889, 6863, 6985, 7106, 7118, 7166, 7215,
+		4198, 7208, 6433, 6308, 7152, 4461, 6042, 6099, 6203, 6308, 6437, 6442, 6479,
+		4260, 7222, 6527, 6403, 7315, 6412, 4594, 5962, 6066, 6171, 6295, 6425, 6430,
+		4250, 7190, 6618, 6493, 7285, 6471, 6327, 4611, 5942, 6047, 6171, 6302, 6433,
+		4290, 7168, 6563, 6585, 7417, 6582, 6437, 6304, 4686, 5967, 6063, 6194, 6325,
+		4271, 7178, 6573, 6565, 7546, 6693, 6549, 6415, 6330, 4775, 5954, 6085, 6216,
+		4271, 7186, 6577, 6569, 7558, 6832, 6682, 6549, 6433, 6316, 4821, 6089, 6220,
+		4281, 7235, 6617, 6609, 7610, 6837, 6823, 6690, 6574, 6457, 6462, 4883, 6258,
+		4291, 7284, 6658, 6649, 7663, 6878, 6829, 6831, 6715, 6599, 6603, 6644, 4953,
+	},
+	{ // QTo
+		1551, 3388, 2630, 3653, 2624, 4087, 4114, 4186, 4186, 4167, 4167, 4176, 4184,
+		3552, 1400, 2538, 3557, 2531, 4012, 4141, 4192, 4184, 4218, 4218, 4226, 4235,
+		2727, 2620, 1126, 2621, 4751, 6788, 6796, 6802, 6773, 6782, 6791, 6836, 6881,
+		3835, 3732, 2722, 2917, 6922, 6050, 6139, 6229, 6315, 6295, 6299, 6335, 6373,
+		2743, 2636, 5000, 7317, 3357, 6812, 6846, 6994, 6968, 7088, 7099, 7147, 7196,
+		4304, 4223, 7196, 6419, 7226, 4537, 6119, 6210, 6292, 6398, 6526, 6531, 6568,
+		4338, 4360, 7209, 6513, 7265, 6490, 4643, 6073, 6130, 6236, 6359, 6490, 6495,
+		4419, 4421, 7217, 6608, 7429, 6587, 6442, 4781, 6021, 6127, 6223, 6353, 6484,
+		4419, 4411, 7186, 6699, 7399, 6674, 6502, 6389, 4802, 6004, 6100, 6230, 6361,
+		4400, 4449, 7196, 6679, 7528, 6785, 6614, 6500, 6367, 4889, 5990, 6121, 6252,
+		4401, 4449, 7204, 6683, 7540, 6924, 6747, 6603, 6471, 6354, 4932, 6126, 6257,
+		4410, 4459, 7253, 6723, 7591, 6929, 6888, 6744, 6611, 6495, 6499, 4992, 6295,
+		4420, 4469, 7302, 6763, 7644, 6970, 6894, 6886, 6753, 6636, 6641, 6682, 5058,
+	},
+	{ // JTo
+		1726, 3516, 3497, 2760, 2774, 4189, 4211, 4262, 4346, 4288, 4288, 4297, 4305,
+		3688, 1575, 3380, 2668, 2681, 4114, 4242, 4269, 4345, 4339, 4339, 4347, 4356,
+		3662, 3538, 1423, 2569, 2583, 4031, 4160, 4288, 4344, 4338, 4338, 4347, 4355,
+		2865, 2759, 2644, 1297, 4751, 6781, 6789, 6793, 6827, 6797, 6805, 6850, 6895,
+		2904, 2797, 2683, 5000, 3512, 6916, 6918, 6953, 7101, 7073, 7083, 7131, 7179,
+		4409, 4328, 4239, 7184, 7336, 4618, 6239, 6311, 6406, 6489, 6618, 6623, 6660,
+		4439, 4465, 4376, 7196, 7341, 6614, 4718, 6149, 6244, 6324, 6448, 6578, 6583,
+		4497, 4498, 4512, 7203, 7381, 6690, 6520, 4828, 6135, 6190, 6286, 6417, 6548,
+		4592, 4584, 4578, 7244, 7545, 6790, 6620, 6506, 4975, 6173, 6178, 6308, 6440,
+		4529, 4578, 4571, 7210, 7513, 6880, 6705, 6564, 6551, 5003, 6026, 6157, 6288,
+		4529, 4578, 4572, 7219, 7524, 7019, 6838, 6668, 6555, 6391, 5044, 6162, 6293,
+		4539, 4588, 4581, 7267, 7575, 7024, 6979, 6808, 6695, 6531, 6536, 5101, 6331,
+		4549, 4598, 4591, 7316, 7627, 7064, 6985, 6950, 6837, 6673, 6678, 6718, 5163,
+	},
+	{ // TT
+		1925, 5406, 5414, 5422, 6520, 6854, 6817, 6798, 6821, 6696, 6696, 6732, 6769,
+		5688, 1886, 5369, 5377, 6414, 6843, 6884, 6847, 6834, 6847, 6847, 6883, 6919,
+		5695, 5649, 1843, 5301, 6272, 6797, 6837, 6878, 6846, 6859, 6859, 6896, 6932,
+		5702, 5656, 5576, 1801, 6131, 6751, 6791, 6832, 6873, 6872, 6872, 6909, 6945,
+		6913, 6798, 6643, 6488, 5000, 8231, 8362, 8493, 8625, 8754, 8754, 8794, 8834,
+		7219, 7208, 7159, 7109, 8717, 8200, 8170, 8179, 8193, 8229, 8388, 8389, 8429,
+		7178, 7250, 7200, 7151, 8858, 8580, 8162, 8028, 8042, 8078, 8238, 8402, 8402,
+		7158, 7209, 7242, 7192, 9000, 8589, 8429, 8125, 7896, 7932, 8087, 8251, 8416,
+		7183, 7195, 7207, 7234, 9142, 8603, 8442, 8287, 8093, 7820, 7941, 8106, 8270,
+		7048, 7209, 7221, 7234, 9282, 8640, 8479, 8324, 8205, 8096, 7786, 7950, 8115,
+		7048, 7209, 7221, 7234, 9282, 8811, 8651, 8490, 8335, 8170, 8098, 7950, 8115,
+		7088, 7249, 7261, 7274, 9326, 8812, 8826, 8666, 8511, 8346, 8346, 8142, 8156,
+		7128, 7289, 7301, 7314, 9370, 8856, 8827, 8842, 8687, 8522, 8522, 8566, 8187,
+	},
+	{ // T9s
+		2220, 3798, 3782, 3762, 3084, 3301, 4405, 4451, 4509, 4535, 4535, 4535, 4544,
+		4052, 2109, 3689, 3670, 3137, 3214, 4434, 4456, 4506, 4584, 4584, 4584, 4592,
+		4032, 3934, 1971, 3553, 3079, 3122, 4354, 4479, 4505, 4582, 4582, 4582, 4591,
+		4008, 3910, 3786, 1830, 2989, 3044, 4280, 4405, 4530, 4588, 4588, 4588, 4596,
+		3210, 3259, 3188, 3084, 1769, 5000, 6701, 6716, 6727, 6761, 6769, 6777, 6818,
+		3443, 3341, 3234, 3141, 5249, 3885, 6932, 6941, 6978, 7117, 7127, 7138, 7182,
+		4681, 4708, 4622, 4544, 7081, 7329, 4888, 6445, 6517, 6608, 6724, 6847, 6852,
+		4732, 4734, 4753, 4675, 7101, 7343, 6732, 4984, 6365, 6456, 6568, 6687, 6813,
+		4796, 4789, 4784, 4806, 7116, 7386, 6807, 6650, 5088, 6353, 6442, 6534, 6661,
+		4825, 4873, 4868, 4870, 7156, 7540, 6903, 6746, 6641, 5230, 6396, 6400, 6526,
+		4825, 4873, 4868, 4871, 7165, 7551, 7026, 6865, 6735, 6689, 5267, 6404, 6531,
+		4826, 4874, 4869, 4871, 7173, 7562, 7157, 6990, 6832, 6694, 6698, 5308, 6536,
+		4835, 4884, 4879, 4881, 7219, 7610, 7162, 7124, 6966, 6827, 6832, 6837, 5364,
+	},
+	{ // T8s
+		2101, 3715, 3698, 3679, 3109, 4042, 3177, 4337, 4395, 4418, 4418, 4456, 4456,
+		3968, 2070, 3598, 3581, 2996, 3945, 3218, 4325, 4375, 4449, 4449, 4487, 4487,
+		3947, 3840, 1958, 3482, 3043, 3845, 3125, 4348, 4374, 4447, 4447, 4486, 4486,
+		3923, 3820, 3715, 1821, 2982, 3752, 3048, 4275, 4400, 4453, 4453, 4492, 4492,
+		3236, 3111, 3154, 3082, 1638, 3299, 5000, 6476, 6498, 6509, 6516, 6561, 6569,
+		4307, 4204, 4098, 3999, 3429, 3309, 6877, 6232, 6320, 6407, 6523, 6539, 6543,
+		3308, 3350, 3242, 3149, 5249, 7271, 3802, 6796, 6833, 6972, 6982, 7130, 7141,
+		4612, 4599, 4618, 4540, 6844, 6514, 7186, 4864, 6329, 6417, 6529, 6667, 6791,
+		4675, 4654, 4649, 4672, 6871, 6607, 7229, 6612, 4969, 6288, 6377, 6514, 6634,
+		4701, 4734, 4729, 4731, 6886, 6699, 7384, 6705, 6572, 5107, 6242, 6380, 6473,
+		4701, 4734, 4729, 4732, 6894, 6822, 7394, 6824, 6666, 6528, 5143, 6384, 6478,
+		4743, 4776, 4771, 4774, 6944, 6840, 7555, 6970, 6812, 6673, 6678, 5238, 6524,
+		4744, 4777, 4772, 4775, 6952, 6845, 7567, 7101, 6938, 6771, 6776, 6826, 5284,
+	},
+	{ // T7s
+		1977, 3624, 3594, 3577, 2994, 3958, 3931, 3072, 4282, 4304, 4304, 4339, 4377,
+		3873, 1951, 3514, 3497, 3020, 3861, 3945, 3094, 4261, 4334, 4334, 4369, 4407,
+		3840, 3755, 1919, 3390, 2901, 3757, 3841, 3129, 4242, 4316, 4316, 4350, 4389,
+		3820, 3734, 3620, 1807, 2946, 3664, 3748, 3051, 4268, 4322, 4322, 4356, 4395,
+		3113, 3137, 3006, 3047, 1507, 3284, 3524, 5000, 6193, 6221, 6228, 6243, 6288,
+		4221, 4119, 4008, 3910, 3418, 3269, 5703, 6873, 6236, 6323, 6439, 6423, 6467,
+		4193, 4207, 4096, 3998, 3674, 5970, 3322, 6743, 6133, 6220, 6336, 6453, 6469,
+		3196, 3215, 3251, 3158, 5249, 7271, 7130, 3726, 6697, 6837, 6847, 6995, 7143,
+		4557, 4533, 4513, 4536, 6544, 6521, 6413, 7083, 4849, 6256, 6344, 6479, 6617,
+		4582, 4613, 4593, 4596, 6577, 6613, 6504, 7238, 6538, 4987, 6184, 6318, 6457,
+		4582, 4613, 4593, 4596, 6585, 6736, 6628, 7248, 6632, 6466, 5024, 6323, 6461,
+		4620, 4651, 4631, 4634, 6602, 6721, 6753, 7409, 6775, 6608, 6613, 5114, 6508,
+		4663, 4694, 4674, 4677, 6652, 6768, 6771, 7571, 6921, 6755, 6759, 6810, 5214,
+	},
+	{ // T6s
+		1855, 3539, 3511, 3480, 2884, 3881, 3854, 3854, 2972, 4216, 4216, 4251, 4289,
+		3786, 1827, 3423, 3392, 2906, 3776, 3860, 3833, 2989, 4220, 4220, 4255, 4289,
+		3754, 3660, 1800, 3305, 2925, 3672, 3756, 3841, 3005, 4201, 4201, 4235, 4270,
+		3721, 3627, 3535, 1768, 2804, 3575, 3659, 3744, 3055, 4189, 4189, 4224, 4258,
+		2995, 3015, 3032, 2899, 1375, 3273, 3502, 3807, 5000, 5869, 5876, 5888, 5903,
+		4144, 4033, 3922, 3819, 3412, 3228, 5578, 5709, 6869, 6238, 6354, 6334, 6351,
+		4115, 4121, 4010, 3907, 3656, 5841, 3281, 5624, 6740, 6136, 6252, 6369, 6354,
+		4115, 4092, 4099, 3995, 3983, 5979, 5889, 3340, 6612, 6036, 6149, 6266, 6384,
+		3088, 3103, 3116, 3167, 5249, 7271, 7131, 6993, 3656, 6705, 6715, 6863, 7012,
+		4491, 4493, 4472, 4459, 6199, 6526, 6418, 6314, 7095, 4869, 6153, 6288, 6423,
+		4491, 4494, 4472, 4459, 6206, 6650, 6542, 6433, 7105, 6433, 4905, 6292, 6427,
+		4529, 4532, 4510, 4498, 6220, 6629, 6667, 6558, 7266, 6576, 6580, 4995, 6448,
+		4572, 4570, 4548, 4536, 6237, 6649, 6652, 6683, 7428, 6719, 6724, 6746, 5091,
+	},
+	{ // T5s
+		1705, 3419, 3390, 3362, 2737, 3770, 3743, 3743, 3771, 2840, 4011, 4046, 4080,
+		3659, 1706, 3331, 3303, 2793, 3693, 3777, 3750, 3754, 2887, 4103, 4138, 4172,
+		3628, 3565, 1678, 3216, 2813, 3589, 3673, 3758, 3735, 2903, 4084, 4118, 4153,
+		3596, 3534, 3441, 1652, 2830, 3493, 3577, 3661, 3746, 2934, 4072, 4106, 4141,
+		2835, 2894, 2912, 2927, 1246, 3239, 3491, 3779, 4131, 5000, 5501, 5525, 5537,
+		4028, 3948, 3838, 3735, 3380, 3190, 5450, 5582, 5714, 6863, 6273, 6252, 6264,
+		3999, 4036, 3926, 3823, 3650, 5710, 3243, 5497, 5629, 6733, 6170, 6288, 6268,
+		4000, 4008, 4014, 3912, 3958, 5847, 5757, 3302, 5548, 6607, 6068, 6185, 6303,
+		4029, 4012, 3990, 4000, 4336, 5986, 5896, 5810, 3366, 6510, 5969, 6087, 6204,
+		2944, 2993, 3006, 3035, 5250, 7268, 7128, 6991, 6886, 3596, 6550, 6699, 6847,
+		4275, 4372, 4350, 4335, 5800, 6566, 6459, 6350, 6247, 6927, 4784, 6270, 6405,
+		4313, 4410, 4388, 4373, 5828, 6546, 6583, 6475, 6372, 7088, 6557, 4874, 6420,
+		4352, 4448, 4426, 4412, 5842, 6560, 6564, 6601, 6497, 7250, 6700, 6717, 4970,
+	},
+	{ // T4s
+		1705, 3415, 3387, 3359, 2725, 3755, 3759, 3764, 3788, 3698, 2840, 4041, 4076,
+		3656, 1706, 3327, 3299, 2782, 3678, 3793, 3771, 3771, 3772, 2887, 4134, 4168,
+		3624, 3562, 1678, 3213, 2802, 3574, 3690, 3779, 3752, 3753, 2903, 4114, 4149,
+		3593, 3530, 3437, 1652, 2820, 3478, 3593, 3682, 3767, 3741, 2934, 4102, 4137,
+		2823, 2882, 2901, 2917, 1246, 3231, 3484, 3772, 4124, 4499, 5000, 5287, 5314,
+		4013, 3933, 3822, 3719, 3372, 3151, 5387, 5522, 5655, 5790, 7002, 6244, 6256,
+		4017, 4054, 3943, 3840, 3642, 5646, 3239, 5462, 5594, 5729, 6872, 6279, 6263,
+		4022, 4030, 4037, 3934, 3951, 5787, 5722, 3303, 5518, 5653, 6740, 6180, 6298,
+		4048, 4030, 4008, 4023, 4329, 5926, 5861, 5780, 3367, 5599, 6614, 6083, 6201,
+		3953, 4031, 4009, 3995, 4734, 6067, 6002, 5921, 5864, 3436, 6503, 5983, 6101,
+		2945, 2993, 3007, 3035, 5250, 7419, 7278, 7136, 6999, 6878, 3644, 6699, 6848,
+		4309, 4405, 4383, 4369, 5571, 6537, 6575, 6470, 6368, 6262, 7088, 4874, 6420,
+		4347, 4444, 4422, 4407, 5601, 6551, 6559, 6596, 6493, 6387, 7250, 6717, 4970,
+	},
+	{ // T3s
+		1664, 3381, 3352, 3324, 2680, 3751, 3716, 3747, 3776, 3686, 3686, 2825, 4041,
+		3619, 1665, 3293, 3264, 2737, 3674, 3751, 3755, 3760, 3761, 3761, 2873, 4133,
+		3587, 3525, 1638, 3178, 2758, 3570, 3647, 3763, 3741, 3741, 3742, 2889, 4114,
+		3556, 3493, 3401, 1611, 2776, 3474, 3550, 3666, 3756, 3729, 3729, 2919, 4101,
+		2774, 2834, 2853, 2869, 1206, 3223, 3439, 3757, 4112, 4475, 4713, 5000, 5283,
+		4008, 3928, 3818, 3715, 3364, 3151, 5387, 5522, 5655, 5789, 5910, 7002, 6256,
+		3973, 4010, 3899, 3796, 3595, 5646, 3187, 5379, 5515, 5650, 5771, 6991, 6228,
+		4006, 4014, 4021, 3918, 3935, 5787, 5638, 3280, 5460, 5594, 5710, 6859, 6263,
+		4037, 4019, 3997, 4012, 4318, 5926, 5781, 5721, 3349, 5545, 5634, 6733, 6169,
+		3942, 4020, 3998, 3984, 4709, 6067, 5922, 5862, 5809, 3418, 5554, 6622, 6069,
+		3942, 4021, 3999, 3984, 4966, 6194, 6049, 5984, 5903, 5818, 3464, 6627, 6074,
+		2930, 2978, 2991, 3020, 5251, 7419, 7408, 7266, 7130, 7009, 7014, 3682, 6863,
+		4310, 4406, 4385, 4370, 5568, 6551, 6523, 6560, 6461, 6355, 6360, 7267, 4938,
+	},
+	{ // T2s
+		1624, 3345, 3317, 3289, 2634, 3715, 3712, 3704, 3761, 3675, 3675, 3683, 2811,
+		3582, 1624, 3257, 3229, 2692, 3639, 3746, 3712, 3743, 3749, 3749, 3757, 2858,
+		3550, 3488, 1597, 3143, 2713, 3535, 3642, 3720, 3724, 3729, 3729, 3738, 2874,
+		3519, 3456, 3363, 1571, 2732, 3438, 3546, 3623, 3739, 3717, 3717, 3726, 2905,
+		2725, 2785, 2804, 2821, 1166, 3182, 3431, 3712, 4097, 4463, 4686, 4717, 5000,
+		3971, 3891, 3781, 3678, 3319, 3115, 5387, 5484, 5620, 5755, 5875, 5880, 7016,
+		3968, 4005, 3894, 3791, 3586, 5645, 3187, 5379, 5515, 5650, 5771, 5891, 6991,
+		3961, 3970, 3976, 3873, 3888, 5748, 5638, 3228, 5377, 5515, 5631, 5751, 6978,
+		4021, 4003, 3981, 3995, 4302, 5890, 5781, 5637, 3326, 5486, 5576, 5696, 6852,
+		3931, 4009, 3987, 3972, 4697, 6031, 5922, 5782, 5750, 3401, 5500, 5620, 6741,
+		3931, 4009, 3987, 3973, 4938, 6158, 6049, 5903, 5844, 5763, 3446, 5625, 6746,
+		3940, 4018, 3997, 3982, 4972, 6164, 6176, 6030, 5971, 5890, 5895, 3503, 6785,
+		2915, 2963, 2977, 3005, 5251, 7436, 7409, 7397, 7260, 7139, 7144, 7186, 3726,
+	},
+	{ // A9o
+		622, 2461, 2517, 2580, 2678, 4751, 6525, 6504, 6495, 6345, 6393, 6401, 6446,
+		2576, 2836, 5473, 5470, 5467, 7001, 6039, 5982, 5950, 5962, 6005, 6009, 6047,
+		2632, 5811, 2810, 5372, 5369, 6853, 5923, 6008, 5943, 5955, 5998, 6003, 6040,
+		2696, 5806, 5700, 2792, 5272, 6705, 5808, 5893, 5970, 5949, 5992, 5997, 6034,
+		2797, 5802, 5696, 5591, 2781, 6557, 5693, 5779, 5856, 5972, 5987, 5992, 6029,
+		5000, 7414, 7253, 7093, 6933, 2955, 6620, 6743, 6862, 7021, 7182, 7195, 7244,
+		6923, 6419, 6294, 6171, 6048, 7024, 4290, 5885, 5981, 6114, 6255, 6385, 6390,
+		6900, 6358, 6385, 6262, 6139, 7156, 6248, 4361, 5867, 6000, 6141, 6271, 6402,
+		6892, 6324, 6316, 6344, 6221, 7283, 6349, 6227, 4402, 5861, 6001, 6126, 6257,
+		6729, 6336, 6328, 6321, 6344, 7454, 6490, 6368, 6219, 4482, 5911, 6007, 6138,
+		6782, 6384, 6376, 6369, 6362, 7627, 6641, 6519, 6370, 6273, 4587, 6054, 6186,
+		6790, 6389, 6381, 6374, 6367, 7640, 6781, 6659, 6504, 6377, 6429, 4643, 6191,
+		6839, 6430, 6422, 6414, 6407, 7693, 6786, 6800, 6646, 6519, 6571, 6576, 4715,
+	},
+	{ // K9o
+		1313, 2297, 3440, 3408, 3376, 2471, 3863, 3855, 3891, 3868, 3907, 3907, 3916,
+		2376, 766, 2519, 2581, 2677, 4751, 6592, 6563, 6539, 6516, 6563, 6572, 6617,
+		3618, 2628, 2821, 5450, 5447, 6954, 6026, 6112, 6055, 6036, 6079, 6083, 6121,
+		3582, 2692, 5781, 2803, 5350, 6806, 5911, 5996, 6083, 6030, 6073, 6077, 6115,
+		3545, 2792, 5777, 5672, 2792, 6659, 5796, 5881, 5967, 6052, 6067, 6072, 6109,
+		2586, 5000, 7360, 7200, 7040, 3067, 6743, 6717, 6840, 6961, 7121, 7133, 7182,
+		4079, 6996, 6401, 6278, 6154, 7159, 4427, 5923, 6027, 6130, 6270, 6400, 6405,
+		4070, 6964, 6492, 6368, 6244, 7129, 6288, 4443, 5904, 6006, 6147, 6276, 6408,
+		4109, 6938, 6432, 6460, 6336, 7261, 6399, 6265, 4518, 5898, 6039, 6163, 6294,
+		4086, 6913, 6411, 6403, 6426, 7391, 6507, 6373, 6258, 4603, 5924, 6021, 6152,
+		4130, 6965, 6459, 6451, 6444, 7564, 6657, 6524, 6408, 6285, 4705, 6068, 6199,
+		4130, 6974, 6464, 6456, 6449, 7576, 6797, 6664, 6542, 6389, 6441, 4758, 6205,
+		4140, 7022, 6504, 6497, 6489, 7629, 6803, 6805, 6684, 6531, 6583, 6588, 4827,
+	},
+	{ // Q9o
+		1488, 3259, 2427, 3528, 3496, 2621, 3940, 4012, 4012, 3990, 4029, 4029, 4037,
+		3419, 1368, 2486, 3433, 3400, 2529, 3967, 4019, 4011, 4040, 4080, 4080, 4088,
+		2515, 2571, 935, 2598, 2693, 4751, 6585, 6598, 6570, 6546, 6594, 6602, 6647,
+		3706, 3604, 2704, 2853, 5436, 6914, 6017, 6107, 6193, 6140, 6184, 6188, 6225,
+		3669, 3567, 2804, 5761, 2841, 6766, 5902, 5992, 6078, 6162, 6178, 6182, 6219,
+		2747, 2640, 5000, 7314, 7154, 3221, 6699, 6847, 6821, 6942, 7102, 7113, 7162,
+		4157, 4179, 6984, 6387, 6264, 7109, 4474, 6034, 6091, 6194, 6334, 6464, 6469,
+		4239, 4240, 7001, 6483, 6359, 7272, 6403, 4613, 5955, 6057, 6198, 6322, 6453,
+		4239, 4231, 6969, 6574, 6450, 7242, 6463, 6319, 4634, 5934, 6075, 6199, 6330,
+		4215, 4264, 6944, 6517, 6540, 7371, 6571, 6427, 6295, 4716, 5960, 6057, 6188,
+		4259, 4308, 6996, 6565, 6558, 7544, 6722, 6578, 6445, 6322, 4817, 6104, 6235,
+		4260, 4309, 7005, 6570, 6563, 7556, 6861, 6712, 6579, 6427, 6478, 4866, 6241,
+		4270, 4319, 7054, 6611, 6603, 7608, 6867, 6854, 6721, 6568, 6620, 6625, 4932,
+	},
+	{ // J9o
+		1664, 3387, 3371, 2556, 3616, 2771, 4038, 4088, 4169, 4111, 4150, 4150, 4159,
+		3555, 1544, 3276, 2615, 3520, 2678, 4069, 4095, 4167, 4161, 4201, 4201, 4209,
+		3532, 3430, 1396, 2554, 3417, 2580, 3987, 4115, 4167, 4161, 4201, 4201, 4209,
+		2653, 2710, 2636, 1107, 2709, 4751, 6578, 6590, 6597, 6566, 6614, 6622, 6667,
+		3794, 3692, 3581, 2816, 2891, 6859, 6001, 6090, 6181, 6265, 6281, 6285, 6322,
+		2907, 2800, 2686, 5000, 7252, 3375, 6757, 6791, 6939, 6911, 7071, 7082, 7130,
+		4258, 4284, 4195, 6972, 6366, 7168, 4550, 6105, 6196, 6276, 6417, 6546, 6551,
+		4316, 4318, 4332, 6988, 6460, 7208, 6474, 4660, 6059, 6115, 6255, 6380, 6511,
+		4407, 4399, 4393, 6999, 6556, 7372, 6571, 6427, 4802, 6006, 6147, 6244, 6375,
+		4344, 4393, 4387, 6964, 6645, 7340, 6656, 6485, 6372, 4830, 5990, 6087, 6218,
+		4388, 4437, 4431, 7016, 6664, 7512, 6806, 6636, 6523, 6353, 4928, 6134, 6265,
+		4389, 4437, 4431, 7025, 6668, 7523, 6946, 6770, 6627, 6457, 6509, 4974, 6271,
+		4399, 4447, 4441, 7074, 6709, 7575, 6952, 6911, 6768, 6599, 6650, 6656, 5037,
+	},
+	{ // T9o
+		1839, 3515, 3499, 3480, 2686, 2919, 4139, 4185, 4244, 4271, 4271, 4271, 4279,
+		3691, 1719, 3404, 3385, 2745, 2827, 4170, 4192, 4243, 4321, 4321, 4321, 4330,
+		3668, 3566, 1571, 3265, 2683, 2729, 4087, 4216, 4243, 4321, 4321, 4321, 4330,
+		3641, 3540, 3412, 1420, 2588, 2646, 4013, 4141, 4270, 4328, 4328, 4328, 4337,
+		2792, 2848, 2774, 2664, 1283, 4751, 6571, 6582, 6588, 6620, 6628, 6636, 6681,
+		3067, 2960, 2846, 2748, 5000, 3528, 6834, 6839, 6873, 7019, 7030, 7041, 7089,
+		4362, 4388, 4300, 4219, 6960, 7250, 4629, 6212, 6285, 6378, 6497, 6627, 6632,
+		4416, 4418, 4436, 4355, 6975, 7258, 6585, 4734, 6125, 6218, 6333, 6458, 6589,
+		4484, 4476, 4470, 4492, 6985, 7298, 6662, 6493, 4848, 6109, 6200, 6297, 6428,
+		4516, 4565, 4559, 4560, 7022, 7459, 6760, 6591, 6478, 5001, 6150, 6155, 6286,
+		4516, 4565, 4559, 4561, 7031, 7469, 6888, 6715, 6575, 6525, 5039, 6159, 6291,
+		4517, 4566, 4559, 4561, 7040, 7481, 7028, 6849, 6679, 6530, 6534, 5083, 6296,
+		4527, 4576, 4569, 4571, 7088, 7532, 7034, 6991, 6821, 6671, 6676, 6681, 5142,
+	},
+	{ // 99
+		1914, 5258, 5270, 5277, 5285, 6640, 6759, 6740, 6758, 6604, 6656, 6656, 6692,
+		5527, 1918, 5256, 5263, 5271, 6537, 6827, 6790, 6771, 6755, 6807, 6808, 6844,
+		5539, 5525, 1881, 5191, 5199, 6397, 6781, 6822, 6785, 6769, 6821, 6821, 6857,
+		5546, 5532, 5455, 1839, 5123, 6256, 6736, 6776, 6817, 6783, 6835, 6835, 6871,
+		5553, 5539, 5463, 5382, 1800, 6115, 6691, 6731, 6772, 6810, 6849, 6849, 6885,
+		7045, 6933, 6779, 6625, 6472, 5000, 8217, 8348, 8479, 8608, 8780, 8780, 8820,
+		7118, 7190, 7141, 7093, 7045, 8702, 8194, 8162, 8171, 8182, 8262, 8421, 8421,
+		7097, 7150, 7183, 7135, 7086, 8843, 8572, 8156, 8021, 8032, 8111, 8270, 8435,
+		7116, 7129, 7143, 7176, 7128, 8985, 8581, 8421, 8118, 7886, 7965, 8120, 8284,
+		6950, 7112, 7125, 7139, 7167, 9125, 8592, 8431, 8276, 8084, 7810, 7931, 8096,
+		7007, 7169, 7182, 7195, 7209, 9311, 8676, 8515, 8360, 8195, 8131, 7983, 8148,
+		7007, 7169, 7182, 7196, 7209, 9312, 8847, 8687, 8526, 8325, 8381, 8134, 8148,
+		7047, 7209, 7222, 7236, 7249, 9356, 8848, 8862, 8702, 8501, 8557, 8558, 8178,
+	},
+	{ // 98s
+		2206, 3712, 3815, 3826, 3812, 3241, 3271, 4388, 4442, 4444, 4527, 4527, 4527,
+		3967, 2175, 3714, 3729, 3714, 3130, 3312, 4376, 4422, 4475, 4558, 4558, 4558,
+		4071, 3964, 2101, 3657, 3642, 3178, 3254, 4430, 4452, 4504, 4587, 4587, 4587,
+		4080, 3976, 3899, 1969, 3530, 3132, 3178, 4360, 4485, 4514, 4597, 4597, 4597,
+		4061, 3958, 3881, 3761, 1830, 3068, 3123, 4297, 4422, 4550, 4613, 4613, 4613,
+		3380, 3257, 3301, 3243, 3166, 1783, 5000, 6465, 6486, 6496, 6566, 6573, 6581,
+		3411, 3452, 3382, 3292, 3224, 5249, 3900, 6817, 6829, 6864, 7039, 7049, 7059,
+		4663, 4651, 4704, 4629, 4561, 6829, 7209, 4900, 6402, 6471, 6596, 6713, 6837,
+		4723, 4701, 4729, 4760, 4693, 6855, 7225, 6686, 4999, 6321, 6446, 6559, 6678,
+		4726, 4759, 4787, 4794, 4827, 6869, 7265, 6759, 6604, 5109, 6311, 6401, 6494,
+		4816, 4849, 4877, 4884, 4897, 6948, 7459, 6891, 6736, 6597, 5261, 6509, 6514,
+		4817, 4850, 4878, 4885, 4898, 6957, 7470, 7015, 6856, 6692, 6809, 5300, 6519,
+		4818, 4851, 4878, 4885, 4898, 6965, 7481, 7146, 6982, 6790, 6815, 6820, 5343,
+	},
+	{ // 97s
+		2082, 3740, 3710, 3724, 3710, 3127, 3999, 3167, 4328, 4330, 4409, 4409, 4447,
+		3997, 2094, 3658, 3673, 3658, 3154, 4045, 3222, 4339, 4391, 4470, 4470, 4509,
+		3964, 3908, 2062, 3565, 3553, 3036, 3942, 3257, 4320, 4373, 4452, 4452, 4490,
+		3976, 3920, 3805, 1955, 3459, 3095, 3852, 3182, 4354, 4382, 4462, 4462, 4500,
+		3958, 3901, 3790, 3689, 1821, 3059, 3768, 3127, 4291, 4418, 4478, 4478, 4516,
+		3257, 3283, 3153, 3209, 3161, 1652, 3535, 5000, 6182, 6209, 6258, 6265, 6309,
+		4262, 4311, 4200, 4105, 4016, 3682, 3324, 6755, 6185, 6272, 6393, 6509, 6525,
+		3298, 3354, 3390, 3300, 3232, 5249, 7143, 3822, 6681, 6716, 6891, 6901, 7049,
+		4604, 4614, 4594, 4624, 4557, 6528, 6465, 7065, 4880, 6282, 6404, 6517, 6655,
+		4607, 4671, 4651, 4658, 4691, 6561, 6556, 7106, 6563, 4990, 6243, 6333, 6471,
+		4693, 4757, 4737, 4744, 4757, 6617, 6684, 7300, 6691, 6525, 5137, 6353, 6491,
+		4694, 4758, 4738, 4745, 4758, 6625, 6808, 7310, 6812, 6620, 6644, 5176, 6497,
+		4736, 4800, 4780, 4787, 4800, 6675, 6827, 7472, 6958, 6766, 6791, 6796, 5273,
+	},
+	{ // 96s
+		1959, 3655, 3658, 3627, 3616, 3016, 3922, 3922, 3066, 4242, 4321, 4321, 4360,
+		3909, 1970, 3598, 3567, 3556, 3039, 3960, 3932, 3117, 4277, 4356, 4356, 4391,
+		3911, 3845, 1948, 3484, 3472, 3060, 3861, 3945, 3138, 4262, 4342, 4342, 4376,
+		3878, 3812, 3723, 1916, 3366, 2954, 3763, 3847, 3185, 4250, 4329, 4329, 4364,
+		3862, 3797, 3708, 3594, 1807, 3022, 3680, 3764, 3131, 4286, 4345, 4345, 4380,
+		3138, 3160, 3179, 3061, 3127, 1521, 3514, 3818, 5000, 5859, 5932, 5939, 5954,
+		4185, 4224, 4118, 4014, 3926, 3664, 3283, 5657, 6752, 6188, 6308, 6425, 6410,
+		4185, 4194, 4206, 4102, 4014, 3990, 5921, 3341, 6622, 6085, 6206, 6323, 6441,
+		3190, 3242, 3260, 3309, 3241, 5249, 7143, 7003, 3750, 6578, 6753, 6763, 6912,
+		4516, 4552, 4535, 4521, 4555, 6183, 6470, 6362, 6957, 4871, 6210, 6299, 6434,
+		4602, 4638, 4621, 4608, 4621, 6267, 6598, 6490, 7151, 6490, 5018, 6294, 6429,
+		4602, 4638, 4622, 4608, 4621, 6274, 6722, 6614, 7162, 6584, 6581, 5057, 6434,
+		4645, 4677, 4660, 4647, 4660, 6292, 6708, 6740, 7323, 6728, 6725, 6730, 5149,
+	},
+	{ // 95s
+		1810, 3535, 3538, 3514, 3484, 2869, 3811, 3811, 3835, 2934, 4116, 4116, 4151,
+		3783, 1849, 3506, 3483, 3452, 2927, 3877, 3849, 3849, 3015, 4239, 4239, 4274,
+		3785, 3751, 1826, 3400, 3369, 2948, 3778, 3862, 3835, 3036, 4225, 4225, 4259,
+		3758, 3724, 3635, 1800, 3283, 2980, 3680, 3764, 3849, 3064, 4212, 4212, 4246,
+		3726, 3692, 3602, 3511, 1771, 2883, 3593, 3677, 3762, 3137, 4210, 4211, 4245,
+		2979, 3039, 3058, 3089, 2981, 1392, 3504, 3791, 4141, 5000, 5582, 5589, 5601,
+		4069, 4140, 4034, 3930, 3838, 3659, 3245, 5529, 5661, 6746, 6227, 6344, 6324,
+		4069, 4110, 4122, 4018, 3926, 3966, 5789, 3302, 5577, 6616, 6125, 6242, 6360,
+		4095, 4110, 4093, 4107, 4014, 4343, 5928, 5838, 3365, 6490, 6026, 6139, 6257,
+		3047, 3132, 3151, 3177, 3252, 5250, 7141, 7001, 6864, 3686, 6588, 6598, 6746,
+		4386, 4516, 4499, 4483, 4482, 5889, 6514, 6407, 6303, 6971, 4897, 6266, 6401,
+		4387, 4516, 4499, 4484, 4482, 5896, 6639, 6531, 6423, 6982, 6552, 4935, 6406,
+		4425, 4555, 4538, 4522, 4521, 5910, 6619, 6657, 6549, 7143, 6696, 6701, 5028,
+	},
+	{ // 94s
+		1645, 3410, 3413, 3389, 3361, 2720, 3694, 3694, 3718, 3628, 2815, 3996, 4031,
+		3653, 1684, 3381, 3358, 3329, 2778, 3761, 3733, 3733, 3734, 2896, 4120, 4154,
+		3654, 3621, 1662, 3274, 3246, 2799, 3661, 3745, 3718, 3719, 2917, 4105, 4140,
+		3628, 3594, 3505, 1636, 3160, 2832, 3564, 3648, 3732, 3706, 2945, 4092, 4127,
+		3597, 3563, 3474, 3382, 1612, 2873, 3477, 3561, 3646, 3727, 2998, 4090, 4125,
+		2818, 2879, 2898, 2929, 2970, 1220, 3434, 3742, 4068, 4418, 5000, 5269, 5295,
+		3949, 4020, 3914, 3811, 3718, 3589, 3168, 5396, 5528, 5663, 6756, 6227, 6208,
+		3949, 3990, 4002, 3899, 3806, 3919, 5652, 3226, 5444, 5579, 6627, 6126, 6243,
+		3975, 3991, 3974, 3987, 3895, 4269, 5790, 5701, 3288, 5498, 6501, 6024, 6141,
+		3880, 3991, 3975, 3959, 3980, 4646, 5932, 5842, 5757, 3355, 6389, 5896, 6014,
+		2919, 3004, 3023, 3049, 3102, 5250, 7157, 7017, 6880, 6759, 3611, 6586, 6735,
+		4262, 4392, 4375, 4360, 4356, 5551, 6520, 6413, 6305, 6170, 6971, 4824, 6361,
+		4301, 4430, 4414, 4398, 4395, 5581, 6500, 6538, 6431, 6296, 7132, 6655, 4917,
+	},
+	{ // 93s
+		1645, 3406, 3409, 3385, 3357, 2708, 3679, 3710, 3739, 3645, 3680, 2815, 4027,
+		3649, 1684, 3377, 3353, 3325, 2767, 3745, 3748, 3753, 3750, 3786, 2896, 4149,
+		3650, 3616, 1662, 3270, 3242, 2788, 3646, 3761, 3739, 3736, 3771, 2917, 4135,
+		3624, 3590, 3501, 1635, 3156, 2821, 3548, 3663, 3753, 3723, 3758, 2945, 4122,
+		3592, 3558, 3469, 3377, 1611, 2862, 3461, 3577, 3666, 3748, 3756, 2998, 4120,
+		2805, 2867, 2887, 2918, 2959, 1220, 3427, 3735, 4061, 4411, 4731, 5000, 5283,
+		3933, 4004, 3898, 3794, 3702, 3581, 3130, 5333, 5469, 5603, 5741, 6895, 6199,
+		3966, 4007, 4019, 3915, 3823, 3912, 5588, 3221, 5409, 5544, 5682, 6766, 6235,
+		3997, 4012, 3996, 4009, 3917, 4262, 5730, 5666, 3289, 5468, 5606, 6635, 6137,
+		3898, 4009, 3993, 3977, 4002, 4639, 5871, 5807, 5727, 3355, 5526, 6494, 6010,
+		3937, 4048, 4032, 4016, 4013, 4987, 6017, 5953, 5873, 5788, 3452, 6536, 6054,
+		2919, 3005, 3023, 3050, 3103, 5251, 7307, 7167, 7026, 6873, 6920, 3661, 6735,
+		4296, 4425, 4409, 4393, 4389, 5568, 6492, 6530, 6426, 6292, 6339, 7133, 4917,
+	},
+	{ // 92s
+		1604, 3371, 3373, 3350, 3321, 2662, 3674, 3667, 3723, 3633, 3668, 3668, 2800,
+		3611, 1644, 3342, 3318, 3290, 2721, 3741, 3705, 3736, 3738, 3774, 3774, 2882,
+		3613, 3579, 1621, 3235, 3207, 2743, 3641, 3718, 3722, 3724, 3759, 3759, 2902,
+		3586, 3553, 3463, 1595, 3121, 2776, 3543, 3620, 3736, 3711, 3746, 3746, 2930,
+		3555, 3521, 3432, 3340, 1571, 2818, 3457, 3533, 3649, 3736, 3744, 3744, 2984,
+		2756, 2818, 2838, 2870, 2911, 1180, 3419, 3691, 4046, 4399, 4705, 4717, 5000,
+		3928, 3999, 3893, 3789, 3697, 3572, 3130, 5333, 5469, 5603, 5741, 5862, 6895,
+		3922, 3963, 3975, 3871, 3779, 3864, 5588, 3169, 5326, 5465, 5602, 5723, 6885,
+		3982, 3996, 3979, 3992, 3900, 4247, 5730, 5582, 3266, 5410, 5547, 5664, 6754,
+		3887, 3998, 3981, 3966, 3991, 4627, 5871, 5727, 5668, 3338, 5471, 5561, 6613,
+		3926, 4037, 4020, 4005, 4001, 4959, 6017, 5873, 5813, 5732, 3434, 5605, 6655,
+		3926, 4037, 4021, 4005, 4001, 4972, 6145, 6000, 5936, 5827, 5874, 3483, 6661,
+		2905, 2990, 3008, 3035, 3088, 5251, 7308, 7298, 7156, 7003, 7050, 7056, 3702,
+	},
+	{ // A8o
+		665, 2490, 2546, 2609, 2707, 2940, 4751, 6280, 6278, 6124, 6139, 6187, 6195,
+		2607, 2833, 5412, 5413, 5410, 5580, 7136, 6007, 5975, 5981, 5997, 6040, 6046,
+		2663, 5745, 2845, 5341, 5339, 5508, 6987, 6033, 5972, 5979, 5994, 6038, 6043,
+		2728, 5745, 5666, 2833, 5245, 5415, 6839, 5922, 5999, 5973, 5988, 6032, 6037,
+		2829, 5740, 5662, 5561, 2822, 5319, 6692, 5807, 5885, 6001, 5983, 6027, 6032,
+		3077, 5921, 5843, 5742, 5638, 2882, 6589, 5738, 5815, 5931, 6051, 6067, 6072,
+		5000, 7559, 7398, 7238, 7078, 6969, 3003, 6615, 6733, 6893, 7054, 7215, 7228,
+		6661, 6384, 6411, 6292, 6168, 6096, 7017, 4353, 5890, 6024, 6162, 6303, 6433,
+		6658, 6350, 6346, 6374, 6251, 6178, 7144, 6253, 4395, 5879, 6017, 6158, 6288,
+		6492, 6356, 6352, 6345, 6374, 6301, 7315, 6395, 6240, 4475, 5898, 6040, 6165,
+		6510, 6374, 6370, 6363, 6356, 6429, 7488, 6542, 6387, 6260, 4576, 6087, 6184,
+		6562, 6423, 6419, 6412, 6405, 6448, 7662, 6693, 6538, 6411, 6463, 4688, 6232,
+		6571, 6428, 6425, 6417, 6410, 6454, 7676, 6833, 6679, 6546, 6568, 6620, 4750,
+	},
+	{ // K8o
+		1186, 2324, 3352, 3320, 3288, 3406, 2337, 3736, 3772, 3749, 3785, 3824, 3824,
+		2406, 623, 2475, 2569, 2659, 2872, 4751, 6276, 6257, 6230, 6245, 6293, 6301,
+		3528, 2588, 2780, 5319, 5316, 5486, 6946, 6023, 5967, 5942, 5957, 6001, 6006,
+		3492, 2685, 5645, 2762, 5219, 5389, 6798, 5908, 5994, 5936, 5951, 5995, 6000,
+		3455, 2778, 5640, 5535, 2750, 5292, 6650, 5793, 5879, 5964, 5946, 5990, 5995,
+		3581, 3004, 5821, 5716, 5612, 2810, 6548, 5689, 5776, 5860, 5980, 5996, 6001,
+		2441, 5000, 7355, 7195, 7035, 6926, 2984, 6576, 6699, 6819, 6980, 7141, 7153,
+		3941, 6656, 6401, 6277, 6154, 6044, 6978, 4316, 5879, 5981, 6119, 6261, 6391,
+		3980, 6636, 6341, 6369, 6246, 6135, 7109, 6241, 4391, 5868, 6006, 6147, 6277,
+		3957, 6607, 6314, 6306, 6335, 6225, 7239, 6349, 6228, 4475, 5864, 6005, 6130,
+		3997, 6625, 6332, 6324, 6317, 6353, 7412, 6496, 6375, 6222, 4574, 6052, 6150,
+		4041, 6677, 6381, 6373, 6366, 6372, 7585, 6647, 6526, 6373, 6425, 4683, 6197,
+		4042, 6686, 6386, 6379, 6372, 6378, 7598, 6788, 6667, 6508, 6530, 6583, 4742,
+	},
+	{ // Q8o
+		1361, 3171, 2454, 3440, 3408, 3526, 2487, 3894, 3894, 3871, 3906, 3946, 3946,
+		3329, 1328, 2329, 3340, 3308, 3427, 2529, 3882, 3873, 3903, 3938, 3978, 3978,
+		2545, 2408, 792, 2587, 2676, 2887, 4751, 6340, 6311, 6284, 6300, 6347, 6355,
+		3616, 3509, 2699, 2812, 5305, 5475, 6906, 6019, 6105, 6046, 6062, 6106, 6111,
+		3580, 3473, 2791, 5624, 2800, 5378, 6758, 5904, 5990, 6074, 6057, 6101, 6106,
+		3706, 3599, 3016, 5805, 5700, 2859, 6618, 5800, 5882, 5966, 6086, 6102, 6107,
+		2602, 2645, 5000, 7308, 7149, 6999, 3134, 6704, 6678, 6799, 6959, 7119, 7131,
+		4110, 4097, 6726, 6392, 6268, 6158, 7118, 4485, 5925, 6027, 6164, 6306, 6436,
+		4110, 4088, 6694, 6483, 6360, 6245, 7089, 6289, 4506, 5904, 6041, 6183, 6313,
+		4086, 4121, 6665, 6421, 6449, 6334, 7218, 6397, 6265, 4589, 5899, 6041, 6166,
+		4126, 4161, 6682, 6438, 6431, 6462, 7390, 6544, 6412, 6259, 4685, 6088, 6185,
+		4171, 4205, 6734, 6487, 6480, 6481, 7563, 6695, 6563, 6410, 6462, 4791, 6233,
+		4171, 4206, 6743, 6493, 6486, 6487, 7576, 6836, 6703, 6545, 6567, 6619, 4847,
+	},
+	{ // J8o
+		1536, 3298, 3283, 2583, 3528, 3646, 2636, 3970, 4050, 3992, 4028, 4067, 4067,
+		3465, 1504, 3180, 2459, 3428, 3546, 2678, 3958, 4030, 4024, 4059, 4099, 4099,
+		3442, 3332, 1382, 2511, 3326, 3444, 2580, 3978, 4030, 4024, 4059, 4099, 4099,
+		2683, 2547, 2596, 965, 2692, 2903, 4751, 6333, 6352, 6321, 6336, 6383, 6392,
+		3704, 3597, 3487, 2804, 2849, 5456, 6851, 6002, 6093, 6177, 6160, 6204, 6209,
+		3829, 3722, 3613, 3028, 5781, 2907, 6708, 5895, 5986, 6070, 6189, 6206, 6211,
+		2762, 2805, 2692, 5000, 7246, 7093, 3286, 6647, 6795, 6767, 6926, 7086, 7098,
+		4187, 4174, 4189, 6714, 6370, 6256, 7054, 4532, 6029, 6084, 6222, 6363, 6493,
+		4278, 4256, 4250, 6737, 6466, 6352, 7217, 6396, 4674, 5948, 6086, 6227, 6352,
+		4215, 4250, 4243, 6702, 6555, 6441, 7185, 6455, 6312, 4702, 5929, 6070, 6195,
+		4255, 4290, 4283, 6720, 6537, 6569, 7357, 6602, 6459, 6289, 4795, 6117, 6215,
+		4299, 4334, 4328, 6772, 6586, 6588, 7529, 6753, 6610, 6440, 6492, 4899, 6263,
+		4300, 4334, 4328, 6781, 6592, 6594, 7542, 6893, 6745, 6575, 6597, 6649, 4951,
+	},
+	{ // T8o
+		1712, 3426, 3411, 3391, 2713, 3765, 2785, 4067, 4126, 4148, 4148, 4187, 4187,
+		3601, 1679, 3308, 3292, 2588, 3666, 2827, 4055, 4105, 4180, 4180, 4219, 4219,
+		3578, 3468, 1558, 3190, 2641, 3563, 2729, 4079, 4105, 4180, 4180, 4219, 4219,
+		3552, 3445, 3336, 1411, 2578, 3468, 2646, 4004, 4133, 4187, 4187, 4227, 4227,
+		2822, 2685, 2735, 2659, 1142, 2919, 4751, 6326, 6344, 6350, 6358, 6405, 6414,
+		3952, 3846, 3736, 3634, 3040, 2955, 6776, 5984, 6074, 6162, 6282, 6298, 6303,
+		2922, 2965, 2851, 2754, 5000, 7165, 3438, 6682, 6716, 6862, 6873, 7033, 7044,
+		4288, 4275, 4293, 4212, 6702, 6347, 7090, 4605, 6090, 6179, 6295, 6436, 6566,
+		4355, 4333, 4327, 4349, 6724, 6442, 7130, 6458, 4719, 6043, 6134, 6275, 6400,
+		4383, 4418, 4411, 4413, 6734, 6536, 7291, 6552, 6409, 4868, 5992, 6133, 6230,
+		4383, 4418, 4412, 4413, 6742, 6664, 7301, 6676, 6506, 6356, 4906, 6138, 6235,
+		4428, 4462, 4456, 4458, 6795, 6683, 7473, 6827, 6657, 6507, 6512, 5007, 6283,
+		4428, 4463, 4456, 4458, 6803, 6688, 7485, 6968, 6792, 6612, 6617, 6669, 5056,
+	},
+	{ // 98o
+		1822, 3419, 3529, 3543, 3529, 2856, 2886, 4120, 4175, 4176, 4260, 4260, 4260,
+		3594, 1789, 3426, 3443, 3429, 2733, 2928, 4108, 4154, 4208, 4292, 4292, 4292,
+		3706, 3596, 1709, 3371, 3357, 2787, 2868, 4166, 4187, 4241, 4325, 4325, 4325,
+		3716, 3609, 3530, 1568, 3242, 2738, 2787, 4095, 4223, 4252, 4337, 4337, 4337,
+		3695, 3588, 3510, 3386, 1420, 2671, 2729, 4030, 4159, 4290, 4354, 4354, 4355,
+		2976, 2841, 2891, 2832, 2750, 1298, 4751, 6318, 6336, 6341, 6411, 6419, 6428,
+		3031, 3074, 3001, 2907, 2835, 5000, 3545, 6714, 6721, 6753, 6938, 6949, 6960,
+		4342, 4329, 4385, 4307, 4238, 6690, 7123, 4642, 6166, 6237, 6366, 6486, 6616,
+		4405, 4383, 4413, 4444, 4374, 6712, 7133, 6535, 4750, 6079, 6207, 6323, 6448,
+		4410, 4444, 4474, 4480, 4514, 6721, 7171, 6611, 6444, 4870, 6065, 6157, 6254,
+		4505, 4540, 4569, 4576, 4589, 6801, 7374, 6748, 6580, 6430, 5033, 6267, 6273,
+		4506, 4540, 4570, 4576, 4589, 6809, 7385, 6876, 6705, 6528, 6652, 5074, 6278,
+		4506, 4541, 4570, 4577, 4590, 6818, 7397, 7017, 6840, 6633, 6658, 6663, 5120,
+	},
+	{ // 88
+		1954, 5248, 5260, 5267, 5275, 5430, 6595, 6736, 6754, 6594, 6617, 6669, 6669,
+		5516, 1913, 5128, 5140, 5148, 5303, 6613, 6733, 6714, 6693, 6716, 6768, 6768,
+		5528, 5387, 1913, 5095, 5102, 5258, 6476, 6766, 6729, 6708, 6731, 6783, 6783,
+		5535, 5399, 5350, 1877, 5031, 5187, 6337, 6722, 6763, 6723, 6746, 6798, 6798,
+		5542, 5406, 5357, 5282, 1838, 5112, 6198, 6678, 6719, 6757, 6761, 6813, 6813,
+		5710, 5573, 5526, 5450, 5371, 1806, 6100, 6676, 6717, 6755, 6832, 6870, 6870,
+		6997, 7016, 6866, 6714, 6562, 6455, 5000, 8197, 8327, 8456, 8628, 8799, 8799,
+		7093, 7090, 7125, 7078, 7031, 7029, 8681, 8182, 8150, 8156, 8210, 8290, 8449,
+		7112, 7070, 7084, 7119, 7072, 7071, 8822, 8559, 8144, 8006, 8060, 8139, 8299,
+		6940, 7047, 7061, 7076, 7111, 7110, 8961, 8565, 8405, 8104, 7871, 7950, 8105,
+		6965, 7072, 7086, 7101, 7116, 7191, 9147, 8622, 8462, 8261, 8114, 8003, 8124,
+		7022, 7129, 7143, 7158, 7173, 7233, 9333, 8706, 8546, 8345, 8401, 8162, 8176,
+		7023, 7129, 7144, 7158, 7173, 7234, 9334, 8878, 8718, 8511, 8531, 8588, 8165,
+	},
+	{ // 87s
+		2246, 3860, 3830, 3844, 3830, 3863, 3246, 3302, 4422, 4419, 4478, 4561, 4561,
+		4121, 2207, 3673, 3776, 3788, 3826, 3284, 3320, 4393, 4442, 4501, 4584, 4584,
+		4088, 3926, 2174, 3668, 3683, 3722, 3168, 3355, 4375, 4424, 4482, 4565, 4565,
+		4101, 4029, 3915, 2101, 3612, 3657, 3229, 3309, 4436, 4460, 4518, 4601, 4601,
+		4082, 4038, 3927, 3851, 1972, 3555, 3204, 3257, 4376, 4503, 4538, 4621, 4621,
+		4115, 4077, 3966, 3895, 3788, 1838, 3183, 3245, 4343, 4471, 4604, 4667, 4667,
+		3385, 3424, 3296, 3353, 3318, 3286, 1803, 5000, 6172, 6198, 6245, 6315, 6322,
+		3444, 3461, 3497, 3439, 3374, 3351, 5249, 3924, 6661, 6673, 6743, 6919, 6929,
+		4699, 4670, 4650, 4709, 4645, 4610, 6513, 7043, 4919, 6328, 6431, 6557, 6674,
+		4698, 4723, 4703, 4737, 4779, 4744, 6544, 7059, 6607, 5024, 6250, 6375, 6489,
+		4762, 4787, 4767, 4801, 4819, 4884, 6600, 7139, 6717, 6529, 5143, 6396, 6485,
+		4853, 4877, 4857, 4892, 4909, 4955, 6679, 7333, 6849, 6661, 6686, 5297, 6595,
+		4854, 4878, 4858, 4893, 4910, 4956, 6688, 7344, 6975, 6782, 6781, 6900, 5337,
+	},
+	{ // 86s
+		2124, 3775, 3778, 3747, 3735, 3769, 3135, 4033, 3202, 4331, 4390, 4473, 4473,
+		4033, 2083, 3701, 3671, 3685, 3724, 3170, 4005, 3215, 4328, 4386, 4465, 4465,
+		4035, 3955, 2093, 3612, 3626, 3665, 3192, 4044, 3265, 4340, 4398, 4477, 4477,
+		4002, 3922, 3858, 2062, 3519, 3567, 3087, 3947, 3313, 4327, 4386, 4465, 4465,
+		3987, 3934, 3870, 3756, 1958, 3483, 3167, 3867, 3260, 4371, 4406, 4485, 4485,
+		4019, 3973, 3909, 3804, 3715, 1829, 3171, 3815, 3248, 4339, 4472, 4531, 4531,
+		3267, 3301, 3322, 3205, 3284, 3279, 1673, 3828, 5000, 5848, 5920, 5970, 5978,
+		4298, 4268, 4309, 4206, 4121, 4065, 3997, 3346, 6591, 6106, 6226, 6348, 6465,
+		3336, 3349, 3399, 3447, 3382, 3360, 5249, 6969, 3849, 6520, 6590, 6766, 6776,
+		4607, 4603, 4614, 4601, 4643, 4608, 6167, 6381, 6894, 4904, 6206, 6328, 6442,
+		4671, 4667, 4678, 4665, 4682, 4748, 6250, 6508, 6974, 6482, 5023, 6322, 6412,
+		4761, 4754, 4765, 4751, 4769, 4815, 6308, 6636, 7168, 6611, 6608, 5173, 6433,
+		4762, 4755, 4766, 4752, 4770, 4816, 6316, 6762, 7179, 6732, 6704, 6729, 5213,
+	},
+	{ // 85s
+		1974, 3655, 3658, 3634, 3603, 3640, 2988, 3921, 3945, 3069, 4185, 4264, 4264,
+		3907, 1961, 3610, 3613, 3582, 3623, 3057, 3922, 3922, 3113, 4269, 4349, 4349,
+		3909, 3860, 1972, 3553, 3523, 3565, 3080, 3961, 3933, 3163, 4281, 4360, 4360,
+		3882, 3862, 3797, 1951, 3440, 3488, 3113, 3868, 3952, 3196, 4273, 4352, 4352,
+		3850, 3829, 3764, 3676, 1922, 3392, 3028, 3780, 3864, 3267, 4271, 4350, 4350,
+		3886, 3870, 3806, 3724, 3622, 1818, 3136, 3728, 3812, 3254, 4337, 4397, 4397,
+		3107, 3181, 3201, 3233, 3138, 3247, 1544, 3802, 4152, 5000, 5572, 5658, 5665,
+		4182, 4184, 4225, 4126, 4032, 3977, 3974, 3308, 5578, 6585, 6145, 6267, 6384,
+		4208, 4184, 4195, 4214, 4120, 4065, 4351, 5836, 3368, 6456, 6043, 6165, 6282,
+		3193, 3239, 3289, 3321, 3394, 3371, 5250, 6966, 6827, 3782, 6416, 6592, 6602,
+		4455, 4545, 4556, 4546, 4543, 4609, 5874, 6425, 6318, 6785, 4901, 6292, 6381,
+		4541, 4632, 4643, 4632, 4630, 4676, 5971, 6554, 6446, 6980, 6575, 5051, 6371,
+		4542, 4632, 4643, 4633, 4630, 4676, 5979, 6679, 6572, 6991, 6671, 6663, 5091,
+	},
+	{ // 84s
+		1809, 3530, 3532, 3509, 3481, 3503, 2839, 3805, 3829, 3735, 2950, 4144, 4144,
+		3777, 1797, 3485, 3487, 3464, 3486, 2909, 3805, 3805, 3802, 2994, 4229, 4229,
+		3779, 3730, 1808, 3428, 3405, 3428, 2931, 3845, 3817, 3814, 3044, 4241, 4241,
+		3752, 3732, 3667, 1786, 3322, 3351, 2965, 3752, 3836, 3806, 3077, 4232, 4233,
+		3721, 3705, 3641, 3552, 1762, 3276, 3018, 3664, 3748, 3830, 3128, 4229, 4229,
+		3745, 3730, 3666, 3583, 3503, 1738, 2961, 3607, 3692, 3773, 3244, 4259, 4259,
+		2946, 3020, 3041, 3074, 3127, 3062, 1372, 3755, 4080, 4428, 5000, 5353, 5359,
+		4063, 4064, 4105, 4006, 3913, 3853, 3928, 3231, 5445, 5579, 6595, 6150, 6268,
+		4089, 4064, 4075, 4094, 4001, 3941, 4277, 5699, 3291, 5495, 6467, 6049, 6167,
+		3989, 4061, 4072, 4062, 4086, 4026, 4653, 5840, 5752, 3356, 6326, 5921, 6035,
+		3065, 3111, 3161, 3193, 3243, 3365, 5250, 6982, 6843, 6690, 3702, 6579, 6589,
+		4417, 4507, 4518, 4508, 4504, 4534, 5642, 6435, 6328, 6193, 6967, 4940, 6326,
+		4418, 4508, 4519, 4509, 4504, 4535, 5649, 6560, 6453, 6314, 6978, 6617, 4980,
+	},
+	{ // 83s
+		1645, 3404, 3407, 3383, 3355, 3379, 2689, 3687, 3712, 3617, 3653, 2831, 4024,
+		3647, 1632, 3359, 3362, 3338, 3363, 2759, 3688, 3688, 3685, 3720, 2875, 4109,
+		3648, 3600, 1643, 3303, 3279, 3304, 2782, 3728, 3700, 3697, 3732, 2925, 4120,
+		3622, 3601, 3536, 1622, 3197, 3227, 2816, 3634, 3718, 3688, 3724, 2958, 4112,
+		3590, 3575, 3510, 3422, 1598, 3153, 2870, 3547, 3631, 3712, 3721, 3009, 4109,
+		3615, 3600, 3536, 3454, 3373, 1579, 2951, 3491, 3575, 3656, 3773, 3105, 4138,
+		2785, 2859, 2881, 2914, 2967, 3051, 1201, 3685, 4030, 4342, 4647, 5000, 5264,
+		3942, 3944, 3985, 3886, 3792, 3733, 3858, 3154, 5311, 5446, 5583, 6606, 6152,
+		3968, 3944, 3955, 3974, 3881, 3821, 4228, 5562, 3214, 5362, 5500, 6478, 6051,
+		3869, 3941, 3952, 3942, 3966, 3906, 4565, 5703, 5614, 3279, 5393, 6337, 5919,
+		3908, 3980, 3991, 3981, 3976, 4030, 4896, 5849, 5760, 5646, 3372, 6379, 5935,
+		2937, 2983, 3034, 3065, 3116, 3215, 5251, 6999, 6859, 6707, 6753, 3630, 6578,
+		4294, 4383, 4394, 4384, 4380, 4408, 5548, 6441, 6335, 6196, 6213, 6967, 4869,
+	},
+	{ // 82s
+		1645, 3399, 3402, 3378, 3350, 3374, 2677, 3671, 3728, 3638, 3669, 3705, 2831,
+		3642, 1632, 3354, 3357, 3333, 3358, 2747, 3672, 3703, 3705, 3736, 3772, 2875,
+		3643, 3595, 1643, 3298, 3274, 3300, 2771, 3712, 3715, 3717, 3748, 3783, 2925,
+		3617, 3596, 3531, 1622, 3192, 3223, 2805, 3618, 3733, 3708, 3740, 3775, 2958,
+		3585, 3570, 3505, 3417, 1598, 3148, 2859, 3531, 3646, 3732, 3737, 3772, 3009,
+		3610, 3595, 3531, 3449, 3368, 1579, 2941, 3475, 3590, 3676, 3792, 3801, 3105,
+		2772, 2847, 2869, 2902, 2956, 3040, 1201, 3678, 4022, 4335, 4641, 4736, 5000,
+		3926, 3927, 3968, 3869, 3776, 3716, 3850, 3116, 5248, 5386, 5524, 5662, 6744,
+		3986, 3960, 3971, 3990, 3897, 3837, 4221, 5498, 3210, 5327, 5465, 5603, 6616,
+		3891, 3962, 3973, 3963, 3987, 3928, 4558, 5643, 5580, 3280, 5363, 5501, 6471,
+		3926, 3997, 4008, 3998, 3993, 4051, 4889, 5789, 5725, 5616, 3373, 5544, 6484,
+		3965, 4037, 4048, 4037, 4033, 4062, 4992, 5935, 5872, 5763, 5810, 3472, 6526,
+		2938, 2984, 3034, 3066, 3117, 3216, 5251, 7149, 7010, 6852, 6867, 6915, 3683,
+	},
+	{ // A7o
+		665, 2517, 2532, 2628, 2729, 2959, 3185, 4751, 5970, 5824, 5836, 5851, 5899,
+		2637, 2873, 5421, 5422, 5419, 5589, 5709, 7120, 6009, 6016, 6026, 6042, 6087,
+		2650, 5755, 2828, 5260, 5261, 5431, 5551, 7100, 5968, 5974, 5985, 6001, 6045,
+		2750, 5754, 5581, 2847, 5191, 5361, 5481, 6952, 5995, 5973, 5983, 5999, 6044,
+		2855, 5750, 5581, 5503, 2842, 5268, 5388, 6804, 5885, 6000, 5978, 5994, 6039,
+		3100, 5930, 5761, 5684, 5584, 2903, 5337, 6702, 5815, 5931, 6051, 6034, 6078,
+		3339, 6059, 5890, 5813, 5712, 5658, 2907, 6556, 5702, 5818, 5937, 6058, 6074,
+		5000, 7542, 7519, 7359, 7200, 7090, 6932, 3042, 6621, 6780, 6941, 7102, 7264,
+		6328, 6386, 6342, 6370, 6251, 6178, 6056, 7023, 4353, 5894, 6032, 6170, 6312,
+		6171, 6393, 6348, 6345, 6374, 6301, 6179, 7193, 6257, 4432, 5908, 6047, 6189,
+		6184, 6405, 6360, 6357, 6350, 6429, 6307, 7367, 6404, 6271, 4533, 6066, 6208,
+		6201, 6423, 6379, 6376, 6369, 6412, 6436, 7540, 6552, 6419, 6441, 4641, 6256,
+		6254, 6473, 6428, 6425, 6418, 6462, 6456, 7715, 6703, 6571, 6592, 6645, 4760,
+	},
+	{ // K7o
+		1229, 2351, 3370, 3371, 3344, 3462, 3439, 2353, 3775, 3752, 3788, 3823, 3863,
+		2435, 667, 2504, 2598, 2688, 2901, 3190, 4751, 5970, 5951, 5963, 5978, 6025,
+		3547, 2619, 2777, 5258, 5259, 5429, 5564, 7080, 5991, 5966, 5977, 5993, 6037,
+		3547, 2717, 5579, 2796, 5188, 5358, 5493, 6932, 6019, 5965, 5975, 5991, 6036,
+		3516, 2810, 5579, 5502, 2791, 5266, 5401, 6785, 5908, 5992, 5970, 5986, 6030,
+		3642, 3036, 5760, 5682, 5582, 2850, 5349, 6646, 5806, 5890, 6010, 5993, 6037,
+		3616, 3344, 5903, 5826, 5725, 5671, 2910, 6539, 5732, 5816, 5936, 6056, 6073,
+		2458, 5000, 7500, 7340, 7180, 7031, 6916, 3038, 6570, 6691, 6851, 7012, 7174,
+		3983, 6328, 6366, 6394, 6275, 6167, 6089, 6971, 4384, 5892, 6030, 6168, 6310,
+		3959, 6308, 6340, 6336, 6365, 6256, 6178, 7100, 6254, 4468, 5882, 6021, 6163,
+		3999, 6321, 6352, 6348, 6342, 6385, 6307, 7273, 6401, 6243, 4567, 6040, 6182,
+		4039, 6339, 6370, 6367, 6360, 6368, 6436, 7446, 6549, 6391, 6412, 4671, 6230,
+		4084, 6391, 6420, 6417, 6410, 6417, 6456, 7621, 6701, 6542, 6564, 6617, 4787,
+	},
+	{ // Q7o
+		1229, 3076, 2330, 3351, 3318, 3437, 3413, 2373, 3775, 3752, 3788, 3823, 3863,
+		3227, 1201, 2356, 3252, 3219, 3338, 3424, 2395, 3754, 3784, 3819, 3855, 3894,
+		2413, 2438, 650, 2543, 2662, 2866, 3124, 4751, 5949, 5931, 5942, 5958, 6005,
+		3525, 3419, 2658, 2770, 5174, 5343, 5478, 6897, 6016, 5958, 5968, 5984, 6029,
+		3489, 3382, 2783, 5488, 2758, 5247, 5382, 6749, 5901, 5986, 5963, 5979, 6024,
+		3615, 3508, 2999, 5668, 5564, 2817, 5296, 6610, 5794, 5878, 5998, 5981, 6025,
+		3589, 3599, 3274, 5811, 5707, 5615, 2875, 6503, 5691, 5775, 5895, 6015, 6032,
+		2481, 2500, 5000, 7303, 7143, 6994, 6879, 3056, 6537, 6657, 6817, 6978, 7139,
+		3983, 3958, 6306, 6392, 6269, 6154, 6045, 6937, 4379, 5879, 6017, 6155, 6297,
+		3959, 3991, 6286, 6329, 6358, 6244, 6134, 7066, 6241, 4461, 5870, 6008, 6150,
+		3999, 4031, 6299, 6342, 6335, 6372, 6262, 7238, 6388, 6229, 4557, 6027, 6169,
+		4039, 4071, 6317, 6360, 6353, 6355, 6392, 7411, 6535, 6377, 6399, 4659, 6217,
+		4084, 4116, 6369, 6410, 6403, 6405, 6411, 7585, 6687, 6529, 6551, 6603, 4772,
+	},
+	{ // J7o
+		1405, 3204, 3172, 2460, 3438, 3557, 3529, 2523, 3932, 3874, 3909, 3944, 3984,
+		3363, 1376, 3091, 2486, 3339, 3457, 3544, 2544, 3910, 3905, 3940, 3976, 4015,
+		3329, 3241, 1342, 2354, 3233, 3351, 3437, 2580, 3892, 3886, 3921, 3957, 3996,
+		2552, 2576, 2433, 823, 2680, 2883, 3140, 4751, 6024, 5993, 6005, 6020, 6067,
+		3613, 3507, 3392, 2797, 2808, 5325, 5460, 6842, 6005, 6088, 6066, 6082, 6127,
+		3738, 3632, 3517, 3012, 5645, 2865, 5371, 6700, 5898, 5982, 6101, 6085, 6129,
+		3708, 3723, 3608, 3286, 5788, 5693, 2922, 6561, 5794, 5874, 5994, 6114, 6131,
+		2641, 2660, 2697, 5000, 7241, 7088, 6939, 3204, 6652, 6624, 6783, 6943, 7104,
+		4151, 4127, 4106, 6387, 6375, 6262, 6151, 7064, 4547, 5918, 6056, 6194, 6336,
+		4088, 4120, 4100, 6351, 6464, 6351, 6236, 7032, 6282, 4574, 5899, 6037, 6179,
+		4128, 4160, 4139, 6365, 6440, 6479, 6365, 7203, 6428, 6259, 4668, 6056, 6199,
+		4168, 4200, 4179, 6382, 6459, 6462, 6494, 7376, 6576, 6407, 6428, 4767, 6246,
+		4212, 4244, 4224, 6435, 6509, 6511, 6513, 7549, 6728, 6558, 6580, 6633, 4876,
+	},
+	{ // T7o
+		1580, 3332, 3300, 3284, 2590, 3675, 3648, 2672, 4007, 4029, 4030, 4065, 4104,
+		3499, 1552, 3219, 3203, 2615, 3577, 3663, 2693, 3986, 4061, 4061, 4096, 4136,
+		3465, 3377, 1518, 3093, 2484, 3471, 3556, 2729, 3967, 4042, 4042, 4077, 4117,
+		3442, 3354, 3236, 1397, 2535, 3376, 3462, 2646, 3995, 4049, 4049, 4085, 4124,
+		2690, 2715, 2571, 2619, 1000, 2899, 3156, 4751, 6017, 6042, 6049, 6065, 6112,
+		3861, 3756, 3641, 3540, 3025, 2914, 5439, 6768, 5986, 6074, 6194, 6177, 6221,
+		3832, 3846, 3732, 3630, 3298, 5762, 2969, 6626, 5879, 5968, 6087, 6208, 6224,
+		2800, 2820, 2857, 2759, 5000, 7160, 7007, 3354, 6569, 6715, 6726, 6886, 7046,
+		4228, 4204, 4183, 4205, 6375, 6352, 6238, 6973, 4591, 6011, 6102, 6241, 6383,
+		4256, 4288, 4267, 4269, 6405, 6445, 6332, 7133, 6377, 4740, 5932, 6071, 6213,
+		4256, 4288, 4268, 4270, 6412, 6573, 6460, 7144, 6474, 6294, 4778, 6075, 6217,
+		4296, 4328, 4308, 4310, 6429, 6556, 6589, 7316, 6622, 6442, 6447, 4874, 6265,
+		4341, 4373, 4352, 4354, 6482, 6606, 6609, 7489, 6774, 6594, 6598, 6651, 4980,
+	},
+	{ // 97o
+		1690, 3450, 3418, 3435, 3421, 2733, 3719, 2773, 4056, 4057, 4138, 4138, 4177,
+		3627, 1704, 3367, 3384, 3370, 2760, 3768, 2831, 4068, 4121, 4202, 4202, 4241,
+		3593, 3536, 1670, 3274, 3263, 2630, 3662, 2867, 4049, 4103, 4183, 4183, 4222,
+		3606, 3549, 3431, 1555, 3167, 2696, 3570, 2787, 4085, 4114, 4195, 4195, 4234,
+		3585, 3529, 3413, 3310, 1411, 2657, 3486, 2729, 4021, 4153, 4213, 4213, 4252,
+		2844, 2871, 2728, 2792, 2742, 1157, 3171, 4751, 6010, 6034, 6081, 6088, 6136,
+		3904, 3956, 3842, 3744, 3653, 3310, 2971, 6649, 5935, 6023, 6147, 6267, 6284,
+		2910, 2969, 3006, 2912, 2840, 5000, 7030, 3459, 6561, 6593, 6778, 6789, 6949,
+		4278, 4289, 4269, 4300, 4231, 6363, 6294, 6962, 4622, 6041, 6166, 6282, 6424,
+		4283, 4351, 4330, 4337, 4370, 6392, 6387, 6999, 6405, 4741, 5996, 6087, 6229,
+		4374, 4442, 4421, 4428, 4441, 6446, 6520, 7203, 6538, 6358, 4901, 6106, 6249,
+		4374, 4442, 4422, 4428, 4441, 6454, 6648, 7214, 6662, 6456, 6480, 4941, 6254,
+		4419, 4487, 4466, 4473, 4486, 6506, 6668, 7386, 6814, 6608, 6632, 6637, 5044,
+	},
+	{ // 87o
+		1866, 3576, 3544, 3561, 3548, 3582, 2862, 2920, 4156, 4153, 4212, 4296, 4296,
+		3761, 1822, 3380, 3490, 3504, 3544, 2901, 2937, 4126, 4175, 4234, 4319, 4319,
+		3727, 3551, 1789, 3380, 3397, 3438, 2772, 2973, 4107, 4157, 4216, 4300, 4300,
+		3740, 3663, 3545, 1710, 3325, 3372, 2840, 2925, 4172, 4196, 4255, 4339, 4340,
+		3719, 3673, 3558, 3480, 1571, 3268, 2814, 2870, 4111, 4243, 4278, 4362, 4362,
+		3752, 3712, 3597, 3526, 3415, 1428, 2791, 2857, 4079, 4211, 4348, 4412, 4412,
+		2983, 3022, 2882, 2946, 2910, 2877, 1319, 4751, 6003, 6026, 6072, 6142, 6150,
+		3068, 3084, 3121, 3061, 2993, 2970, 5000, 3570, 6548, 6556, 6627, 6812, 6823,
+		4381, 4349, 4328, 4391, 4325, 4290, 6351, 6947, 4662, 6088, 6195, 6324, 6444,
+		4382, 4406, 4385, 4422, 4464, 4430, 6379, 6958, 6451, 4776, 6004, 6133, 6249,
+		4449, 4473, 4453, 4490, 4508, 4576, 6432, 7038, 6565, 6362, 4905, 6152, 6244,
+		4545, 4569, 4549, 4585, 4603, 4651, 6512, 7242, 6703, 6500, 6524, 5071, 6355,
+		4546, 4570, 4549, 4586, 4604, 4652, 6521, 7253, 6832, 6625, 6623, 6747, 5113,
+	},
+	{ // 77
+		1952, 5231, 5189, 5201, 5209, 5364, 5372, 6558, 6737, 6577, 6595, 6618, 6670,
+		5498, 1953, 5113, 5125, 5132, 5288, 5406, 6562, 6704, 6683, 6701, 6724, 6776,
+		5453, 5371, 1908, 4962, 4974, 5130, 5249, 6546, 6667, 6646, 6664, 6687, 6739,
+		5465, 5382, 5207, 1909, 4930, 5086, 5206, 6411, 6702, 6663, 6680, 6703, 6755,
+		5472, 5389, 5219, 5172, 1875, 5016, 5136, 6274, 6660, 6698, 6697, 6720, 6772,
+		5639, 5557, 5387, 5340, 5266, 1844, 5100, 6178, 6659, 6698, 6774, 6779, 6831,
+		5647, 5684, 5515, 5468, 5395, 5358, 1818, 6076, 6654, 6692, 6769, 6846, 6884,
+		6958, 6962, 6944, 6796, 6646, 6541, 6430, 5000, 8172, 8300, 8471, 8643, 8815,
+		7095, 7060, 7020, 7056, 7011, 7011, 7006, 8655, 8164, 8129, 8179, 8233, 8312,
+		6923, 7037, 6996, 7013, 7050, 7050, 7045, 8793, 8536, 8124, 7985, 8039, 8118,
+		6943, 7056, 7016, 7032, 7049, 7131, 7126, 8979, 8590, 8383, 8128, 8058, 8137,
+		6968, 7082, 7041, 7057, 7074, 7136, 7208, 9165, 8647, 8440, 8461, 8139, 8189,
+		7025, 7138, 7098, 7114, 7131, 7193, 7250, 9352, 8731, 8524, 8545, 8602, 8187,
+	},
+	{ // 76s
+		2247, 3894, 3855, 3825, 3839, 3878, 3858, 3240, 3303, 4393, 4448, 4510, 4614,
+		4157, 2247, 3821, 3790, 3805, 3843, 3869, 3289, 3350, 4421, 4475, 4533, 4616,
+		4116, 4078, 2206, 3626, 3729, 3795, 3825, 3322, 3363, 4394, 4448, 4507, 4590,
+		4083, 4046, 3875, 2175, 3622, 3696, 3728, 3219, 3410, 4382, 4436, 4494, 4577,
+		4095, 4058, 3979, 3865, 2104, 3635, 3671, 3303, 3388, 4452, 4482, 4540, 4623,
+		4133, 4096, 4045, 3941, 3875, 1979, 3598, 3319, 3378, 4423, 4556, 4591, 4674,
+		4110, 4121, 4075, 3971, 3910, 3834, 1850, 3339, 3409, 4422, 4555, 4689, 4752,
+		3379, 3430, 3463, 3348, 3431, 3439, 3452, 1828, 5000, 5837, 5909, 5958, 6027,
+		3446, 3494, 3505, 3554, 3521, 3501, 3526, 5249, 3948, 6445, 6495, 6565, 6741,
+		4669, 4698, 4670, 4656, 4727, 4695, 4694, 6152, 6812, 4941, 6204, 6308, 6434,
+		4729, 4758, 4730, 4716, 4761, 4836, 4834, 6234, 6870, 6475, 5054, 6282, 6408,
+		4797, 4822, 4794, 4780, 4825, 4876, 4975, 6291, 6950, 6586, 6562, 5175, 6429,
+		4911, 4913, 4885, 4871, 4916, 4967, 5046, 6371, 7145, 6719, 6695, 6721, 5330,
+	},
+	{ // 75s
+		2098, 3774, 3735, 3738, 3707, 3749, 3729, 3093, 4025, 3171, 4243, 4301, 4380,
+		4031, 2126, 3729, 3732, 3701, 3743, 3768, 3176, 4032, 3248, 4358, 4417, 4496,
+		3990, 3984, 2084, 3656, 3626, 3694, 3725, 3210, 4005, 3261, 4332, 4390, 4469,
+		3991, 3985, 3906, 2097, 3567, 3641, 3673, 3245, 4051, 3323, 4350, 4408, 4487,
+		3959, 3953, 3873, 3810, 2068, 3544, 3583, 3163, 3964, 3393, 4347, 4406, 4485,
+		4000, 3994, 3943, 3885, 3782, 1968, 3529, 3284, 3915, 3384, 4421, 4456, 4535,
+		3976, 4019, 3973, 3916, 3821, 3763, 1844, 3327, 3894, 3415, 4421, 4554, 4614,
+		3220, 3309, 3343, 3376, 3285, 3407, 3444, 1700, 4163, 5000, 5561, 5646, 5693,
+		4289, 4297, 4268, 4316, 4223, 4171, 4149, 4358, 3371, 6364, 6010, 6130, 6252,
+		3302, 3384, 3395, 3459, 3532, 3512, 3537, 5250, 6727, 3878, 6300, 6370, 6546,
+		4513, 4636, 4607, 4625, 4622, 4696, 4695, 5858, 6279, 6659, 4932, 6234, 6357,
+		4577, 4700, 4671, 4689, 4686, 4736, 4836, 5955, 6407, 6739, 6511, 5053, 6347,
+		4664, 4787, 4758, 4776, 4773, 4823, 4903, 6010, 6536, 6934, 6641, 6633, 5203,
+	},
+	{ // 74s
+		1933, 3649, 3610, 3613, 3589, 3611, 3595, 2944, 3908, 3814, 3052, 4181, 4261,
+		3901, 1961, 3604, 3607, 3583, 3605, 3634, 3028, 3915, 3912, 3129, 4297, 4376,
+		3860, 3854, 1920, 3531, 3534, 3557, 3591, 3062, 3888, 3885, 3142, 4270, 4349,
+		3861, 3855, 3776, 1932, 3476, 3504, 3539, 3097, 3935, 3903, 3204, 4288, 4367,
+		3835, 3829, 3777, 3714, 1913, 3432, 3471, 3153, 3851, 3932, 3260, 4290, 4369,
+		3859, 3853, 3802, 3745, 3667, 1889, 3404, 3109, 3794, 3875, 3373, 4318, 4398,
+		3838, 3881, 3836, 3778, 3705, 3634, 1790, 3257, 3774, 3855, 3405, 4417, 4476,
+		3059, 3149, 3183, 3217, 3274, 3222, 3373, 1529, 4091, 4439, 5000, 5342, 5443,
+		4169, 4177, 4149, 4196, 4108, 4047, 4025, 4285, 3294, 5442, 6375, 6015, 6137,
+		4070, 4174, 4146, 4163, 4193, 4131, 4110, 4661, 5692, 3356, 6231, 5884, 6006,
+		3174, 3256, 3267, 3331, 3387, 3505, 3530, 5250, 6743, 6587, 3795, 6347, 6523,
+		4453, 4576, 4547, 4565, 4565, 4595, 4695, 5627, 6288, 6150, 6716, 4941, 6297,
+		4540, 4662, 4634, 4652, 4652, 4682, 4762, 5741, 6418, 6279, 6910, 6582, 5092,
+	},
+	{ // 73s
+		1769, 3523, 3485, 3487, 3464, 3488, 3457, 2794, 3791, 3697, 3728, 2933, 4141,
+		3770, 1796, 3478, 3481, 3457, 3482, 3496, 2878, 3798, 3795, 3826, 3010, 4256,
+		3729, 3723, 1755, 3406, 3408, 3438, 3453, 2913, 3771, 3768, 3800, 3023, 4229,
+		3731, 3725, 3645, 1767, 3350, 3385, 3401, 2948, 3817, 3786, 3818, 3085, 4247,
+		3704, 3698, 3647, 3583, 1749, 3313, 3333, 3005, 3734, 3815, 3820, 3141, 4249,
+		3729, 3724, 3678, 3620, 3542, 1730, 3287, 3099, 3677, 3758, 3874, 3234, 4277,
+		3697, 3739, 3694, 3637, 3564, 3514, 1710, 3081, 3652, 3733, 3850, 3394, 4338,
+		2898, 2988, 3022, 3057, 3114, 3211, 3188, 1357, 4042, 4354, 4658, 5000, 5348,
+		4049, 4057, 4028, 4076, 3987, 3927, 3900, 4237, 3217, 5308, 5446, 6386, 6021,
+		3949, 4054, 4025, 4043, 4072, 4012, 3985, 4573, 5555, 3279, 5336, 6243, 5890,
+		3984, 4089, 4060, 4078, 4078, 4135, 4109, 4903, 5700, 5583, 3370, 6255, 5906,
+		3046, 3129, 3140, 3203, 3259, 3355, 3524, 5251, 6759, 6604, 6618, 3718, 6511,
+		4416, 4538, 4509, 4527, 4527, 4555, 4620, 5639, 6299, 6161, 6178, 6898, 4981,
+	},
+	{ // 72s
+		1604, 3397, 3358, 3361, 3337, 3362, 3333, 2644, 3670, 3580, 3611, 3647, 2814,
+		3639, 1632, 3352, 3354, 3331, 3356, 3372, 2728, 3680, 3677, 3709, 3744, 2891,
+		3598, 3592, 1591, 3279, 3282, 3312, 3329, 2763, 3653, 3650, 3682, 3717, 2904,
+		3599, 3593, 3514, 1603, 3224, 3259, 3277, 2799, 3699, 3668, 3700, 3735, 2966,
+		3573, 3567, 3516, 3452, 1584, 3187, 3209, 2857, 3616, 3697, 3702, 3737, 3022,
+		3598, 3592, 3547, 3489, 3411, 1565, 3163, 2951, 3559, 3640, 3757, 3765, 3115,
+		3567, 3609, 3564, 3507, 3434, 3384, 1551, 3071, 3535, 3616, 3732, 3848, 3256,
+		2736, 2826, 2861, 2896, 2954, 3051, 3177, 1185, 3973, 4307, 4557, 4652, 5000,
+		3925, 3936, 3907, 3955, 3866, 3806, 3780, 4167, 3140, 5175, 5313, 5451, 6397,
+		3829, 3933, 3904, 3922, 3951, 3891, 3865, 4527, 5418, 3202, 5202, 5341, 6253,
+		3864, 3968, 3939, 3957, 3957, 4014, 3988, 4799, 5563, 5446, 3294, 5357, 6267,
+		3903, 4007, 3978, 3996, 3997, 4025, 4112, 4902, 5709, 5592, 5610, 3389, 6309,
+		2919, 3001, 3012, 3075, 3131, 3228, 3374, 5251, 6776, 6620, 6635, 6683, 3647,
+	},
+	{ // A6o
+		644, 2492, 2544, 2599, 2732, 2966, 3186, 3496, 4751, 5452, 5475, 5492, 5539,
+		2611, 2833, 5421, 5380, 5381, 5551, 5671, 5670, 7115, 6015, 6026, 6037, 6052,
+		2664, 5755, 2828, 5260, 5261, 5431, 5551, 5670, 7079, 5983, 5994, 6005, 6020,
+		2720, 5710, 5581, 2791, 5100, 5275, 5395, 5514, 7059, 5943, 5953, 5964, 5980,
+		2860, 5710, 5581, 5408, 2817, 5204, 5325, 5443, 6912, 5971, 5952, 5963, 5979,
+		3108, 5891, 5761, 5593, 5516, 2884, 5277, 5396, 6810, 5905, 6025, 6003, 6018,
+		3342, 6020, 5890, 5722, 5645, 5595, 2888, 5301, 6664, 5792, 5911, 6032, 6014,
+		3672, 6017, 6017, 5849, 5772, 5722, 5619, 2905, 6554, 5711, 5831, 5951, 6075,
+		5000, 7537, 7497, 7476, 7316, 7207, 7048, 6930, 3050, 6629, 6790, 6951, 7113,
+		5770, 6393, 6358, 6314, 6343, 6274, 6152, 6066, 7031, 4365, 5893, 6031, 6173,
+		5795, 6405, 6370, 6326, 6324, 6403, 6281, 6195, 7205, 6257, 4466, 6045, 6187,
+		5814, 6418, 6383, 6339, 6337, 6380, 6410, 6324, 7378, 6405, 6421, 4574, 6206,
+		5866, 6436, 6401, 6357, 6354, 6398, 6392, 6457, 7553, 6557, 6573, 6594, 4693,
+	},
+	{ // K6o
+		1230, 2345, 3408, 3367, 3368, 3492, 3469, 3436, 2357, 3749, 3785, 3820, 3860,
+		2429, 666, 2531, 2584, 2707, 2924, 3206, 3496, 4751, 5601, 5624, 5636, 5651,
+		3589, 2649, 2817, 5266, 5268, 5438, 5573, 5694, 7065, 6001, 6011, 6022, 6039,
+		3545, 2704, 5588, 2779, 5107, 5281, 5416, 5537, 7045, 5960, 5971, 5982, 5999,
+		3544, 2832, 5589, 5416, 2805, 5211, 5346, 5467, 6897, 5988, 5970, 5981, 5997,
+		3676, 3062, 5769, 5601, 5524, 2871, 5299, 5386, 6758, 5890, 6009, 5988, 6004,
+		3650, 3364, 5912, 5744, 5667, 5617, 2930, 5330, 6651, 5816, 5936, 6056, 6040,
+		3614, 3672, 6042, 5873, 5796, 5711, 5651, 2940, 6506, 5703, 5823, 5943, 6064,
+		2463, 5000, 7483, 7461, 7302, 7152, 7038, 6880, 3083, 6579, 6739, 6900, 7061,
+		3955, 5931, 6376, 6332, 6361, 6256, 6178, 6057, 6979, 4426, 5897, 6036, 6175,
+		3995, 5956, 6388, 6344, 6342, 6385, 6307, 6186, 7152, 6260, 4524, 6050, 6189,
+		4035, 5970, 6401, 6357, 6354, 6362, 6436, 6315, 7325, 6407, 6423, 4629, 6209,
+		4079, 5987, 6420, 6376, 6374, 6381, 6420, 6445, 7499, 6556, 6572, 6594, 4741,
+	},
+	{ // Q6o
+		1274, 3114, 2361, 3376, 3377, 3501, 3473, 3477, 2394, 3782, 3817, 3853, 3892,
+		3269, 1245, 2382, 3269, 3270, 3393, 3480, 3456, 2411, 3786, 3822, 3857, 3892,
+		2447, 2467, 693, 2572, 2691, 2895, 3154, 3516, 4751, 5601, 5624, 5636, 5651,
+		3553, 3437, 2690, 2767, 5112, 5286, 5421, 5557, 7031, 5982, 5993, 6004, 6021,
+		3552, 3437, 2814, 5422, 2793, 5216, 5351, 5487, 6884, 6010, 5992, 6003, 6019,
+		3684, 3568, 3031, 5607, 5530, 2857, 5271, 5406, 6740, 5907, 6026, 6004, 6021,
+		3654, 3659, 3306, 5750, 5673, 5587, 2916, 5350, 6601, 5805, 5925, 6045, 6029,
+		3658, 3634, 3694, 5894, 5817, 5731, 5672, 2980, 6495, 5732, 5851, 5972, 6093,
+		2503, 2517, 5000, 7448, 7289, 7133, 6984, 6871, 3115, 6529, 6689, 6849, 7010,
+		3990, 3993, 5931, 6355, 6384, 6273, 6165, 6088, 6927, 4454, 5893, 6032, 6171,
+		4030, 4033, 5956, 6367, 6364, 6402, 6294, 6216, 7100, 6255, 4550, 6046, 6185,
+		4070, 4073, 5970, 6380, 6377, 6379, 6423, 6346, 7273, 6403, 6419, 4652, 6205,
+		4114, 4113, 5987, 6399, 6397, 6399, 6407, 6475, 7446, 6552, 6568, 6590, 4760,
+	},
+	{ // J6o
+		1274, 3114, 3085, 2340, 3357, 3475, 3447, 3451, 2414, 3781, 3817, 3852, 3892,
+		3269, 1245, 2996, 2362, 3249, 3368, 3454, 3430, 2430, 3786, 3821, 3856, 3892,
+		3236, 3139, 1215, 2381, 3143, 3262, 3348, 3434, 2446, 3766, 3801, 3837, 3872,
+		2424, 2445, 2462, 680, 2636, 2867, 3112, 3440, 4751, 5581, 5603, 5615, 5630,
+		3530, 3415, 3301, 2756, 2766, 5194, 5328, 5464, 6833, 6000, 5977, 5988, 6005,
+		3656, 3540, 3426, 3001, 5508, 2824, 5240, 5376, 6691, 5893, 6013, 5991, 6008,
+		3626, 3631, 3517, 3263, 5651, 5556, 2881, 5291, 6553, 5786, 5906, 6026, 6010,
+		3630, 3606, 3608, 3613, 5795, 5700, 5609, 2944, 6446, 5684, 5804, 5924, 6045,
+		2524, 2539, 2552, 5000, 7236, 7083, 6934, 6820, 3130, 6482, 6642, 6802, 6962,
+		3989, 3992, 3969, 5909, 6373, 6259, 6146, 6037, 6880, 4447, 5874, 6012, 6151,
+		4029, 4032, 4009, 5934, 6349, 6388, 6274, 6165, 7052, 6235, 4540, 6027, 6166,
+		4069, 4072, 4049, 5948, 6362, 6365, 6403, 6294, 7224, 6383, 6399, 4639, 6186,
+		4114, 4112, 4089, 5965, 6382, 6384, 6387, 6424, 7397, 6531, 6547, 6569, 4744,
+	},
+	{ // T6o
+		1450, 3242, 3213, 3182, 2470, 3594, 3566, 3566, 2562, 3937, 3937, 3973, 4012,
+		3405, 1420, 3124, 3092, 2492, 3487, 3573, 3545, 2579, 3941, 3942, 3977, 4012,
+		3372, 3275, 1390, 3004, 2511, 3381, 3467, 3553, 2595, 3922, 3922, 3957, 3993,
+		3338, 3240, 3145, 1357, 2378, 3282, 3368, 3455, 2646, 3911, 3911, 3946, 3982,
+		2563, 2583, 2601, 2455, 858, 2884, 3129, 3456, 4751, 5664, 5671, 5682, 5698,
+		3779, 3664, 3550, 3444, 3015, 2872, 5307, 5443, 6759, 5986, 6105, 6083, 6100,
+		3749, 3754, 3640, 3534, 3276, 5626, 2928, 5355, 6618, 5880, 5999, 6119, 6103,
+		3749, 3725, 3731, 3625, 3625, 5769, 5675, 2989, 6479, 5777, 5892, 6013, 6134,
+		2684, 2698, 2711, 2764, 5000, 7154, 7002, 6853, 3276, 6572, 6583, 6743, 6903,
+		4157, 4160, 4137, 4125, 5998, 6354, 6242, 6132, 6980, 4612, 5902, 6040, 6179,
+		4157, 4160, 4138, 4125, 6006, 6482, 6369, 6256, 6990, 6264, 4650, 6045, 6184,
+		4197, 4200, 4177, 4165, 6019, 6459, 6499, 6385, 7163, 6412, 6417, 4747, 6204,
+		4242, 4241, 4218, 4205, 6036, 6479, 6482, 6515, 7335, 6560, 6565, 6587, 4848,
+	},
+	{ // 96o
+		1560, 3360, 3364, 3333, 3322, 2613, 3637, 3637, 2664, 3965, 4045, 4046, 4085,
+		3533, 1572, 3304, 3273, 3262, 2636, 3678, 3649, 2717, 4002, 4083, 4083, 4118,
+		3536, 3469, 1548, 3188, 3178, 2657, 3577, 3663, 2738, 3988, 4068, 4068, 4104,
+		3502, 3435, 3344, 1515, 3069, 2539, 3477, 3563, 2787, 3976, 4056, 4056, 4092,
+		3484, 3418, 3326, 3210, 1397, 2614, 3393, 3479, 2729, 4014, 4074, 4074, 4110,
+		2717, 2739, 2758, 2628, 2702, 1015, 3145, 3472, 4751, 5657, 5731, 5738, 5753,
+		3822, 3865, 3755, 3648, 3558, 3288, 2929, 5390, 6640, 5935, 6059, 6179, 6163,
+		3822, 3833, 3846, 3738, 3648, 3637, 5710, 2989, 6499, 5829, 5953, 6073, 6194,
+		2793, 2848, 2867, 2917, 2846, 5000, 7025, 6873, 3378, 6444, 6629, 6640, 6800,
+		4184, 4223, 4206, 4192, 4226, 5987, 6297, 6184, 6840, 4613, 5963, 6054, 6193,
+		4275, 4314, 4297, 4283, 4297, 6071, 6429, 6317, 7043, 6325, 4772, 6046, 6185,
+		4275, 4314, 4297, 4284, 4297, 6078, 6558, 6445, 7054, 6423, 6417, 4813, 6190,
+		4320, 4354, 4337, 4324, 4337, 6096, 6542, 6575, 7226, 6571, 6566, 6571, 4911,
+	},
+	{ // 86o
+		1735, 3486, 3490, 3459, 3448, 3483, 2742, 3755, 2811, 4061, 4120, 4204, 4204,
+		3667, 1691, 3410, 3379, 3396, 3436, 2777, 3725, 2823, 4056, 4115, 4196, 4196,
+		3670, 3584, 1703, 3320, 3337, 3378, 2799, 3767, 2876, 4070, 4129, 4209, 4209,
+		3636, 3550, 3485, 1670, 3228, 3278, 2683, 3668, 2925, 4058, 4117, 4197, 4197,
+		3618, 3563, 3498, 3380, 1558, 3193, 2771, 3587, 2869, 4104, 4139, 4219, 4219,
+		3651, 3601, 3537, 3429, 3338, 1419, 2775, 3535, 2857, 4072, 4210, 4270, 4270,
+		2856, 2891, 2911, 2783, 2870, 2867, 1178, 3487, 4751, 5649, 5723, 5772, 5779,
+		3944, 3911, 3955, 3849, 3762, 3706, 3649, 2994, 6474, 5851, 5975, 6100, 6220,
+		2952, 2962, 3016, 3066, 2998, 2975, 5000, 6845, 3487, 6390, 6461, 6646, 6657,
+		4283, 4278, 4291, 4277, 4320, 4286, 5975, 6204, 6780, 4647, 5960, 6085, 6202,
+		4351, 4346, 4359, 4345, 4363, 4432, 6058, 6335, 6860, 6317, 4777, 6077, 6169,
+		4446, 4437, 4450, 4436, 4454, 4503, 6115, 6469, 7064, 6451, 6445, 4938, 6189,
+		4447, 4437, 4450, 4437, 4455, 4503, 6122, 6598, 7075, 6576, 6544, 6569, 4980,
+	},
+	{ // 76o
+		1867, 3612, 3571, 3539, 3557, 3597, 3578, 2856, 2922, 4127, 4182, 4245, 4351,
+		3800, 1866, 3536, 3504, 3521, 3562, 3589, 2907, 2970, 4155, 4210, 4269, 4354,
+		3756, 3717, 1822, 3332, 3442, 3511, 3544, 2940, 2981, 4127, 4182, 4241, 4325,
+		3722, 3683, 3499, 1789, 3333, 3411, 3444, 2825, 3030, 4115, 4170, 4229, 4313,
+		3735, 3696, 3611, 3494, 1713, 3350, 3388, 2917, 3007, 4190, 4220, 4279, 4363,
+		3773, 3735, 3681, 3573, 3507, 1579, 3314, 2935, 2997, 4162, 4299, 4334, 4418,
+		3747, 3759, 3711, 3604, 3542, 3465, 1441, 2957, 3031, 4164, 4301, 4438, 4502,
+		2977, 3029, 3063, 2936, 3027, 3038, 3053, 1345, 4751, 5642, 5715, 5763, 5833,
+		3070, 3120, 3129, 3180, 3147, 3127, 3155, 5000, 3596, 6317, 6366, 6437, 6623,
+		4350, 4380, 4349, 4336, 4410, 4379, 4381, 5963, 6700, 4685, 5957, 6064, 6193,
+		4414, 4444, 4413, 4400, 4448, 4525, 4527, 6045, 6756, 6308, 4808, 6035, 6165,
+		4486, 4512, 4481, 4468, 4516, 4569, 4674, 6101, 6837, 6423, 6396, 4939, 6184,
+		4605, 4608, 4577, 4563, 4611, 4664, 4749, 6181, 7041, 6561, 6534, 6559, 5106,
+	},
+	{ // 66
+		1954, 5231, 5198, 5157, 5169, 5324, 5331, 5371, 6550, 6562, 6580, 6603, 6655,
+		5499, 1951, 5091, 5050, 5062, 5217, 5336, 5343, 6520, 6661, 6679, 6697, 6720,
+		5464, 5348, 1947, 4943, 4955, 5111, 5229, 5348, 6491, 6631, 6649, 6667, 6690,
+		5419, 5303, 5186, 1903, 4794, 4954, 5073, 5192, 6477, 6596, 6614, 6632, 6655,
+		5430, 5314, 5198, 5025, 1907, 4912, 5031, 5151, 6344, 6634, 6633, 6651, 6674,
+		5598, 5482, 5366, 5198, 5152, 1882, 5001, 5120, 6250, 6635, 6712, 6711, 6734,
+		5605, 5609, 5494, 5326, 5281, 5250, 1856, 5081, 6151, 6632, 6709, 6786, 6790,
+		5647, 5616, 5621, 5453, 5409, 5378, 5338, 1836, 6052, 6629, 6706, 6783, 6860,
+		6950, 6917, 6885, 6870, 6724, 6622, 6513, 6404, 5000, 8141, 8312, 8483, 8655,
+		6907, 7015, 6982, 6944, 6983, 6985, 6982, 6980, 8622, 8136, 8101, 8150, 8204,
+		6926, 7034, 7001, 6963, 6982, 7066, 7063, 7061, 8807, 8507, 8140, 8164, 8218,
+		6951, 7053, 7021, 6983, 7001, 7066, 7145, 7142, 8993, 8560, 8575, 8145, 8237,
+		7008, 7079, 7046, 7008, 7027, 7091, 7150, 7224, 9179, 8618, 8633, 8653, 8156,
+	},
+	{ // 65s
+		2250, 3891, 3882, 3844, 3813, 3880, 3866, 3855, 3231, 3302, 4325, 4383, 4463,
+		4153, 2249, 3848, 3809, 3778, 3846, 3876, 3857, 3281, 3349, 4420, 4474, 4532,
+		4144, 4107, 2249, 3775, 3744, 3813, 3843, 3869, 3329, 3395, 4423, 4478, 4536,
+		4103, 4066, 4029, 2209, 3582, 3744, 3801, 3832, 3375, 3420, 4403, 4458, 4516,
+		4071, 4033, 3996, 3827, 2180, 3647, 3712, 3744, 3295, 3490, 4401, 4455, 4514,
+		4139, 4102, 4066, 3994, 3891, 2114, 3679, 3718, 3422, 3510, 4502, 4532, 4590,
+		4121, 4132, 4096, 4052, 3957, 3921, 1994, 3672, 3480, 3544, 4505, 4638, 4673,
+		4106, 4108, 4121, 4082, 3989, 3959, 3912, 1871, 3555, 3636, 4558, 4692, 4825,
+		3371, 3421, 3471, 3518, 3428, 3556, 3610, 3683, 1859, 5000, 5550, 5634, 5680,
+		3445, 3493, 3540, 3564, 3637, 3650, 3678, 3768, 5250, 3974, 6153, 6205, 6276,
+		4597, 4698, 4701, 4680, 4677, 4780, 4782, 4838, 5842, 6498, 4966, 6156, 6261,
+		4661, 4758, 4761, 4740, 4737, 4815, 4923, 4979, 5938, 6558, 6424, 5081, 6232,
+		4747, 4822, 4826, 4804, 4801, 4879, 4963, 5120, 5992, 6639, 6535, 6508, 5202,
+	},
+	{ // 64s
+		2085, 3765, 3757, 3719, 3721, 3743, 3732, 3720, 3082, 3896, 3183, 4264, 4343,
+		4023, 2085, 3722, 3684, 3686, 3709, 3742, 3723, 3132, 3991, 3230, 4354, 4413,
+		4014, 3977, 2084, 3650, 3652, 3675, 3709, 3735, 3181, 3995, 3276, 4358, 4417,
+		3973, 3936, 3899, 2044, 3578, 3606, 3667, 3698, 3227, 3975, 3301, 4338, 4396,
+		3975, 3937, 3900, 3822, 2059, 3558, 3623, 3656, 3285, 4031, 3386, 4366, 4424,
+		3999, 3961, 3925, 3853, 3800, 2035, 3554, 3596, 3247, 3974, 3499, 4394, 4453,
+		3983, 3994, 3959, 3914, 3866, 3793, 1940, 3569, 3410, 3957, 3533, 4500, 4535,
+		3968, 3970, 3983, 3944, 3898, 3834, 3805, 1821, 3505, 3990, 3625, 4554, 4687,
+		3210, 3261, 3311, 3358, 3417, 3371, 3539, 3634, 1688, 4450, 5000, 5331, 5431,
+		4153, 4254, 4258, 4236, 4294, 4234, 4215, 4251, 4668, 3357, 6061, 5766, 5887,
+		3317, 3365, 3412, 3436, 3524, 3642, 3670, 3761, 5250, 6401, 3889, 6154, 6225,
+		4537, 4633, 4637, 4615, 4644, 4674, 4781, 4838, 5611, 6021, 6504, 4969, 6156,
+		4623, 4698, 4702, 4680, 4708, 4738, 4822, 4979, 5724, 6149, 6585, 6428, 5090,
+	},
+	{ // 63s
+		1921, 3640, 3631, 3593, 3595, 3625, 3594, 3586, 2932, 3779, 3811, 3064, 4223,
+		3893, 1920, 3597, 3558, 3561, 3590, 3605, 3588, 2983, 3874, 3905, 3111, 4292,
+		3884, 3846, 1920, 3524, 3527, 3557, 3572, 3600, 3032, 3878, 3909, 3157, 4296,
+		3843, 3805, 3768, 1880, 3452, 3514, 3529, 3563, 3079, 3857, 3889, 3182, 4276,
+		3844, 3806, 3770, 3692, 1894, 3466, 3486, 3521, 3137, 3913, 3917, 3267, 4304,
+		3874, 3837, 3801, 3756, 3703, 1880, 3441, 3483, 3237, 3861, 3976, 3365, 4336,
+		3842, 3853, 3817, 3773, 3725, 3677, 1861, 3443, 3234, 3835, 3951, 3522, 4397,
+		3830, 3832, 3845, 3806, 3759, 3718, 3676, 1767, 3435, 3870, 3985, 3614, 4549,
+		3049, 3100, 3151, 3198, 3257, 3360, 3354, 3563, 1517, 4366, 4669, 5000, 5337,
+		4033, 4133, 4137, 4116, 4174, 4118, 4090, 4127, 4581, 3280, 5197, 6072, 5771,
+		4068, 4168, 4172, 4150, 4179, 4241, 4213, 4250, 4911, 5433, 3368, 6082, 5784,
+		3189, 3237, 3284, 3308, 3396, 3498, 3664, 3754, 5251, 6417, 6429, 3807, 6200,
+		4499, 4573, 4577, 4555, 4583, 4616, 4680, 4838, 5624, 6031, 6045, 6559, 4979,
+	},
+	{ // 62s
+		1756, 3513, 3505, 3466, 3469, 3498, 3470, 3447, 2782, 3662, 3694, 3729, 2945,
+		3761, 1756, 3470, 3432, 3434, 3464, 3481, 3450, 2833, 3756, 3787, 3819, 2992,
+		3752, 3715, 1755, 3398, 3400, 3430, 3448, 3462, 2882, 3760, 3791, 3823, 3038,
+		3711, 3674, 3637, 1715, 3326, 3388, 3410, 3425, 2930, 3739, 3771, 3802, 3063,
+		3713, 3675, 3639, 3560, 1730, 3339, 3366, 3383, 2988, 3796, 3799, 3831, 3148,
+		3743, 3706, 3670, 3625, 3572, 1716, 3322, 3345, 3088, 3743, 3859, 3863, 3246,
+		3712, 3723, 3687, 3648, 3600, 3552, 1701, 3326, 3224, 3718, 3833, 3949, 3384,
+		3688, 3690, 3703, 3664, 3617, 3576, 3556, 1688, 3259, 3748, 3863, 3979, 3603,
+		2887, 2939, 2990, 3038, 3097, 3200, 3343, 3377, 1345, 4320, 4569, 4663, 5000,
+		3912, 4012, 4016, 3995, 4053, 3997, 3970, 4002, 4537, 3203, 5064, 5202, 6083,
+		3947, 4047, 4051, 4029, 4058, 4120, 4093, 4124, 4807, 5296, 3291, 5215, 6093,
+		3986, 4083, 4086, 4065, 4093, 4126, 4216, 4248, 4909, 5442, 5457, 3384, 6107,
+		3062, 3110, 3157, 3181, 3269, 3370, 3514, 3748, 5251, 6434, 6446, 6461, 3731,
+	},
+	{ // A5o
+		810, 2614, 2666, 2758, 2850, 3115, 3339, 3641, 4013, 4750, 5279, 5304, 5314,
+		2742, 2985, 5473, 5440, 5400, 5574, 5694, 5693, 5697, 7146, 6151, 6162, 6173,
+		2795, 5808, 2980, 5321, 5280, 5454, 5574, 5693, 5665, 7110, 6119, 6130, 6141,
+		2891, 5773, 5644, 2982, 5161, 5335, 5455, 5574, 5666, 7075, 6087, 6098, 6109,
+		2987, 5729, 5600, 5471, 2952, 5175, 5299, 5418, 5509, 7056, 6047, 6058, 6069,
+		3271, 5914, 5785, 5656, 5484, 3050, 5274, 5393, 5484, 6953, 6120, 6102, 6113,
+		3508, 6043, 5914, 5785, 5617, 5590, 3060, 5302, 5393, 6807, 6011, 6131, 6109,
+		3829, 6041, 6041, 5912, 5744, 5717, 5618, 3077, 5331, 6698, 5930, 6051, 6171,
+		4230, 6045, 6010, 6011, 5843, 5816, 5717, 5650, 3093, 6555, 5847, 5967, 6088,
+		5000, 7569, 7530, 7491, 7470, 7361, 7203, 7084, 6931, 3263, 6798, 6959, 7119,
+		5581, 6535, 6500, 6465, 6422, 6501, 6383, 6297, 6208, 7208, 4534, 6211, 6350,
+		5608, 6548, 6513, 6478, 6435, 6483, 6512, 6426, 6337, 7381, 6593, 4642, 6365,
+		5620, 6561, 6526, 6491, 6448, 6496, 6490, 6556, 6467, 7555, 6742, 6759, 4756,
+	},
+	{ // K5o
+		1203, 2300, 3379, 3370, 3329, 3481, 3463, 3430, 3431, 2328, 3662, 3697, 3733,
+		2380, 669, 2525, 2615, 2696, 2945, 3231, 3513, 3864, 4750, 5287, 5312, 5323,
+		3555, 2643, 2819, 5273, 5232, 5406, 5541, 5662, 5662, 7062, 6040, 6051, 6063,
+		3546, 2737, 5595, 2822, 5113, 5287, 5422, 5543, 5663, 7026, 6009, 6019, 6031,
+		3502, 2822, 5551, 5422, 2791, 5127, 5266, 5387, 5507, 7007, 5969, 5980, 5991,
+		3664, 3087, 5736, 5607, 5435, 2888, 5241, 5329, 5448, 6868, 6009, 5991, 6002,
+		3644, 3393, 5879, 5750, 5582, 5556, 2953, 5277, 5397, 6761, 5939, 6059, 6038,
+		3607, 3692, 6009, 5880, 5712, 5649, 5594, 2963, 5302, 6616, 5826, 5946, 6067,
+		3607, 4069, 6007, 6008, 5840, 5777, 5722, 5620, 2985, 6507, 5746, 5867, 5988,
+		2431, 5000, 7480, 7441, 7420, 7271, 7156, 6999, 6881, 3137, 6589, 6750, 6911,
+		3861, 5592, 6418, 6384, 6341, 6384, 6310, 6189, 6104, 6989, 4479, 6070, 6208,
+		3901, 5620, 6431, 6397, 6354, 6365, 6439, 6318, 6233, 7163, 6446, 4584, 6223,
+		3942, 5634, 6445, 6410, 6367, 6379, 6417, 6448, 6363, 7337, 6594, 6611, 4695,
+	},
+	{ // Q5o
+		1246, 3085, 2316, 3379, 3338, 3490, 3468, 3471, 3464, 2364, 3695, 3730, 3765,
+		3235, 1247, 2374, 3301, 3260, 3412, 3503, 3480, 3447, 2413, 3789, 3824, 3860,
+		2397, 2458, 696, 2602, 2680, 2916, 3179, 3533, 3865, 4750, 5287, 5312, 5323,
+		3554, 3472, 2723, 2810, 5118, 5292, 5427, 5563, 5685, 7013, 6030, 6041, 6053,
+		3510, 3427, 2804, 5429, 2779, 5132, 5271, 5407, 5528, 6994, 5991, 6002, 6013,
+		3672, 3589, 3056, 5613, 5441, 2875, 5213, 5349, 5465, 6849, 6025, 6007, 6019,
+		3648, 3686, 3335, 5757, 5589, 5526, 2939, 5297, 5386, 6711, 5928, 6048, 6027,
+		3652, 3660, 3714, 5900, 5733, 5670, 5615, 3004, 5330, 6605, 5854, 5975, 6096,
+		3642, 3624, 4069, 6031, 5863, 5794, 5709, 5651, 3018, 6460, 5742, 5863, 5984,
+		2470, 2520, 5000, 7428, 7407, 7252, 7103, 6989, 6832, 3167, 6539, 6699, 6860,
+		3897, 3996, 5592, 6407, 6364, 6401, 6297, 6220, 6099, 6938, 4505, 6066, 6205,
+		3937, 4036, 5620, 6420, 6376, 6383, 6426, 6349, 6229, 7111, 6441, 4607, 6220,
+		3977, 4077, 5634, 6433, 6390, 6396, 6404, 6479, 6358, 7284, 6590, 6607, 4715,
+	},
+	{ // J5o
+		1290, 3117, 3088, 2332, 3347, 3498, 3476, 3476, 3504, 2400, 3727, 3762, 3797,
+		3270, 1291, 3028, 2390, 3268, 3420, 3511, 3484, 3488, 2449, 3821, 3856, 3892,
+		3237, 3173, 1261, 2410, 3163, 3314, 3405, 3492, 3468, 2464, 3801, 3837, 3872,
+		2415, 2476, 2494, 727, 2667, 2898, 3144, 3473, 3885, 4750, 5287, 5312, 5323,
+		3518, 3435, 3321, 2790, 2766, 5130, 5269, 5404, 5541, 6965, 6005, 6016, 6028,
+		3679, 3597, 3483, 3036, 5440, 2861, 5206, 5342, 5479, 6823, 6041, 6023, 6034,
+		3655, 3694, 3579, 3298, 5587, 5520, 2924, 5263, 5399, 6679, 5938, 6058, 6037,
+		3655, 3664, 3671, 3649, 5731, 5663, 5578, 2987, 5344, 6541, 5837, 5957, 6078,
+		3686, 3668, 3645, 4091, 5875, 5808, 5723, 5664, 3056, 6436, 5764, 5884, 6005,
+		2509, 2559, 2572, 5000, 7378, 7225, 7071, 6922, 6809, 3197, 6476, 6636, 6797,
+		3931, 4031, 4008, 5592, 6378, 6417, 6307, 6200, 6123, 6872, 4530, 6055, 6194,
+		3971, 4071, 4048, 5620, 6391, 6398, 6436, 6329, 6252, 7045, 6430, 4629, 6209,
+		4011, 4111, 4088, 5634, 6405, 6412, 6415, 6459, 6382, 7218, 6579, 6595, 4734,
+	},
+	{ // T5o
+		1290, 3117, 3088, 3059, 2311, 3477, 3449, 3449, 3478, 2419, 3725, 3761, 3796,
+		3270, 1291, 3028, 2999, 2370, 3399, 3485, 3457, 3461, 2468, 3820, 3855, 3891,
+		3237, 3173, 1261, 2911, 2389, 3293, 3379, 3465, 3442, 2484, 3800, 3836, 3871,
+		3204, 3140, 3045, 1233, 2407, 3195, 3281, 3367, 3453, 2515, 3788, 3824, 3859,
+		2392, 2454, 2472, 2487, 718, 2844, 3114, 3423, 3801, 4750, 5266, 5291, 5303,
+		3656, 3574, 3460, 3355, 2978, 2833, 5173, 5309, 5445, 6748, 6020, 5998, 6009,
+		3626, 3665, 3551, 3445, 3266, 5486, 2889, 5221, 5357, 6606, 5914, 6034, 6013,
+		3626, 3635, 3642, 3536, 3595, 5630, 5536, 2950, 5273, 6468, 5807, 5928, 6049,
+		3657, 3639, 3616, 3627, 4002, 5774, 5680, 5590, 3017, 6363, 5706, 5826, 5947,
+		2530, 2580, 2593, 2622, 5000, 7146, 6994, 6846, 6733, 3209, 6404, 6564, 6724,
+		3930, 4030, 4007, 3992, 5570, 6394, 6282, 6169, 6061, 6798, 4520, 6025, 6164,
+		3970, 4070, 4047, 4032, 5598, 6372, 6411, 6298, 6190, 6970, 6398, 4616, 6179,
+		4010, 4110, 4087, 4072, 5612, 6385, 6389, 6428, 6319, 7143, 6546, 6563, 4718,
+	},
+	{ // 95o
+		1401, 3235, 3239, 3215, 3184, 2454, 3520, 3520, 3545, 2520, 3833, 3834, 3869,
+		3398, 1443, 3209, 3185, 3153, 2514, 3590, 3561, 3561, 2606, 3961, 3961, 3997,
+		3401, 3368, 1419, 3100, 3069, 2535, 3489, 3575, 3547, 2627, 3947, 3947, 3982,
+		3374, 3341, 3249, 1391, 2982, 2568, 3389, 3475, 3562, 2656, 3934, 3934, 3969,
+		3340, 3307, 3215, 3120, 1360, 2460, 3301, 3387, 3474, 2732, 3933, 3933, 3969,
+		2546, 2609, 2629, 2660, 2541, 875, 3131, 3439, 3817, 4750, 5354, 5361, 5373,
+		3699, 3775, 3666, 3559, 3464, 3279, 2890, 5256, 5392, 6629, 5974, 6094, 6072,
+		3699, 3744, 3756, 3649, 3555, 3608, 5570, 2950, 5305, 6488, 5869, 5988, 6109,
+		3726, 3744, 3727, 3741, 3646, 4013, 5714, 5621, 3015, 6350, 5766, 5882, 6003,
+		2639, 2729, 2748, 2775, 2854, 5000, 7017, 6865, 6717, 3307, 6448, 6459, 6619,
+		4047, 4183, 4166, 4150, 4149, 5665, 6342, 6230, 6121, 6849, 4642, 6021, 6160,
+		4048, 4184, 4166, 4151, 4150, 5672, 6470, 6358, 6245, 6860, 6392, 4682, 6165,
+		4088, 4224, 4206, 4191, 4190, 5686, 6448, 6488, 6375, 7032, 6541, 6546, 4780,
+	},
+	{ // 85o
+		1576, 3361, 3365, 3341, 3310, 3348, 2584, 3638, 3662, 2668, 3908, 3988, 3988,
+		3532, 1562, 3314, 3318, 3287, 3330, 2655, 3637, 3637, 2712, 3994, 4074, 4074,
+		3535, 3483, 1574, 3259, 3228, 3272, 2678, 3679, 3651, 2765, 4007, 4087, 4088,
+		3508, 3485, 3420, 1551, 3144, 3194, 2712, 3585, 3671, 2799, 3999, 4080, 4080,
+		3474, 3451, 3386, 3295, 1521, 3097, 2616, 3496, 3582, 2872, 3998, 4078, 4078,
+		3510, 3493, 3429, 3344, 3240, 1408, 2735, 3444, 3530, 2859, 4068, 4129, 4129,
+		2685, 2761, 2782, 2815, 2709, 2829, 1039, 3456, 3833, 4750, 5347, 5435, 5442,
+		3821, 3822, 3866, 3764, 3668, 3613, 3621, 2955, 5306, 6463, 5890, 6015, 6135,
+		3848, 3822, 3835, 3854, 3758, 3703, 4025, 5619, 3018, 6322, 5785, 5910, 6030,
+		2797, 2844, 2897, 2929, 3006, 2983, 5000, 6837, 6686, 3412, 6272, 6457, 6468,
+		4123, 4215, 4228, 4218, 4216, 4285, 5654, 6248, 6136, 6657, 4646, 6048, 6140,
+		4214, 4306, 4319, 4309, 4307, 4356, 5753, 6382, 6270, 6861, 6417, 4807, 6127,
+		4215, 4307, 4320, 4310, 4308, 4356, 5760, 6511, 6399, 6872, 6515, 6505, 4849,
+	},
+	{ // 75o
+		1707, 3487, 3446, 3450, 3418, 3462, 3443, 2698, 3747, 2778, 3970, 4029, 4110,
+		3666, 1737, 3440, 3444, 3412, 3456, 3483, 2785, 3754, 2859, 4089, 4148, 4228,
+		3621, 3616, 1693, 3364, 3333, 3405, 3438, 2819, 3726, 2870, 4060, 4119, 4200,
+		3624, 3619, 3534, 1706, 3275, 3353, 3386, 2854, 3775, 2936, 4080, 4140, 4220,
+		3590, 3585, 3500, 3436, 1676, 3254, 3295, 2762, 3686, 3009, 4079, 4138, 4218,
+		3632, 3627, 3573, 3515, 3409, 1569, 3241, 2894, 3638, 2999, 4158, 4193, 4273,
+		3605, 3651, 3603, 3545, 3448, 3389, 1435, 2941, 3619, 3034, 4160, 4297, 4357,
+		2807, 2900, 2934, 2968, 2867, 3001, 3042, 1207, 3848, 4750, 5339, 5427, 5473,
+		3934, 3943, 3912, 3963, 3868, 3816, 3796, 4037, 3020, 6232, 5749, 5873, 5998,
+		2916, 3001, 3011, 3078, 3154, 3135, 3163, 5000, 6587, 3518, 6155, 6226, 6411,
+		4186, 4313, 4282, 4302, 4300, 4378, 4380, 5641, 6093, 6529, 4677, 5987, 6114,
+		4254, 4381, 4350, 4370, 4368, 4421, 4527, 5740, 6225, 6610, 6347, 4808, 6101,
+		4346, 4473, 4442, 4462, 4460, 4513, 4598, 5793, 6359, 6814, 6482, 6471, 4970,
+	},
+	{ // 65o
+		1869, 3608, 3600, 3559, 3527, 3599, 3585, 3574, 2847, 2920, 4058, 4117, 4197,
+		3797, 1869, 3565, 3524, 3492, 3564, 3596, 3577, 2898, 2969, 4154, 4210, 4269,
+		3787, 3749, 1868, 3489, 3458, 3530, 3563, 3590, 2948, 3016, 4159, 4214, 4273,
+		3743, 3704, 3667, 1825, 3287, 3457, 3518, 3551, 2995, 3040, 4137, 4192, 4251,
+		3709, 3670, 3633, 3449, 1795, 3359, 3428, 3462, 2905, 3114, 4136, 4191, 4250,
+		3781, 3742, 3705, 3628, 3522, 1724, 3396, 3437, 3043, 3136, 4243, 4273, 4332,
+		3760, 3772, 3735, 3688, 3591, 3556, 1595, 3393, 3106, 3173, 4248, 4386, 4420,
+		3743, 3746, 3759, 3718, 3623, 3595, 3549, 1464, 3188, 3273, 4308, 4445, 4582,
+		2969, 3021, 3073, 3120, 3020, 3160, 3220, 3300, 1378, 4750, 5332, 5419, 5463,
+		3069, 3119, 3168, 3191, 3267, 3283, 3314, 3413, 5000, 3624, 6003, 6054, 6126,
+		4275, 4380, 4384, 4360, 4358, 4468, 4473, 4538, 5630, 6363, 4712, 5904, 6012,
+		4343, 4444, 4448, 4424, 4422, 4505, 4619, 4684, 5727, 6422, 6251, 4836, 5980,
+		4435, 4512, 4516, 4492, 4490, 4573, 4663, 4831, 5779, 6503, 6366, 6335, 4968,
+	},
+	{ // 55
+		1911, 5197, 5164, 5132, 5091, 5250, 5258, 5298, 5360, 6353, 6631, 6649, 6667,
+		5462, 1952, 5052, 5020, 4978, 5138, 5256, 5264, 5304, 6468, 6651, 6669, 6687,
+		5427, 5305, 1948, 4915, 4873, 5033, 5152, 5270, 5278, 6441, 6623, 6641, 6659,
+		5392, 5270, 5156, 1946, 4768, 4928, 5047, 5166, 5284, 6415, 6596, 6614, 6632,
+		5346, 5225, 5111, 4997, 1904, 4770, 4893, 5013, 5131, 6404, 6564, 6582, 6599,
+		5518, 5397, 5284, 5170, 4999, 1916, 4891, 5010, 5129, 6314, 6645, 6645, 6662,
+		5525, 5525, 5411, 5298, 5132, 5130, 1896, 4976, 5096, 6218, 6644, 6721, 6720,
+		5568, 5532, 5539, 5426, 5260, 5259, 5224, 1876, 5059, 6122, 6644, 6721, 6798,
+		5635, 5574, 5546, 5553, 5388, 5387, 5353, 5315, 1864, 6026, 6643, 6720, 6797,
+		6737, 6863, 6833, 6803, 6791, 6693, 6588, 6482, 6376, 5000, 8106, 8277, 8448,
+		6985, 7005, 6975, 6945, 6910, 6997, 6996, 6996, 6996, 8585, 8148, 8301, 8351,
+		7004, 7024, 6994, 6964, 6929, 6996, 7078, 7077, 7078, 8770, 8722, 8152, 8364,
+		7024, 7044, 7014, 6984, 6949, 7016, 7077, 7159, 7159, 8956, 8775, 8790, 8157,
+	},
+	{ // 54s
+		2109, 3866, 3858, 3850, 3811, 3833, 3848, 3842, 3860, 3080, 3157, 4347, 4402,
+		4124, 2252, 3837, 3829, 3791, 3818, 3878, 3863, 3852, 3270, 3345, 4442, 4497,
+		4115, 4096, 2251, 3795, 3757, 3785, 3845, 3875, 3856, 3319, 3391, 4446, 4500,
+		4107, 4087, 4051, 2252, 3723, 3757, 3818, 3848, 3874, 3380, 3449, 4456, 4511,
+		4065, 4046, 4010, 3974, 2214, 3604, 3758, 3816, 3847, 3450, 3497, 4446, 4500,
+		4089, 4076, 4040, 4010, 3850, 2190, 3689, 3757, 3790, 3412, 3611, 4474, 4529,
+		4102, 4136, 4101, 4071, 4008, 3935, 2129, 3750, 3794, 3584, 3674, 4607, 4637,
+		4092, 4118, 4130, 4101, 4068, 4004, 3996, 2015, 3796, 3700, 3769, 4664, 4798,
+		4107, 4103, 4107, 4126, 4098, 4037, 4040, 4043, 1899, 3847, 3939, 4803, 4936,
+		3202, 3411, 3461, 3524, 3596, 3552, 3728, 3845, 3997, 1894, 5000, 5318, 5418,
+		3283, 3490, 3537, 3597, 3645, 3764, 3824, 3917, 4094, 5250, 4000, 5930, 5980,
+		4621, 4722, 4726, 4736, 4725, 4755, 4892, 4952, 5099, 5592, 6257, 5020, 5996,
+		4682, 4782, 4786, 4797, 4786, 4815, 4927, 5093, 5240, 5704, 6315, 6253, 5135,
+	},
+	{ // 53s
+		1945, 3740, 3732, 3724, 3685, 3741, 3710, 3707, 3725, 2931, 3898, 3038, 4282,
+		3994, 2087, 3712, 3703, 3665, 3725, 3740, 3728, 3717, 3121, 4013, 3226, 4376,
+		3985, 3965, 2087, 3670, 3631, 3692, 3707, 3740, 3721, 3170, 4017, 3272, 4380,
+		3976, 3957, 3920, 2087, 3598, 3664, 3680, 3714, 3739, 3232, 4028, 3330, 4390,
+		3935, 3915, 3879, 3843, 2050, 3600, 3620, 3682, 3712, 3301, 4017, 3378, 4380,
+		3993, 3979, 3943, 3913, 3845, 2069, 3599, 3667, 3701, 3402, 4104, 3506, 4439,
+		3960, 3995, 3959, 3930, 3867, 3843, 2050, 3625, 3672, 3408, 4079, 3663, 4499,
+		3953, 3979, 3992, 3963, 3929, 3913, 3867, 1961, 3692, 3630, 4116, 3757, 4659,
+		3969, 3964, 3968, 3988, 3960, 3946, 3915, 3936, 1850, 3795, 4234, 3928, 4798,
+		3041, 3250, 3301, 3364, 3436, 3541, 3543, 3774, 3946, 1723, 4682, 5000, 5325,
+		4156, 4278, 4282, 4293, 4281, 4372, 4346, 4385, 4511, 4919, 3381, 5826, 5569,
+		3155, 3362, 3409, 3469, 3518, 3651, 3817, 3910, 4088, 5251, 6147, 3914, 5917,
+		4557, 4658, 4661, 4672, 4661, 4722, 4785, 4951, 5098, 5606, 5812, 6247, 5023,
+	},
+	{ // 52s
+		1780, 3614, 3606, 3598, 3559, 3614, 3591, 3568, 3587, 2782, 3781, 3812, 2919,
+		3862, 1923, 3585, 3577, 3538, 3599, 3621, 3590, 3582, 2971, 3896, 3927, 3107,
+		3853, 3834, 1922, 3543, 3505, 3566, 3588, 3602, 3586, 3021, 3900, 3931, 3153,
+		3845, 3825, 3789, 1923, 3471, 3538, 3561, 3575, 3604, 3083, 3910, 3941, 3211,
+		3803, 3784, 3748, 3712, 1885, 3474, 3527, 3543, 3577, 3153, 3899, 3931, 3259,
+		3862, 3848, 3812, 3782, 3714, 1904, 3506, 3529, 3566, 3254, 3986, 3990, 3387,
+		3835, 3870, 3834, 3805, 3770, 3746, 1895, 3511, 3558, 3398, 3965, 4081, 3529,
+		3811, 3837, 3850, 3821, 3787, 3771, 3751, 1882, 3566, 3454, 3994, 4110, 3747,
+		3827, 3825, 3829, 3849, 3821, 3807, 3798, 3807, 1796, 3724, 4113, 4229, 3917,
+		2881, 3089, 3140, 3203, 3276, 3381, 3532, 3589, 3874, 1552, 4582, 4675, 5000,
+		4035, 4157, 4161, 4172, 4160, 4251, 4229, 4260, 4386, 4816, 3304, 4977, 5837,
+		4071, 4192, 4196, 4207, 4196, 4256, 4352, 4383, 4509, 4916, 5200, 3394, 5847,
+		3028, 3235, 3282, 3342, 3390, 3524, 3673, 3904, 4082, 5251, 6163, 6175, 3834,
+	},
+	{ // A4o
+		766, 2568, 2620, 2712, 2841, 3066, 3322, 3627, 3988, 4195, 4750, 5026, 5053,
+		2692, 2945, 5463, 5431, 5400, 5532, 5656, 5655, 5659, 5788, 7162, 6153, 6164,
+		2746, 5799, 2940, 5311, 5280, 5412, 5536, 5655, 5627, 5755, 7126, 6120, 6131,
+		2842, 5763, 5634, 2942, 5161, 5293, 5417, 5536, 5628, 5724, 7090, 6089, 6100,
+		2979, 5729, 5599, 5471, 2952, 5175, 5299, 5418, 5509, 5725, 7055, 6058, 6069,
+		3218, 5870, 5741, 5612, 5484, 2993, 5184, 5307, 5398, 5614, 7081, 6063, 6074,
+		3490, 6003, 5874, 5745, 5617, 5495, 3035, 5238, 5329, 5545, 6935, 6092, 6074,
+		3816, 6001, 6001, 5872, 5744, 5626, 5551, 3057, 5271, 5487, 6826, 6016, 6136,
+		4205, 6005, 5970, 5971, 5843, 5725, 5649, 5586, 3074, 5403, 6683, 5932, 6053,
+		4419, 6139, 6103, 6069, 6070, 5953, 5877, 5814, 5725, 3015, 6717, 5844, 5965,
+		5000, 7586, 7547, 7508, 7470, 7500, 7341, 7223, 7070, 7101, 3308, 6975, 7135,
+		5309, 6538, 6503, 6468, 6434, 6442, 6471, 6390, 6301, 6205, 7399, 4606, 6356,
+		5339, 6551, 6516, 6481, 6448, 6455, 6453, 6519, 6430, 6335, 7572, 6749, 4721,
+	},
+	{ // K4o
+		1159, 2252, 3342, 3334, 3325, 3435, 3445, 3418, 3418, 3299, 2311, 3660, 3696,
+		2328, 625, 2479, 2570, 2688, 2895, 3214, 3498, 3840, 4179, 4750, 5034, 5061,
+		3516, 2594, 2779, 5263, 5232, 5364, 5503, 5624, 5624, 5659, 7077, 6042, 6053,
+		3507, 2689, 5585, 2782, 5113, 5245, 5384, 5505, 5625, 5627, 7042, 6010, 6022,
+		3498, 2814, 5551, 5422, 2791, 5127, 5266, 5387, 5506, 5628, 7007, 5979, 5991,
+		3616, 3035, 5692, 5563, 5435, 2831, 5151, 5243, 5362, 5484, 6996, 5952, 5963,
+		3626, 3375, 5839, 5710, 5582, 5460, 2928, 5213, 5333, 5455, 6889, 6020, 6003,
+		3595, 3679, 5969, 5840, 5712, 5558, 5527, 2944, 5242, 5364, 6744, 5911, 6032,
+		3595, 4044, 5967, 5968, 5840, 5686, 5654, 5556, 2966, 5302, 6635, 5832, 5953,
+		3465, 4408, 6004, 5969, 5970, 5817, 5785, 5687, 5620, 2995, 6510, 5722, 5843,
+		2414, 5000, 7497, 7459, 7421, 7410, 7295, 7138, 7020, 6884, 3179, 6765, 6927,
+		3862, 5321, 6422, 6387, 6353, 6324, 6398, 6281, 6196, 6078, 7180, 4548, 6214,
+		3902, 5352, 6435, 6401, 6367, 6338, 6381, 6411, 6326, 6208, 7354, 6601, 4660,
+	},
+	{ // Q4o
+		1202, 3048, 2268, 3343, 3334, 3444, 3450, 3459, 3451, 3331, 2348, 3693, 3728,
+		3196, 1203, 2326, 3264, 3256, 3366, 3485, 3467, 3435, 3408, 2397, 3787, 3823,
+		2346, 2407, 652, 2557, 2671, 2867, 3161, 3519, 3840, 4179, 4750, 5034, 5061,
+		3515, 3433, 2674, 2770, 5118, 5250, 5389, 5525, 5647, 5649, 7028, 6032, 6044,
+		3506, 3423, 2796, 5428, 2779, 5132, 5271, 5407, 5528, 5650, 6993, 6001, 6013,
+		3624, 3541, 3004, 5569, 5441, 2818, 5123, 5263, 5379, 5501, 6977, 5968, 5980,
+		3630, 3668, 3318, 5717, 5588, 5431, 2914, 5233, 5322, 5444, 6839, 6009, 5992,
+		3640, 3648, 3701, 5861, 5732, 5579, 5547, 2984, 5270, 5393, 6733, 5940, 6061,
+		3630, 3612, 4044, 5991, 5862, 5703, 5641, 5587, 2999, 5299, 6588, 5828, 5949,
+		3500, 3582, 4408, 5992, 5993, 5834, 5772, 5718, 5616, 3025, 6463, 5718, 5839,
+		2453, 2503, 5000, 7445, 7407, 7391, 7242, 7128, 6971, 6836, 3206, 6715, 6876,
+		3897, 3997, 5321, 6410, 6376, 6342, 6385, 6312, 6192, 6074, 7128, 4571, 6210,
+		3937, 4037, 5352, 6424, 6390, 6355, 6368, 6442, 6322, 6204, 7301, 6597, 4679,
+	},
+	{ // J4o
+		1246, 3081, 3052, 2284, 3343, 3452, 3458, 3463, 3492, 3363, 2384, 3725, 3760,
+		3231, 1247, 2991, 2342, 3265, 3374, 3494, 3471, 3475, 3440, 2432, 3819, 3855,
+		3198, 3134, 1217, 2362, 3159, 3268, 3388, 3479, 3456, 3420, 2448, 3800, 3835,
+		2363, 2425, 2443, 683, 2659, 2848, 3126, 3458, 3860, 4179, 4750, 5034, 5062,
+		3514, 3431, 3317, 2781, 2766, 5129, 5268, 5404, 5541, 5665, 6965, 6016, 6027,
+		3631, 3549, 3435, 2984, 5439, 2805, 5116, 5256, 5392, 5517, 6951, 5984, 5995,
+		3637, 3676, 3562, 3280, 5587, 5424, 2899, 5199, 5335, 5454, 6807, 6019, 6002,
+		3643, 3652, 3658, 3635, 5730, 5572, 5510, 2968, 5284, 5375, 6669, 5922, 6043,
+		3674, 3656, 3633, 4066, 5875, 5717, 5655, 5600, 3037, 5320, 6564, 5850, 5971,
+		3535, 3616, 3593, 4408, 6008, 5850, 5782, 5698, 5640, 3055, 6403, 5707, 5828,
+		2492, 2541, 2555, 5000, 7378, 7364, 7209, 7061, 6948, 6773, 3233, 6652, 6812,
+		3931, 4031, 4008, 5321, 6391, 6357, 6395, 6292, 6216, 6063, 7062, 4594, 6200,
+		3971, 4071, 4048, 5352, 6405, 6371, 6378, 6422, 6346, 6192, 7235, 6586, 4698,
+	},
+	{ // T4o
+		1290, 3113, 3084, 3055, 2300, 3460, 3465, 3471, 3495, 3403, 2419, 3756, 3792,
+		3266, 1291, 3024, 2995, 2358, 3382, 3501, 3479, 3479, 3480, 2468, 3851, 3886,
+		3233, 3169, 1261, 2907, 2378, 3276, 3395, 3487, 3459, 3460, 2483, 3831, 3867,
+		3200, 3136, 3041, 1232, 2396, 3178, 3297, 3388, 3475, 3448, 2514, 3819, 3855,
+		2380, 2442, 2460, 2476, 718, 2835, 3106, 3415, 3794, 4200, 4750, 5034, 5062,
+		3638, 3556, 3442, 3336, 2969, 2791, 5103, 5243, 5379, 5518, 6898, 5987, 5999,
+		3644, 3683, 3569, 3463, 3258, 5411, 2884, 5181, 5318, 5457, 6757, 6024, 6007,
+		3650, 3658, 3665, 3560, 3588, 5559, 5492, 2951, 5239, 5378, 6613, 5922, 6043,
+		3676, 3658, 3636, 3651, 3994, 5703, 5637, 5552, 3018, 5323, 6476, 5821, 5942,
+		3578, 3659, 3636, 3622, 4430, 5851, 5784, 5700, 5642, 3090, 6355, 5719, 5840,
+		2530, 2579, 2593, 2622, 5000, 7308, 7156, 7002, 6855, 6724, 3259, 6564, 6724,
+		3965, 4065, 4042, 4027, 5321, 6361, 6400, 6291, 6184, 6075, 6970, 4616, 6179,
+		4005, 4105, 4082, 4067, 5352, 6374, 6382, 6421, 6314, 6205, 7143, 6563, 4717,
+	},
+	{ // 94o
+		1225, 3103, 3107, 3083, 3054, 2292, 3396, 3396, 3421, 3329, 2390, 3707, 3743,
+		3258, 1267, 3077, 3053, 3024, 2353, 3466, 3437, 3437, 3438, 2476, 3835, 3870,
+		3261, 3228, 1243, 2968, 2939, 2374, 3365, 3451, 3423, 3424, 2497, 3821, 3856,
+		3234, 3201, 3109, 1215, 2852, 2407, 3266, 3351, 3438, 3411, 2526, 3808, 3843,
+		3200, 3168, 3076, 2981, 1189, 2449, 3178, 3264, 3350, 3434, 2581, 3806, 3842,
+		2373, 2436, 2456, 2488, 2531, 689, 3052, 3383, 3733, 4111, 4750, 5013, 5041,
+		3571, 3647, 3538, 3431, 3336, 3199, 2809, 5116, 5252, 5391, 6635, 5970, 5949,
+		3571, 3615, 3628, 3521, 3427, 3554, 5424, 2869, 5164, 5304, 6495, 5865, 5986,
+		3597, 3615, 3598, 3612, 3518, 3929, 5568, 5475, 2934, 5220, 6358, 5759, 5880,
+		3499, 3616, 3599, 3583, 3606, 4335, 5715, 5622, 5532, 3003, 6236, 5628, 5749,
+		2500, 2590, 2609, 2636, 2692, 5000, 7029, 6877, 6730, 6599, 3223, 6446, 6606,
+		3914, 4049, 4032, 4016, 4013, 5299, 6342, 6230, 6118, 5977, 6847, 4562, 6116,
+		3954, 4089, 4072, 4056, 4053, 5330, 6321, 6360, 6248, 6107, 7019, 6496, 4660,
+	},
+	{ // 84o
+		1400, 3230, 3234, 3210, 3180, 3203, 2422, 3514, 3539, 3442, 2538, 3862, 3862,
+		3392, 1386, 3182, 3186, 3162, 3185, 2494, 3513, 3513, 3510, 2582, 3948, 3948,
+		3395, 3343, 1398, 3128, 3104, 3127, 2517, 3556, 3527, 3524, 2635, 3961, 3961,
+		3368, 3345, 3280, 1375, 3020, 3049, 2551, 3461, 3547, 3516, 2669, 3953, 3954,
+		3335, 3318, 3253, 3162, 1349, 2974, 2606, 3372, 3458, 3541, 2722, 3951, 3951,
+		3359, 3343, 3278, 3194, 3112, 1324, 2541, 3316, 3402, 3486, 2843, 3983, 3983,
+		2512, 2588, 2610, 2643, 2699, 2626, 853, 3400, 3750, 4126, 4750, 5104, 5111,
+		3693, 3693, 3738, 3635, 3540, 3480, 3568, 2874, 5166, 5305, 6470, 5891, 6012,
+		3719, 3693, 3706, 3726, 3631, 3571, 3942, 5473, 2937, 5218, 6330, 5787, 5907,
+		3617, 3690, 3703, 3693, 3718, 3658, 4346, 5620, 5527, 3004, 6176, 5654, 5771,
+		2659, 2705, 2758, 2791, 2844, 2971, 5000, 6849, 6698, 6532, 3324, 6442, 6453,
+		4080, 4172, 4185, 4175, 4171, 4205, 5397, 6254, 6142, 6001, 6846, 4686, 6078,
+		4081, 4172, 4185, 4175, 4171, 4205, 5404, 6383, 6272, 6126, 6857, 6454, 4728,
+	},
+	{ // 74o
+		1532, 3355, 3314, 3318, 3294, 3317, 3301, 2536, 3623, 3527, 2649, 3903, 3984,
+		3526, 1562, 3308, 3312, 3288, 3311, 3341, 2623, 3630, 3627, 2729, 4022, 4102,
+		3481, 3476, 1517, 3233, 3236, 3260, 3296, 2658, 3602, 3599, 2740, 3993, 4074,
+		3484, 3479, 3394, 1530, 3179, 3208, 3244, 2694, 3651, 3619, 2806, 4013, 4094,
+		3457, 3451, 3397, 3332, 1510, 3135, 3176, 2752, 3567, 3650, 2864, 4016, 4097,
+		3481, 3476, 3422, 3364, 3285, 1485, 3109, 2700, 3510, 3593, 2983, 4047, 4127,
+		3458, 3504, 3456, 3398, 3324, 3252, 1378, 2861, 3492, 3575, 3018, 4151, 4211,
+		2633, 2727, 2762, 2797, 2856, 2797, 2962, 1021, 3766, 4142, 4750, 5097, 5201,
+		3805, 3814, 3784, 3835, 3744, 3683, 3665, 3955, 2939, 5162, 6239, 5750, 5876,
+		3703, 3811, 3780, 3800, 3831, 3770, 3752, 4359, 5462, 3004, 6083, 5615, 5740,
+		2777, 2862, 2872, 2939, 2998, 3123, 3151, 5000, 6599, 6430, 3426, 6200, 6385,
+		4120, 4247, 4216, 4236, 4238, 4271, 4376, 5385, 6098, 5953, 6583, 4687, 6047,
+		4211, 4338, 4307, 4328, 4329, 4362, 4447, 5502, 6232, 6088, 6787, 6415, 4849,
+	},
+	{ // 64o
+		1694, 3476, 3468, 3427, 3431, 3453, 3443, 3432, 2685, 3614, 2790, 3991, 4071,
+		3657, 1693, 3433, 3392, 3396, 3419, 3454, 3435, 2737, 3711, 2839, 4083, 4142,
+		3647, 3609, 1692, 3357, 3361, 3385, 3421, 3448, 2787, 3715, 2887, 4088, 4147,
+		3603, 3564, 3527, 1649, 3283, 3312, 3376, 3409, 2835, 3694, 2910, 4066, 4125,
+		3606, 3567, 3529, 3445, 1665, 3265, 3334, 3368, 2895, 3753, 3001, 4097, 4156,
+		3630, 3592, 3555, 3477, 3425, 1640, 3264, 3309, 2849, 3697, 3120, 4127, 4187,
+		3613, 3625, 3588, 3541, 3494, 3420, 1538, 3283, 3026, 3682, 3157, 4240, 4275,
+		3596, 3599, 3612, 3572, 3526, 3462, 3435, 1410, 3130, 3721, 3257, 4300, 4437,
+		2795, 2848, 2900, 2948, 3010, 2957, 3140, 3244, 1193, 4158, 4750, 5089, 5193,
+		3792, 3896, 3901, 3877, 3939, 3879, 3864, 3907, 4370, 3004, 5906, 5489, 5614,
+		2930, 2980, 3029, 3052, 3145, 3270, 3302, 3401, 5000, 6236, 3528, 5998, 6069,
+		4209, 4309, 4314, 4290, 4321, 4354, 4468, 4534, 5373, 5812, 6362, 4714, 5897,
+		4300, 4378, 4382, 4358, 4390, 4423, 4512, 4680, 5489, 5945, 6443, 6248, 4846,
+	},
+	{ // 54o
+		1719, 3584, 3576, 3567, 3526, 3549, 3566, 3561, 3580, 2688, 2768, 4080, 4135,
+		3765, 1871, 3554, 3546, 3505, 3533, 3596, 3582, 3571, 2888, 2965, 4178, 4233,
+		3756, 3737, 1870, 3511, 3470, 3499, 3563, 3595, 3576, 2938, 3013, 4182, 4237,
+		3747, 3728, 3691, 1871, 3437, 3471, 3535, 3568, 3595, 3001, 3073, 4193, 4248,
+		3702, 3684, 3646, 3609, 1830, 3311, 3472, 3534, 3567, 3073, 3122, 4182, 4237,
+		3727, 3715, 3678, 3647, 3475, 1805, 3403, 3475, 3510, 3029, 3241, 4212, 4268,
+		3740, 3778, 3741, 3711, 3644, 3570, 1739, 3471, 3518, 3215, 3310, 4354, 4384,
+		3729, 3757, 3771, 3741, 3706, 3642, 3638, 1617, 3525, 3341, 3413, 4417, 4554,
+		3743, 3740, 3745, 3765, 3736, 3675, 3683, 3692, 1493, 3502, 3599, 4567, 4704,
+		2792, 3011, 3062, 3128, 3202, 3151, 3343, 3471, 3637, 1415, 4750, 5081, 5184,
+		2899, 3116, 3164, 3227, 3276, 3401, 3468, 3570, 3764, 5000, 3651, 5763, 5811,
+		4301, 4406, 4410, 4421, 4409, 4442, 4587, 4656, 4819, 5359, 6103, 4768, 5727,
+		4364, 4469, 4474, 4485, 4473, 4506, 4625, 4802, 4965, 5475, 6159, 6055, 4892,
+	},
+	{ // 44
+		1867, 5141, 5109, 5077, 5045, 5151, 5163, 5202, 5265, 5200, 6310, 6587, 6605,
+		5403, 1908, 4999, 4967, 4935, 5041, 5163, 5171, 5211, 5254, 6428, 6609, 6627,
+		5368, 5249, 1904, 4864, 4832, 4938, 5061, 5180, 5188, 5230, 6404, 6584, 6602,
+		5333, 5214, 5103, 1902, 4729, 4836, 4959, 5078, 5197, 5207, 6380, 6560, 6578,
+		5298, 5179, 5068, 4956, 1902, 4733, 4857, 4976, 5095, 5216, 6356, 6536, 6554,
+		5413, 5295, 5183, 5072, 4961, 1869, 4739, 4863, 4982, 5103, 6389, 6548, 6566,
+		5424, 5426, 5315, 5205, 5094, 4967, 1886, 4857, 4977, 5099, 6298, 6628, 6627,
+		5467, 5433, 5443, 5332, 5222, 5099, 5095, 1872, 4946, 5068, 6205, 6630, 6706,
+		5534, 5476, 5450, 5460, 5350, 5228, 5223, 5192, 1860, 5034, 6111, 6632, 6709,
+		5466, 5521, 5495, 5470, 5480, 5358, 5354, 5323, 5288, 1852, 6000, 6619, 6696,
+		6692, 6821, 6794, 6767, 6741, 6777, 6676, 6574, 6472, 6349, 5000, 8262, 8432,
+		6938, 6961, 6934, 6907, 6881, 6894, 6978, 6981, 6984, 6971, 8755, 8101, 8319,
+		6958, 6981, 6954, 6927, 6900, 6913, 6978, 7063, 7066, 7052, 8940, 8742, 8106,
+	},
+	{ // 43s
+		1904, 3705, 3697, 3689, 3681, 3695, 3664, 3687, 3710, 3553, 2916, 3000, 4246,
+		3957, 2046, 3677, 3669, 3660, 3680, 3694, 3709, 3702, 3688, 3106, 3188, 4341,
+		3948, 3929, 2046, 3635, 3626, 3646, 3661, 3721, 3706, 3692, 3155, 3234, 4345,
+		3939, 3920, 3883, 2047, 3593, 3619, 3634, 3694, 3725, 3702, 3217, 3292, 4355,
+		3930, 3911, 3874, 3838, 2050, 3596, 3616, 3677, 3708, 3730, 3301, 3373, 4375,
+		3946, 3932, 3896, 3866, 3841, 2017, 3491, 3647, 3706, 3734, 3414, 3464, 4395,
+		3913, 3948, 3912, 3883, 3862, 3733, 1997, 3604, 3678, 3708, 3421, 3621, 4456,
+		3934, 3960, 3973, 3944, 3925, 3894, 3848, 1942, 3718, 3766, 3653, 3745, 4643,
+		3955, 3950, 3954, 3973, 3955, 3954, 3923, 3965, 1836, 3844, 3846, 3918, 4785,
+		3789, 3930, 3934, 3945, 3975, 3979, 3952, 4013, 4096, 1699, 4070, 4174, 5023,
+		3025, 3235, 3285, 3348, 3436, 3554, 3558, 3800, 4002, 4237, 1738, 5000, 5325,
+		3114, 3320, 3368, 3428, 3512, 3606, 3773, 3898, 4078, 4349, 5251, 3861, 5405,
+		4519, 4620, 4624, 4634, 4655, 4677, 4741, 4935, 5085, 5338, 5606, 5689, 4973,
+	},
+	{ // 42s
+		1740, 3579, 3571, 3563, 3555, 3569, 3571, 3549, 3572, 3418, 2767, 3776, 2881,
+		3825, 1882, 3550, 3542, 3534, 3553, 3601, 3570, 3567, 3552, 2956, 3892, 3069,
+		3816, 3797, 1881, 3508, 3500, 3520, 3568, 3583, 3571, 3556, 3006, 3896, 3115,
+		3808, 3788, 3752, 1882, 3467, 3492, 3541, 3556, 3589, 3567, 3068, 3906, 3173,
+		3799, 3780, 3743, 3707, 1885, 3469, 3522, 3539, 3573, 3595, 3152, 3926, 3254,
+		3814, 3801, 3765, 3735, 3709, 1852, 3486, 3509, 3571, 3599, 3265, 3946, 3345,
+		3816, 3850, 3815, 3785, 3765, 3727, 1876, 3515, 3588, 3619, 3411, 4065, 3516,
+		3792, 3818, 3831, 3801, 3783, 3751, 3756, 1863, 3592, 3643, 3477, 4094, 3733,
+		3813, 3811, 3815, 3834, 3816, 3815, 3831, 3835, 1782, 3739, 3775, 4216, 3907,
+		3650, 3792, 3795, 3806, 3836, 3840, 3860, 3886, 3988, 1649, 4020, 4431, 4163,
+		2865, 3073, 3124, 3188, 3276, 3394, 3547, 3615, 3931, 4189, 1568, 4675, 5000,
+		4033, 4155, 4159, 4169, 4190, 4212, 4336, 4368, 4497, 4726, 4917, 3340, 5286,
+		2986, 3193, 3240, 3300, 3385, 3479, 3660, 3891, 4071, 4342, 5251, 5562, 3777,
+	},
+	{ // A3o
+		722, 2521, 2574, 2666, 2796, 3057, 3272, 3609, 3969, 4168, 4445, 4749, 5021,
+		2642, 2905, 5454, 5422, 5390, 5531, 5614, 5617, 5621, 5749, 5786, 7177, 6154,
+		2696, 5789, 2900, 5302, 5270, 5412, 5494, 5617, 5589, 5717, 5754, 7141, 6122,
+		2793, 5754, 5624, 2902, 5151, 5293, 5375, 5498, 5589, 5686, 5722, 7105, 6090,
+		2930, 5719, 5590, 5461, 2912, 5174, 5257, 5380, 5471, 5687, 5691, 7070, 6060,
+		3210, 5870, 5740, 5611, 5483, 2993, 5183, 5306, 5398, 5613, 5738, 7081, 6074,
+		3438, 5959, 5829, 5701, 5572, 5494, 2978, 5147, 5239, 5459, 5583, 7063, 6035,
+		3799, 5961, 5961, 5832, 5704, 5626, 5455, 3032, 5203, 5423, 5547, 6954, 6097,
+		4186, 5965, 5930, 5931, 5803, 5725, 5554, 5514, 3049, 5339, 5463, 6811, 6014,
+		4392, 6099, 6063, 6029, 6030, 5952, 5786, 5746, 5657, 2996, 5379, 6845, 5929,
+		4691, 6138, 6103, 6069, 6035, 6086, 5920, 5880, 5791, 5699, 3062, 6886, 5967,
+		5000, 7604, 7564, 7526, 7488, 7500, 7480, 7362, 7209, 7239, 7284, 3359, 7151,
+		5305, 6541, 6506, 6472, 6438, 6454, 6412, 6478, 6389, 6298, 6339, 7589, 4685,
+	},
+	{ // K3o
+		1114, 2203, 3305, 3297, 3288, 3431, 3398, 3399, 3405, 3285, 3294, 2295, 3658,
+		2276, 581, 2433, 2524, 2642, 2887, 3164, 3481, 3825, 4152, 4429, 4749, 5028,
+		3476, 2545, 2740, 5254, 5222, 5364, 5461, 5586, 5586, 5620, 5657, 7092, 6044,
+		3467, 2640, 5575, 2742, 5103, 5245, 5342, 5467, 5586, 5589, 5626, 7057, 6012,
+		3458, 2765, 5541, 5412, 2751, 5126, 5224, 5349, 5468, 5590, 5595, 7022, 5982,
+		3611, 3026, 5691, 5563, 5434, 2831, 5150, 5242, 5362, 5484, 5608, 6995, 5963,
+		3577, 3323, 5795, 5666, 5538, 5460, 2871, 5123, 5246, 5368, 5493, 7017, 5963,
+		3577, 3661, 5929, 5800, 5672, 5558, 5431, 2918, 5178, 5300, 5424, 6871, 5993,
+		3582, 4030, 5927, 5928, 5800, 5686, 5563, 5488, 2947, 5242, 5367, 6763, 5917,
+		3452, 4380, 5964, 5929, 5930, 5816, 5694, 5619, 5556, 2976, 5278, 6638, 5808,
+		3462, 4679, 6003, 5969, 5935, 5951, 5828, 5753, 5691, 5594, 3039, 6680, 5845,
+		2396, 5000, 7514, 7476, 7438, 7410, 7434, 7276, 7159, 7023, 7068, 3229, 6943,
+		3862, 5317, 6426, 6391, 6357, 6338, 6340, 6370, 6289, 6171, 6212, 7371, 4624,
+	},
+	{ // Q3o
+		1158, 3011, 2219, 3305, 3297, 3440, 3403, 3440, 3438, 3318, 3326, 2331, 3691,
+		3156, 1159, 2278, 3227, 3219, 3361, 3439, 3448, 3421, 3394, 3403, 2380, 3785,
+		2293, 2355, 608, 2511, 2626, 2858, 3111, 3501, 3826, 4152, 4429, 4749, 5028,
+		3475, 3393, 2625, 2730, 5109, 5250, 5347, 5487, 5608, 5611, 5648, 7043, 6034,
+		3466, 3383, 2747, 5419, 2739, 5131, 5229, 5369, 5490, 5612, 5617, 7009, 6003,
+		3619, 3536, 2995, 5569, 5441, 2818, 5122, 5262, 5378, 5501, 5625, 6977, 5979,
+		3581, 3619, 3266, 5672, 5544, 5430, 2857, 5143, 5235, 5357, 5482, 6966, 5952,
+		3621, 3630, 3683, 5821, 5692, 5578, 5451, 2959, 5206, 5329, 5453, 6860, 6022,
+		3617, 3599, 4030, 5951, 5823, 5703, 5550, 5519, 2979, 5239, 5363, 6716, 5914,
+		3487, 3569, 4380, 5952, 5953, 5834, 5681, 5650, 5552, 3006, 5274, 6591, 5804,
+		3497, 3578, 4679, 5992, 5958, 5968, 5815, 5784, 5686, 5590, 3066, 6632, 5841,
+		2436, 2486, 5000, 7463, 7425, 7391, 7381, 7267, 7110, 6974, 7019, 3252, 6892,
+		3897, 3996, 5317, 6414, 6380, 6355, 6327, 6401, 6285, 6167, 6207, 7318, 4643,
+	},
+	{ // J3o
+		1202, 3043, 3014, 2235, 3306, 3448, 3411, 3444, 3478, 3350, 3358, 2367, 3723,
+		3191, 1203, 2954, 2294, 3227, 3369, 3447, 3452, 3462, 3426, 3435, 2416, 3817,
+		3158, 3094, 1173, 2314, 3121, 3263, 3341, 3461, 3442, 3407, 3415, 2432, 3797,
+		2311, 2373, 2391, 639, 2613, 2840, 3077, 3441, 3846, 4152, 4429, 4749, 5028,
+		3474, 3391, 3277, 2733, 2726, 5129, 5226, 5366, 5502, 5627, 5631, 6980, 6018,
+		3626, 3544, 3430, 2975, 5439, 2804, 5115, 5255, 5392, 5516, 5640, 6950, 5995,
+		3588, 3627, 3513, 3228, 5542, 5424, 2842, 5108, 5249, 5368, 5492, 6935, 5963,
+		3624, 3633, 3640, 3618, 5690, 5572, 5415, 2943, 5220, 5311, 5435, 6797, 6004,
+		3661, 3643, 3620, 4052, 5835, 5716, 5564, 5532, 3017, 5260, 5385, 6692, 5935,
+		3522, 3603, 3580, 4380, 5968, 5849, 5691, 5630, 5576, 3036, 5264, 6531, 5793,
+		3532, 3613, 3590, 4679, 5973, 5984, 5825, 5764, 5710, 5579, 3093, 6572, 5831,
+		2474, 2524, 2537, 5000, 7395, 7364, 7348, 7200, 7087, 6912, 6957, 3275, 6828,
+		3931, 4030, 4008, 5317, 6395, 6371, 6337, 6381, 6309, 6156, 6196, 7252, 4663,
+	},
+	{ // T3o
+		1246, 3076, 3047, 3018, 2250, 3455, 3419, 3452, 3482, 3390, 3390, 2402, 3755,
+		3226, 1246, 2986, 2957, 2310, 3378, 3455, 3460, 3465, 3466, 3466, 2451, 3848,
+		3193, 3129, 1217, 2869, 2330, 3272, 3349, 3468, 3446, 3447, 3447, 2467, 3829,
+		3160, 3096, 3001, 1188, 2348, 3173, 3250, 3370, 3461, 3435, 3435, 2498, 3817,
+		2328, 2390, 2409, 2425, 674, 2827, 3056, 3398, 3780, 4172, 4429, 4749, 5028,
+		3633, 3551, 3437, 3332, 2960, 2791, 5102, 5242, 5379, 5518, 5644, 6897, 5999,
+		3595, 3634, 3520, 3414, 3205, 5411, 2827, 5091, 5231, 5370, 5496, 6884, 5967,
+		3631, 3640, 3647, 3541, 3571, 5559, 5397, 2926, 5175, 5314, 5435, 6741, 6003,
+		3663, 3646, 3623, 3638, 3981, 5703, 5546, 5484, 2999, 5263, 5356, 6604, 5907,
+		3565, 3646, 3624, 3609, 4402, 5850, 5693, 5632, 5578, 3071, 5275, 6482, 5804,
+		3566, 3647, 3624, 3609, 4679, 5987, 5829, 5762, 5679, 5591, 3119, 6488, 5810,
+		2512, 2562, 2575, 2605, 5000, 7309, 7295, 7141, 6994, 6863, 6868, 3298, 6740,
+		3965, 4064, 4041, 4027, 5317, 6374, 6341, 6380, 6277, 6168, 6173, 7160, 4682,
+	},
+	{ // 93o
+		1224, 3099, 3103, 3079, 3050, 2280, 3379, 3412, 3441, 3345, 3382, 2390, 3738,
+		3254, 1267, 3072, 3048, 3019, 2340, 3448, 3453, 3458, 3455, 3491, 2476, 3865,
+		3256, 3223, 1243, 2964, 2935, 2362, 3347, 3466, 3444, 3441, 3477, 2497, 3851,
+		3229, 3196, 3104, 1214, 2847, 2396, 3248, 3367, 3458, 3428, 3464, 2525, 3838,
+		3196, 3163, 3071, 2976, 1188, 2438, 3160, 3279, 3371, 3454, 3463, 2581, 3836,
+		2360, 2424, 2444, 2477, 2519, 688, 3043, 3375, 3726, 4104, 4449, 4749, 5028,
+		3552, 3628, 3519, 3412, 3317, 3191, 2767, 5045, 5185, 5324, 5466, 6785, 5938,
+		3588, 3632, 3645, 3538, 3444, 3546, 5349, 2864, 5124, 5264, 5405, 6645, 5975,
+		3620, 3638, 3621, 3635, 3541, 3922, 5497, 5431, 2934, 5185, 5326, 6502, 5874,
+		3517, 3635, 3617, 3602, 3628, 4328, 5644, 5579, 5495, 3004, 5245, 6349, 5744,
+		3558, 3676, 3658, 3643, 3639, 4701, 5795, 5729, 5646, 5558, 3106, 6394, 5788,
+		2500, 2590, 2609, 2636, 2691, 5000, 7191, 7040, 6886, 6721, 6770, 3276, 6605,
+		3949, 4084, 4067, 4051, 4048, 5317, 6309, 6349, 6241, 6101, 6151, 7019, 4659,
+	},
+	{ // 83o
+		1224, 3097, 3101, 3077, 3048, 3073, 2259, 3389, 3414, 3318, 3354, 2408, 3736,
+		3252, 1210, 3050, 3054, 3030, 3055, 2331, 3388, 3389, 3386, 3422, 2452, 3821,
+		3254, 3202, 1222, 2995, 2971, 2997, 2355, 3431, 3402, 3399, 3435, 2505, 3834,
+		3227, 3204, 3139, 1200, 2887, 2919, 2389, 3336, 3422, 3391, 3428, 2539, 3827,
+		3194, 3177, 3112, 3021, 1174, 2843, 2445, 3247, 3333, 3417, 3425, 2592, 3824,
+		3219, 3203, 3139, 3054, 2972, 1153, 2530, 3192, 3278, 3361, 3480, 2693, 3855,
+		2338, 2415, 2437, 2471, 2527, 2615, 667, 3321, 3692, 4029, 4358, 4749, 5008,
+		3564, 3564, 3608, 3506, 3411, 3352, 3488, 2792, 5025, 5164, 5305, 6476, 5888,
+		3590, 3564, 3577, 3597, 3501, 3442, 3885, 5326, 2855, 5077, 5219, 6336, 5784,
+		3488, 3561, 3574, 3564, 3589, 3530, 4247, 5473, 5381, 2922, 5108, 6183, 5648,
+		3529, 3602, 3615, 3605, 3600, 3658, 4603, 5624, 5532, 5413, 3022, 6227, 5664,
+		2520, 2566, 2619, 2652, 2705, 2809, 5000, 6861, 6710, 6545, 6594, 3242, 6440,
+		3946, 4037, 4050, 4040, 4036, 4068, 5294, 6255, 6144, 5999, 6016, 6844, 4607,
+	},
+	{ // 73o
+		1356, 3222, 3181, 3185, 3161, 3186, 3155, 2373, 3498, 3402, 3435, 2518, 3857,
+		3385, 1386, 3175, 3179, 3155, 3181, 3195, 2461, 3506, 3503, 3535, 2599, 3975,
+		3341, 3335, 1341, 3100, 3104, 3135, 3150, 2496, 3477, 3474, 3507, 2610, 3947,
+		3343, 3338, 3253, 1354, 3046, 3083, 3098, 2532, 3526, 3494, 3527, 2676, 3967,
+		3316, 3310, 3256, 3192, 1334, 3010, 3030, 2591, 3442, 3525, 3530, 2734, 3970,
+		3341, 3336, 3288, 3230, 3151, 1313, 2985, 2690, 3386, 3469, 3587, 2833, 4000,
+		3307, 3353, 3305, 3247, 3173, 3124, 1294, 2667, 3364, 3446, 3565, 3001, 4065,
+		2460, 2554, 2589, 2624, 2684, 2786, 2758, 835, 3709, 4045, 4373, 4749, 5098,
+		3676, 3685, 3654, 3706, 3615, 3555, 3531, 3899, 2858, 5021, 5162, 6246, 5752,
+		3574, 3682, 3651, 3671, 3702, 3642, 3618, 4260, 5316, 2923, 5048, 6090, 5617,
+		3610, 3719, 3688, 3708, 3709, 3770, 3746, 4615, 5466, 5344, 3019, 6102, 5632,
+		2638, 2724, 2733, 2800, 2859, 2960, 3139, 5000, 6611, 6443, 6457, 3339, 6371,
+		4077, 4203, 4172, 4193, 4194, 4225, 4295, 5393, 6104, 5960, 5977, 6773, 4728,
+	},
+	{ // 63o
+		1518, 3344, 3335, 3294, 3298, 3328, 3297, 3289, 2523, 3490, 3522, 2660, 3944,
+		3516, 1517, 3300, 3259, 3263, 3294, 3308, 3292, 2575, 3586, 3619, 2709, 4015,
+		3506, 3468, 1517, 3225, 3229, 3260, 3275, 3305, 2625, 3591, 3623, 2756, 4020,
+		3462, 3423, 3386, 1473, 3150, 3215, 3231, 3266, 2673, 3569, 3601, 2780, 3998,
+		3465, 3426, 3389, 3305, 1489, 3168, 3188, 3225, 2734, 3628, 3632, 2870, 4029,
+		3496, 3458, 3421, 3373, 3321, 1474, 3144, 3188, 2838, 3577, 3695, 2974, 4064,
+		3462, 3474, 3437, 3390, 3343, 3295, 1454, 3151, 2832, 3554, 3672, 3141, 4128,
+		3448, 3451, 3465, 3424, 3378, 3338, 3297, 1353, 3050, 3593, 3712, 3241, 4291,
+		2622, 2675, 2727, 2776, 2837, 2946, 2936, 3163, 1007, 4062, 4389, 4749, 5091,
+		3663, 3767, 3771, 3748, 3810, 3755, 3730, 3775, 4273, 2922, 4901, 5912, 5491,
+		3699, 3804, 3808, 3784, 3816, 3882, 3858, 3902, 4627, 5181, 3016, 5922, 5503,
+		2791, 2841, 2890, 2913, 3006, 3114, 3290, 3389, 5000, 6248, 6259, 3437, 6041,
+		4166, 4243, 4247, 4223, 4254, 4291, 4360, 4529, 5381, 5818, 5832, 6414, 4725,
+	},
+	{ // 53o
+		1543, 3452, 3443, 3435, 3394, 3452, 3420, 3418, 3438, 2526, 3615, 2638, 4008,
+		3624, 1695, 3421, 3413, 3372, 3435, 3450, 3439, 3429, 2726, 3734, 2835, 4106,
+		3615, 3597, 1694, 3379, 3338, 3402, 3417, 3452, 3433, 2776, 3738, 2883, 4110,
+		3606, 3587, 3550, 1695, 3304, 3374, 3390, 3425, 3452, 2840, 3749, 2943, 4121,
+		3561, 3543, 3505, 3469, 1654, 3306, 3327, 3392, 3424, 2912, 3738, 2991, 4110,
+		3623, 3611, 3573, 3543, 3470, 1675, 3308, 3380, 3416, 3018, 3830, 3127, 4173,
+		3589, 3627, 3590, 3560, 3493, 3472, 1655, 3339, 3389, 3020, 3807, 3293, 4237,
+		3581, 3609, 3623, 3593, 3558, 3544, 3500, 1560, 3414, 3261, 3850, 3396, 4408,
+		3595, 3593, 3597, 3617, 3588, 3577, 3549, 3577, 1440, 3442, 3979, 3583, 4558,
+		2619, 2837, 2889, 2955, 3030, 3140, 3139, 3390, 3578, 1230, 4408, 4749, 5084,
+		3795, 3922, 3926, 3937, 3925, 4023, 3999, 4047, 4188, 4641, 3029, 5651, 5274,
+		2761, 2977, 3026, 3088, 3137, 3279, 3455, 3557, 3752, 5000, 5962, 3555, 5741,
+		4229, 4334, 4339, 4350, 4338, 4404, 4474, 4651, 4814, 5367, 5577, 6084, 4770,
+	},
+	{ // 43o
+		1499, 3414, 3406, 3398, 3389, 3402, 3371, 3396, 3421, 3259, 2510, 2596, 3970,
+		3585, 1651, 3384, 3376, 3368, 3386, 3401, 3418, 3412, 3398, 2709, 2794, 4068,
+		3575, 3557, 1650, 3342, 3333, 3352, 3367, 3431, 3417, 3403, 2760, 2841, 4072,
+		3566, 3548, 3510, 1651, 3300, 3325, 3340, 3404, 3436, 3413, 2824, 2901, 4083,
+		3557, 3538, 3501, 3464, 1654, 3302, 3322, 3387, 3420, 3443, 2912, 2986, 4105,
+		3571, 3559, 3522, 3491, 3466, 1619, 3191, 3356, 3419, 3448, 3029, 3080, 4126,
+		3537, 3575, 3538, 3508, 3488, 3348, 1599, 3314, 3392, 3425, 3033, 3247, 4190,
+		3559, 3588, 3601, 3572, 3553, 3520, 3476, 1539, 3438, 3489, 3284, 3382, 4390,
+		3579, 3577, 3581, 3601, 3583, 3583, 3555, 3604, 1425, 3576, 3496, 3571, 4543,
+		3407, 3554, 3559, 3570, 3602, 3608, 3583, 3653, 3749, 1278, 3743, 3853, 4800,
+		2601, 2820, 2872, 2938, 3030, 3153, 3154, 3417, 3638, 3897, 1245, 4749, 5083,
+		2716, 2932, 2981, 3043, 3132, 3230, 3406, 3543, 3741, 4038, 5000, 3498, 5182,
+		4189, 4294, 4298, 4309, 4332, 4355, 4424, 4633, 4800, 5079, 5367, 5477, 4717,
+	},
+	{ // 33
+		1823, 5079, 5047, 5015, 4983, 5098, 5057, 5101, 5163, 5099, 5131, 6260, 6535,
+		5338, 1863, 4940, 4908, 4875, 4991, 5060, 5072, 5112, 5155, 5187, 6381, 6560,
+		5302, 5187, 1860, 4808, 4775, 4892, 4961, 5084, 5091, 5134, 5166, 6359, 6539,
+		5267, 5152, 5043, 1858, 4675, 4792, 4862, 4985, 5103, 5114, 5146, 6338, 6518,
+		5232, 5117, 5008, 4899, 1858, 4692, 4762, 4886, 5005, 5126, 5126, 6318, 6497,
+		5357, 5242, 5134, 5026, 4917, 1866, 4700, 4824, 4943, 5065, 5176, 6339, 6517,
+		5312, 5317, 5209, 5101, 4993, 4926, 1838, 4703, 4827, 4949, 5060, 6370, 6528,
+		5359, 5329, 5341, 5233, 5126, 5059, 4929, 1861, 4825, 4947, 5059, 6282, 6611,
+		5426, 5371, 5348, 5361, 5253, 5187, 5062, 5061, 1855, 4919, 5031, 6193, 6616,
+		5358, 5416, 5393, 5371, 5384, 5318, 5193, 5192, 5164, 1848, 4980, 6086, 6606,
+		5394, 5452, 5429, 5406, 5384, 5438, 5314, 5313, 5286, 5232, 1899, 6139, 6660,
+		6641, 6771, 6748, 6725, 6702, 6724, 6758, 6661, 6563, 6445, 6502, 5000, 8413,
+		6884, 6911, 6887, 6863, 6840, 6862, 6873, 6962, 6968, 6958, 7016, 8920, 8043,
+	},
+	{ // 32s
+		1699, 3543, 3535, 3527, 3519, 3564, 3525, 3502, 3553, 3402, 3410, 2752, 2843,
+		3788, 1841, 3515, 3506, 3498, 3548, 3555, 3524, 3547, 3537, 3545, 2941, 3030,
+		3779, 3760, 1841, 3473, 3464, 3515, 3522, 3536, 3551, 3541, 3549, 2991, 3076,
+		3770, 3751, 3714, 1841, 3431, 3487, 3495, 3509, 3569, 3551, 3559, 3053, 3134,
+		3761, 3742, 3705, 3669, 1844, 3464, 3476, 3492, 3552, 3580, 3580, 3137, 3215,
+		3809, 3795, 3759, 3729, 3704, 1852, 3481, 3503, 3566, 3594, 3639, 3265, 3339,
+		3768, 3803, 3767, 3737, 3717, 3722, 1824, 3405, 3567, 3629, 3674, 3422, 3474,
+		3744, 3770, 3783, 3754, 3735, 3746, 3645, 1811, 3571, 3653, 3703, 3489, 3691,
+		3794, 3791, 3795, 3814, 3796, 3810, 3811, 3816, 1763, 3768, 3844, 3800, 3893,
+		3635, 3777, 3780, 3791, 3821, 3835, 3873, 3899, 4020, 1636, 4004, 4083, 4153,
+		3644, 3786, 3790, 3800, 3821, 3884, 3922, 3953, 4103, 4273, 1681, 4595, 4714,
+		2849, 3057, 3108, 3172, 3260, 3395, 3560, 3629, 3959, 4259, 4818, 1587, 5000,
+		2945, 3151, 3198, 3258, 3343, 3473, 3614, 3846, 4058, 4333, 4946, 5252, 3721,
+	},
+	{ // A2o
+		678, 2474, 2527, 2620, 2750, 3012, 3263, 3559, 3920, 4155, 4415, 4447, 4749,
+		2592, 2865, 5444, 5412, 5380, 5522, 5613, 5574, 5578, 5711, 5747, 5784, 7192,
+		2646, 5779, 2860, 5292, 5261, 5402, 5493, 5574, 5546, 5679, 5715, 5752, 7156,
+		2743, 5744, 5614, 2862, 5142, 5283, 5374, 5455, 5547, 5647, 5684, 5720, 7120,
+		2881, 5709, 5580, 5451, 2872, 5165, 5256, 5337, 5428, 5648, 5653, 5690, 7085,
+		3161, 5860, 5730, 5601, 5473, 2953, 5182, 5264, 5355, 5575, 5699, 5704, 7095,
+		3429, 5958, 5829, 5700, 5572, 5494, 2977, 5146, 5238, 5458, 5582, 5706, 7062,
+		3746, 5916, 5916, 5788, 5659, 5581, 5454, 2975, 5089, 5336, 5460, 5584, 7081,
+		4134, 5921, 5886, 5886, 5758, 5680, 5553, 5395, 2992, 5253, 5377, 5501, 6938,
+		4380, 6058, 6023, 5989, 5990, 5912, 5785, 5654, 5565, 2976, 5318, 5443, 6972,
+		4661, 6098, 6063, 6029, 5995, 6046, 5919, 5789, 5700, 5636, 3042, 5481, 7014,
+		4695, 6138, 6103, 6069, 6035, 6051, 6054, 5923, 5834, 5771, 5811, 3116, 7055,
+		5000, 7621, 7581, 7543, 7505, 7517, 7480, 7500, 7348, 7378, 7423, 7468, 3418,
+	},
+	{ // K2o
+		1070, 2153, 3267, 3258, 3250, 3393, 3393, 3352, 3387, 3272, 3280, 3288, 2278,
+		2223, 537, 2386, 2477, 2596, 2841, 3155, 3431, 3808, 4137, 4399, 4432, 4749,
+		3435, 2495, 2699, 5244, 5213, 5354, 5460, 5543, 5547, 5582, 5619, 5656, 7107,
+		3426, 2590, 5566, 2702, 5094, 5235, 5341, 5424, 5548, 5550, 5587, 5624, 7072,
+		3417, 2716, 5531, 5402, 2711, 5116, 5223, 5306, 5430, 5552, 5556, 5594, 7037,
+		3570, 2978, 5681, 5553, 5424, 2791, 5149, 5200, 5323, 5445, 5570, 5575, 7010,
+		3572, 3314, 5794, 5666, 5537, 5459, 2871, 5122, 5245, 5368, 5492, 5617, 7016,
+		3527, 3609, 5884, 5756, 5627, 5513, 5430, 2862, 5087, 5213, 5338, 5462, 6999,
+		3564, 4013, 5887, 5888, 5759, 5646, 5563, 5392, 2921, 5178, 5302, 5427, 6890,
+		3439, 4366, 5923, 5889, 5890, 5776, 5693, 5527, 5488, 2956, 5218, 5342, 6765,
+		3449, 4648, 5963, 5929, 5895, 5911, 5828, 5662, 5622, 5531, 3019, 5380, 6807,
+		3459, 4683, 6004, 5970, 5936, 5916, 5963, 5797, 5757, 5666, 5706, 3089, 6849,
+		2379, 5000, 7532, 7493, 7455, 7427, 7434, 7415, 7297, 7162, 7207, 7252, 3285,
+	},
+	{ // Q2o
+		1114, 2973, 2169, 3267, 3259, 3401, 3398, 3393, 3419, 3304, 3313, 3321, 2314,
+		3115, 1115, 2228, 3189, 3180, 3323, 3433, 3401, 3402, 3380, 3388, 3397, 2363,
+		2240, 2303, 564, 2465, 2580, 2812, 3102, 3451, 3808, 4137, 4399, 4432, 4749,
+		3434, 3352, 2576, 2690, 5099, 5240, 5346, 5444, 5570, 5572, 5609, 5646, 7058,
+		3425, 3342, 2698, 5409, 2699, 5121, 5228, 5326, 5452, 5574, 5578, 5615, 7023,
+		3578, 3496, 2946, 5559, 5431, 2778, 5122, 5220, 5340, 5462, 5586, 5591, 6992,
+		3575, 3614, 3257, 5672, 5544, 5430, 2856, 5142, 5234, 5357, 5481, 5606, 6966,
+		3572, 3580, 3631, 5776, 5648, 5534, 5451, 2902, 5115, 5242, 5366, 5491, 6988,
+		3599, 3580, 4013, 5911, 5782, 5663, 5550, 5423, 2954, 5174, 5298, 5423, 6843,
+		3474, 3555, 4366, 5912, 5913, 5794, 5680, 5558, 5484, 2986, 5214, 5339, 6718,
+		3484, 3565, 4648, 5952, 5918, 5928, 5815, 5693, 5618, 5526, 3046, 5376, 6760,
+		3494, 3574, 4683, 5992, 5959, 5933, 5950, 5828, 5753, 5661, 5702, 3113, 6802,
+		2419, 2468, 5000, 7480, 7442, 7408, 7381, 7406, 7249, 7113, 7158, 7204, 3305,
+	},
+	{ // J2o
+		1158, 3005, 2976, 2185, 3268, 3410, 3406, 3397, 3460, 3336, 3345, 3353, 2350,
+		3150, 1158, 2916, 2244, 3189, 3331, 3442, 3405, 3442, 3412, 3421, 3429, 2399,
+		3117, 3053, 1129, 2265, 3083, 3225, 3336, 3413, 3423, 3393, 3401, 3409, 2415,
+		2257, 2320, 2339, 595, 2567, 2794, 3068, 3391, 3828, 4137, 4399, 4432, 4749,
+		3433, 3351, 3237, 2684, 2686, 5119, 5225, 5323, 5464, 5588, 5593, 5630, 6995,
+		3586, 3503, 3389, 2926, 5429, 2764, 5115, 5213, 5353, 5478, 5602, 5607, 6965,
+		3583, 3621, 3507, 3219, 5542, 5423, 2842, 5107, 5248, 5367, 5491, 5616, 6934,
+		3575, 3583, 3590, 3565, 5646, 5527, 5414, 2886, 5129, 5224, 5348, 5473, 6925,
+		3643, 3624, 3601, 4035, 5795, 5676, 5563, 5437, 2992, 5196, 5320, 5445, 6819,
+		3509, 3590, 3567, 4366, 5928, 5809, 5690, 5538, 5508, 3016, 5203, 5328, 6658,
+		3519, 3599, 3576, 4648, 5933, 5944, 5825, 5672, 5642, 5515, 3073, 5366, 6700,
+		3528, 3609, 3586, 4683, 5973, 5949, 5960, 5807, 5777, 5650, 5691, 3137, 6742,
+		2457, 2507, 2520, 5000, 7412, 7381, 7348, 7338, 7225, 7051, 7095, 7141, 3324,
+	},
+	{ // T2o
+		1201, 3038, 3009, 2980, 2201, 3417, 3413, 3405, 3463, 3376, 3376, 3385, 2385,
+		3185, 1202, 2948, 2919, 2260, 3339, 3449, 3413, 3446, 3452, 3452, 3460, 2434,
+		3152, 3088, 1172, 2831, 2281, 3233, 3343, 3421, 3426, 3432, 3432, 3441, 2450,
+		3119, 3055, 2960, 1144, 2300, 3135, 3245, 3322, 3442, 3420, 3420, 3429, 2481,
+		2275, 2337, 2356, 2373, 630, 2781, 3048, 3348, 3763, 4158, 4399, 4432, 4749,
+		3593, 3511, 3397, 3291, 2912, 2751, 5102, 5200, 5340, 5479, 5605, 5611, 6912,
+		3590, 3628, 3514, 3408, 3197, 5410, 2827, 5090, 5230, 5370, 5496, 5620, 6883,
+		3582, 3590, 3597, 3491, 3518, 5514, 5396, 2869, 5084, 5227, 5348, 5473, 6869,
+		3646, 3626, 3603, 3618, 3964, 5663, 5545, 5389, 2973, 5199, 5292, 5417, 6731,
+		3552, 3633, 3610, 3595, 4388, 5810, 5692, 5540, 5510, 3051, 5214, 5339, 6610,
+		3552, 3633, 3610, 3595, 4648, 5947, 5829, 5671, 5610, 5527, 3100, 5345, 6615,
+		3562, 3643, 3620, 3605, 4683, 5952, 5964, 5806, 5746, 5662, 5668, 3160, 6657,
+		2495, 2545, 2558, 2588, 5000, 7326, 7295, 7280, 7132, 7001, 7007, 7052, 3344,
+	},
+	{ // 92o
+		1180, 3061, 3065, 3041, 3011, 2230, 3373, 3364, 3423, 3332, 3368, 3368, 2373,
+		3213, 1222, 3034, 3010, 2981, 2291, 3443, 3405, 3439, 3441, 3477, 3477, 2459,
+		3215, 3183, 1198, 2926, 2896, 2313, 3342, 3419, 3424, 3426, 3463, 3463, 2480,
+		3188, 3155, 3063, 1170, 2809, 2347, 3242, 3319, 3439, 3414, 3450, 3450, 2508,
+		3155, 3122, 3030, 2936, 1144, 2390, 3155, 3232, 3351, 3440, 3449, 3449, 2564,
+		2307, 2371, 2392, 2425, 2468, 644, 3035, 3325, 3708, 4090, 4419, 4432, 4749,
+		3546, 3622, 3513, 3406, 3312, 3182, 2766, 5044, 5184, 5324, 5465, 5592, 6784,
+		3538, 3583, 3595, 3489, 3394, 3494, 5348, 2807, 5033, 5177, 5318, 5445, 6772,
+		3602, 3619, 3601, 3616, 3521, 3904, 5497, 5336, 2909, 5121, 5262, 5384, 6630,
+		3504, 3621, 3604, 3588, 3615, 4314, 5644, 5487, 5427, 2984, 5185, 5278, 6476,
+		3545, 3662, 3645, 3629, 3626, 4670, 5795, 5638, 5577, 5494, 3087, 5323, 6521,
+		3546, 3662, 3645, 3629, 3626, 4683, 5932, 5775, 5709, 5596, 5645, 3138, 6527,
+		2483, 2573, 2592, 2619, 2674, 5000, 7191, 7178, 7025, 6860, 6909, 6915, 3318,
+	},
+	{ // 82o
+		1224, 3091, 3095, 3071, 3042, 3067, 2246, 3371, 3429, 3338, 3371, 3407, 2407,
+		3246, 1210, 3044, 3048, 3024, 3049, 2318, 3370, 3403, 3405, 3438, 3474, 2451,
+		3249, 3196, 1222, 2989, 2965, 2991, 2342, 3412, 3417, 3419, 3451, 3487, 2504,
+		3221, 3199, 3134, 1199, 2882, 2913, 2377, 3318, 3437, 3411, 3443, 3480, 2538,
+		3188, 3171, 3106, 3015, 1173, 2838, 2433, 3229, 3348, 3436, 3441, 3477, 2591,
+		3214, 3197, 3133, 3048, 2966, 1152, 2519, 3173, 3292, 3381, 3500, 3508, 2692,
+		2324, 2402, 2424, 2458, 2515, 2603, 666, 3312, 3684, 4021, 4351, 4452, 4749,
+		3544, 3544, 3589, 3487, 3391, 3332, 3479, 2750, 4954, 5097, 5238, 5380, 6626,
+		3608, 3580, 3593, 3613, 3518, 3458, 3878, 5251, 2850, 5037, 5178, 5320, 6486,
+		3510, 3583, 3596, 3585, 3611, 3552, 4240, 5402, 5337, 2923, 5073, 5215, 6327,
+		3547, 3619, 3632, 3622, 3618, 3679, 4596, 5553, 5488, 5375, 3022, 5259, 6340,
+		3588, 3660, 3673, 3663, 3659, 3691, 4706, 5705, 5640, 5526, 5576, 3127, 6386,
+		2520, 2566, 2619, 2652, 2705, 2809, 5000, 7023, 6872, 6701, 6716, 6766, 3297,
+	},
+	{ // 72o
+		1180, 3089, 3048, 3052, 3028, 3053, 3024, 2209, 3370, 3277, 3310, 3346, 2388,
+		3243, 1210, 3042, 3046, 3022, 3047, 3064, 2298, 3380, 3377, 3409, 3446, 2469,
+		3199, 3194, 1165, 2966, 2970, 3001, 3019, 2333, 3352, 3349, 3381, 3417, 2480,
+		3202, 3196, 3112, 1179, 2912, 2949, 2967, 2370, 3401, 3369, 3401, 3437, 2545,
+		3174, 3169, 3114, 3050, 1158, 2876, 2899, 2429, 3317, 3399, 3404, 3440, 2603,
+		3200, 3195, 3146, 3089, 3009, 1138, 2854, 2528, 3260, 3343, 3462, 3470, 2702,
+		3167, 3212, 3164, 3107, 3032, 2983, 1122, 2656, 3238, 3321, 3440, 3559, 2851,
+		2285, 2379, 2415, 2451, 2511, 2614, 2747, 648, 3629, 3990, 4259, 4361, 4749,
+		3543, 3555, 3525, 3576, 3485, 3425, 3402, 3819, 2776, 4880, 5021, 5162, 6252,
+		3444, 3552, 3521, 3541, 3572, 3512, 3489, 4207, 5169, 2841, 4907, 5049, 6096,
+		3481, 3589, 3558, 3578, 3579, 3640, 3617, 4498, 5320, 5198, 2937, 5065, 6109,
+		3522, 3630, 3599, 3619, 3620, 3651, 3745, 4607, 5471, 5349, 5367, 3038, 6154,
+		2500, 2585, 2594, 2662, 2720, 2822, 2977, 5000, 6623, 6454, 6469, 6519, 3259,
+	},
+	{ // 62o
+		1342, 3210, 3202, 3161, 3165, 3195, 3166, 3143, 2359, 3365, 3397, 3433, 2530,
+		3374, 1341, 3167, 3126, 3130, 3160, 3177, 3146, 2411, 3461, 3493, 3525, 2578,
+		3365, 3326, 1341, 3091, 3095, 3126, 3144, 3158, 2463, 3465, 3497, 3530, 2626,
+		3320, 3282, 3244, 1297, 3017, 3082, 3105, 3120, 2511, 3443, 3476, 3508, 2650,
+		3323, 3285, 3247, 3163, 1313, 3034, 3062, 3079, 2572, 3503, 3507, 3539, 2740,
+		3354, 3316, 3279, 3232, 3179, 1298, 3018, 3042, 2677, 3451, 3569, 3574, 2844,
+		3321, 3333, 3297, 3255, 3208, 3160, 1282, 3025, 2821, 3428, 3547, 3665, 2990,
+		3297, 3299, 3313, 3272, 3226, 3186, 3168, 1269, 2855, 3464, 3582, 3701, 3224,
+		2447, 2501, 2554, 2603, 2665, 2774, 2925, 2959, 821, 4008, 4276, 4376, 4749,
+		3533, 3637, 3642, 3618, 3681, 3625, 3601, 3641, 4221, 2841, 4760, 4902, 5918,
+		3570, 3674, 3678, 3654, 3686, 3752, 3728, 3768, 4511, 5035, 2934, 4915, 5929,
+		3611, 3711, 3715, 3691, 3723, 3759, 3856, 3896, 4619, 5186, 5200, 3032, 5942,
+		2652, 2703, 2751, 2775, 2868, 2975, 3128, 3377, 5000, 6260, 6272, 6287, 3351,
+	},
+	{ // 52o
+		1367, 3318, 3310, 3301, 3260, 3318, 3294, 3271, 3291, 2364, 3490, 3522, 2507,
+		3483, 1519, 3288, 3280, 3239, 3302, 3324, 3293, 3285, 2562, 3608, 3641, 2705,
+		3474, 3455, 1519, 3245, 3204, 3268, 3291, 3305, 3290, 2614, 3613, 3645, 2752,
+		3464, 3446, 3408, 1519, 3171, 3241, 3264, 3279, 3309, 2678, 3624, 3656, 2813,
+		3420, 3401, 3364, 3327, 1478, 3173, 3229, 3245, 3281, 2750, 3613, 3645, 2861,
+		3481, 3469, 3432, 3401, 3329, 1499, 3210, 3234, 3272, 2857, 3704, 3708, 2997,
+		3454, 3492, 3455, 3425, 3388, 3367, 1489, 3218, 3268, 3009, 3686, 3804, 3148,
+		3429, 3458, 3471, 3442, 3406, 3392, 3375, 1476, 3281, 3066, 3721, 3839, 3380,
+		3443, 3444, 3448, 3469, 3440, 3429, 3424, 3439, 1382, 3361, 3851, 3969, 3566,
+		2445, 2663, 2716, 2782, 2857, 2968, 3128, 3186, 3497, 1044, 4296, 4394, 4749,
+		3665, 3792, 3796, 3808, 3795, 3893, 3874, 3912, 4055, 4525, 2948, 4662, 5658,
+		3702, 3829, 3833, 3844, 3832, 3899, 4001, 4040, 4182, 4633, 4921, 3042, 5667,
+		2622, 2838, 2887, 2949, 2999, 3140, 3299, 3546, 3740, 5000, 5974, 5985, 3464,
+	},
+	{ // 42o
+		1323, 3281, 3273, 3264, 3256, 3269, 3273, 3250, 3275, 3116, 2347, 3484, 2466,
+		3443, 1475, 3251, 3242, 3234, 3253, 3303, 3271, 3269, 3255, 2546, 3603, 2663,
+		3434, 3415, 1474, 3208, 3200, 3219, 3269, 3284, 3273, 3259, 2597, 3607, 2711,
+		3424, 3406, 3368, 1475, 3166, 3191, 3242, 3257, 3293, 3270, 2661, 3618, 2771,
+		3415, 3397, 3359, 3322, 1478, 3168, 3224, 3241, 3276, 3300, 2750, 3640, 2856,
+		3429, 3417, 3380, 3350, 3324, 1443, 3185, 3209, 3275, 3304, 2868, 3661, 2950,
+		3432, 3470, 3433, 3403, 3383, 3342, 1469, 3219, 3296, 3329, 3022, 3787, 3133,
+		3408, 3436, 3449, 3420, 3402, 3368, 3377, 1455, 3305, 3359, 3090, 3822, 3365,
+		3427, 3428, 3432, 3453, 3435, 3434, 3456, 3466, 1367, 3465, 3415, 3955, 3554,
+		3258, 3406, 3410, 3421, 3454, 3459, 3485, 3518, 3634, 1225, 3685, 4188, 3837,
+		2428, 2646, 2699, 2765, 2857, 2981, 3143, 3213, 3557, 3841, 1060, 4394, 4749,
+		3661, 3788, 3793, 3804, 3827, 3849, 3984, 4023, 4168, 4423, 4633, 2984, 5054,
+		2577, 2793, 2842, 2905, 2993, 3091, 3284, 3531, 3728, 4026, 5000, 5318, 3404,
+	},
+	{ // 32o
+		1279, 3243, 3235, 3226, 3218, 3264, 3223, 3199, 3253, 3099, 3107, 2331, 2424,
+		3402, 1431, 3213, 3205, 3196, 3247, 3252, 3221, 3247, 3238, 3246, 2530, 2621,
+		3393, 3375, 1430, 3170, 3162, 3214, 3219, 3234, 3251, 3242, 3250, 2581, 2668,
+		3384, 3365, 3328, 1431, 3128, 3186, 3192, 3207, 3270, 3253, 3261, 2645, 2729,
+		3375, 3356, 3318, 3282, 1434, 3163, 3174, 3190, 3254, 3283, 3283, 2733, 2814,
+		3424, 3412, 3375, 3344, 3319, 1442, 3180, 3204, 3270, 3299, 3345, 2867, 2944,
+		3380, 3417, 3381, 3351, 3331, 3337, 1412, 3100, 3271, 3337, 3383, 3033, 3085,
+		3355, 3383, 3397, 3367, 3349, 3363, 3253, 1398, 3279, 3367, 3418, 3102, 3317,
+		3406, 3406, 3410, 3431, 3413, 3429, 3431, 3441, 1347, 3492, 3572, 3441, 3539,
+		3241, 3389, 3393, 3405, 3437, 3454, 3495, 3529, 3665, 1210, 3747, 3753, 3825,
+		3251, 3399, 3403, 3414, 3437, 3504, 3546, 3585, 3752, 3945, 1258, 4311, 4438,
+		2411, 2629, 2682, 2748, 2840, 2981, 3156, 3227, 3586, 3916, 4523, 1080, 4748,
+		2532, 2748, 2796, 2859, 2948, 3085, 3234, 3481, 3713, 4015, 4682, 5000, 3342,
+	},
+	{ // 22
+		1778, 5011, 4979, 4946, 4914, 5030, 4998, 4988, 5051, 4990, 5022, 5055, 6204,
+		5265, 1819, 4874, 4842, 4810, 4926, 5004, 4963, 5007, 5049, 5081, 5114, 6326,
+		5230, 5118, 1815, 4745, 4713, 4829, 4908, 4977, 4989, 5032, 5064, 5096, 6308,
+		5195, 5082, 4977, 1813, 4616, 4733, 4812, 4881, 5004, 5015, 5047, 5079, 6291,
+		5160, 5047, 4942, 4837, 1813, 4636, 4716, 4786, 4909, 5030, 5030, 5062, 6274,
+		5285, 5173, 5068, 4963, 4858, 1822, 4657, 4727, 4851, 4972, 5083, 5083, 6298,
+		5250, 5258, 5153, 5049, 4944, 4880, 1835, 4663, 4787, 4909, 5020, 5131, 6317,
+		5240, 5213, 5228, 5124, 5020, 4956, 4887, 1813, 4670, 4797, 4908, 5019, 6353,
+		5307, 5259, 5240, 5256, 5152, 5089, 5020, 4894, 1844, 4798, 4910, 5021, 6269,
+		5244, 5305, 5285, 5266, 5282, 5220, 5151, 5030, 5032, 1843, 4865, 4977, 6166,
+		5279, 5340, 5321, 5302, 5283, 5340, 5272, 5151, 5154, 5108, 1894, 5027, 6223,
+		5315, 5376, 5357, 5337, 5318, 5341, 5393, 5272, 5275, 5230, 5283, 1957, 6279,
+		6582, 6715, 6695, 6676, 6656, 6682, 6703, 6741, 6649, 6536, 6596, 6658, 5000,
+	},
+}
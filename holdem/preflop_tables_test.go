@@ -0,0 +1,243 @@
+package holdem
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"math"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+	"github.com/sdeboni/go-poker/internal/suitclass"
+)
+
+var generate = flag.Bool("generate", false, "regenerate preflop_tables.go")
+
+func TestGeneratePreflopTables(t *testing.T) {
+	if !*generate {
+		t.Skip("run with -generate to regenerate preflop_tables.go")
+	}
+
+	vsRandom, classes := exactPreflopEquities(nil)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by go test -run TestGeneratePreflopTables -generate; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package holdem\n\n")
+	fmt.Fprintf(&buf, "// Equities are exact, over every board and every pair of holdings that do\n")
+	fmt.Fprintf(&buf, "// not share a card.\n\n")
+	fmt.Fprintf(&buf, "const PREFLOP_TABLE_SCALE = 10000\n\n")
+	fmt.Fprintf(&buf, "var preflopEquityVsRandom = [HAND_CLASSES]uint16{")
+	for class, e := range vsRandom {
+		if class%13 == 0 {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(&buf, "%d, ", scaleEquity(e))
+	}
+	fmt.Fprintf(&buf, "\n}\n\n")
+	fmt.Fprintf(&buf, "var preflopClassEquities = [HAND_CLASSES][HAND_CLASSES]uint16{\n")
+	for hero := range classes {
+		fmt.Fprintf(&buf, "{ // %s", HandClass(hero))
+		for villain, e := range classes[hero] {
+			if villain%13 == 0 {
+				buf.WriteString("\n")
+			}
+			fmt.Fprintf(&buf, "%d, ", scaleEquity(e))
+		}
+		fmt.Fprintf(&buf, "\n},\n")
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile("preflop_tables.go", src, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func scaleEquity(e float64) uint16 {
+	return uint16(math.Round(e * PREFLOP_TABLE_SCALE))
+}
+
+// exactPreflopEquities enumerates every board, up to renaming suits, and on
+// each one compares every pair of holdings that share no card with it or
+// each other. Matchups are counted class against class by sorting each
+// class's hand values, then the pairs that share a card are taken out. If
+// boards is not nil, only the first boards classes of boards are counted.
+func exactPreflopEquities(boards *int) ([HAND_CLASSES]float64, *ClassEquities) {
+	type holding struct {
+		cards eval.CardSet
+		class HandClass
+	}
+	var holdings []holding
+	// byCard lists the holdings holding each card.
+	var byCard [card.DECK_SIZE][]int
+	for i := range card.DECK_SIZE {
+		a, _ := card.FromIndex(i)
+		for j := i + 1; j < card.DECK_SIZE; j++ {
+			b, _ := card.FromIndex(j)
+			byCard[i] = append(byCard[i], len(holdings))
+			byCard[j] = append(byCard[j], len(holdings))
+			holdings = append(holdings, holding{eval.NewCardSet(a, b), NewHandClass(a, b)})
+		}
+	}
+
+	// shares counts twice the pots won by the lower class against the
+	// higher, a split counting once, out of total matchups; the diagonal
+	// totals count ordered pairs within a class.
+	var shares, totals [HAND_CLASSES][HAND_CLASSES]int64
+	values := make([]eval.HandValue, len(holdings))
+	var byClass [HAND_CLASSES][]eval.HandValue
+	visited := 0
+	suitclass.ForEach(BOARD_SIZE, card.TWO, func(suitMasks *[card.DIAMONDS + 1]uint16, weight int64) {
+		if boards != nil && visited >= *boards {
+			return
+		}
+		visited++
+		var board eval.CardSet
+		for suit, mask := range suitMasks {
+			for r := card.TWO; r <= card.ACE; r++ {
+				if mask&(1<<r) != 0 {
+					board = board.Add(card.New(r, card.Suit(suit)))
+				}
+			}
+		}
+
+		for c := range byClass {
+			byClass[c] = byClass[c][:0]
+		}
+		for i, h := range holdings {
+			if h.cards&board == 0 {
+				values[i] = (h.cards | board).Evaluate()
+				byClass[h.class] = append(byClass[h.class], values[i])
+			}
+		}
+		for c := range byClass {
+			slices.Sort(byClass[c])
+		}
+
+		for a := range HandClass(HAND_CLASSES) {
+			hero := byClass[a]
+			totals[a][a] += weight * int64(len(hero)*(len(hero)-1))
+			for b := a + 1; b < HAND_CLASSES; b++ {
+				villain := byClass[b]
+				// below and through count the villain's values under and
+				// up to each of the hero's.
+				var share int64
+				below, through := 0, 0
+				for _, v := range hero {
+					for below < len(villain) && villain[below] < v {
+						below++
+					}
+					through = max(through, below)
+					for through < len(villain) && villain[through] == v {
+						through++
+					}
+					share += int64(below + through)
+				}
+				shares[a][b] += weight * share
+				totals[a][b] += weight * int64(len(hero)*len(villain))
+			}
+		}
+
+		for i, held := range byCard {
+			if board&(1<<i) != 0 {
+				continue
+			}
+			for x, h := range held {
+				if holdings[h].cards&board != 0 {
+					continue
+				}
+				for _, v := range held[x+1:] {
+					if holdings[v].cards&board != 0 {
+						continue
+					}
+					a, b := holdings[h].class, holdings[v].class
+					hv, vv := values[h], values[v]
+					if a == b {
+						totals[a][a] -= 2 * weight
+						continue
+					}
+					if a > b {
+						a, b, hv, vv = b, a, vv, hv
+					}
+					totals[a][b] -= weight
+					shares[a][b] -= weight * int64(hv.Compare(vv)+1)
+				}
+			}
+		}
+	})
+
+	var vsRandom [HAND_CLASSES]float64
+	classes := new(ClassEquities)
+	for a := range HAND_CLASSES {
+		classes[a][a] = 0.5
+		won, total := float64(totals[a][a]), 2*float64(totals[a][a])
+		for b := range HAND_CLASSES {
+			switch {
+			case a < b:
+				classes[a][b] = float64(shares[a][b]) / float64(2*totals[a][b])
+				won += float64(shares[a][b])
+				total += float64(2 * totals[a][b])
+			case a > b:
+				classes[a][b] = 1 - float64(shares[b][a])/float64(2*totals[b][a])
+				won += float64(2*totals[b][a] - shares[b][a])
+				total += float64(2 * totals[b][a])
+			}
+		}
+		vsRandom[a] = won / total
+	}
+	return vsRandom, classes
+}
+
+func TestPreflopTables(t *testing.T) {
+	// Exact equities, rounded to the tables' precision. The matchups agree
+	// with Equity enumerating every board for every pair of holdings.
+	const precision = 1.0 / PREFLOP_TABLE_SCALE
+	cases := []struct {
+		class    string
+		expected float64
+	}{
+		{"AA", 0.8520}, {"KK", 0.8240}, {"AKs", 0.6704}, {"72o", 0.3458}, {"32o", 0.3230},
+	}
+	for _, tc := range cases {
+		class, err := ParseHandClass(tc.class)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := PreflopEquity(class); math.Abs(got-tc.expected) > precision {
+			t.Errorf("%s: expected equity against a random hand of %f, got %f", tc.class, tc.expected, got)
+		}
+	}
+
+	matchups := []struct {
+		hero, villain string
+		expected      float64
+	}{
+		{"AA", "KK", 0.819461},
+		{"AA", "AKo", 0.931719},
+		{"AKs", "QJs", 0.635281},
+		{"72o", "32s", 0.615421},
+	}
+	for _, tc := range matchups {
+		hero, _ := ParseHandClass(tc.hero)
+		villain, _ := ParseHandClass(tc.villain)
+		if got := PreflopClassEquity(hero, villain); math.Abs(got-tc.expected) > precision {
+			t.Errorf("%s vs %s: expected %f, got %f", tc.hero, tc.villain, tc.expected, got)
+		}
+	}
+
+	for hero := range HandClass(HAND_CLASSES) {
+		for villain := range HandClass(HAND_CLASSES) {
+			sum := PreflopClassEquity(hero, villain) + PreflopClassEquity(villain, hero)
+			if math.Abs(sum-1) > 2.0/PREFLOP_TABLE_SCALE {
+				t.Fatalf("%s vs %s: equities add up to %f", hero, villain, sum)
+			}
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package holdem
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PushFoldChart is the heads-up push/fold equilibrium at a given effective
+// stack: the small blind either shoves or folds, the big blind calls or folds.
+type PushFoldChart struct {
+	// StackBB is the effective stack in big blinds.
+	StackBB float64
+	// Push and Call hold how often each hand class shoves from the small
+	// blind and calls from the big blind.
+	Push [HAND_CLASSES]float64
+	Call [HAND_CLASSES]float64
+}
+
+// NewPushFoldChart solves the push/fold game by fictitious play, each round
+// best responding to the other player's average range using equities for the
+// hand-vs-range matchups. Ranges are weighted by combos, ignoring card removal.
+func NewPushFoldChart(stackBB float64, equities *ClassEquities, iterations int) (*PushFoldChart, error) {
+	if stackBB < 1 {
+		return nil, fmt.Errorf("invalid stack: %f big blinds", stackBB)
+	}
+	if iterations < 1 {
+		return nil, fmt.Errorf("invalid number of iterations: %d", iterations)
+	}
+
+	chart := &PushFoldChart{StackBB: stackBB}
+	for c := range chart.Push {
+		chart.Push[c], chart.Call[c] = 1, 1
+	}
+
+	for t := 1; t <= iterations; t++ {
+		push := chart.bestPushes(equities)
+		call := chart.bestCalls(equities)
+		for c := range chart.Push {
+			chart.Push[c] += (push[c] - chart.Push[c]) / float64(t+1)
+			chart.Call[c] += (call[c] - chart.Call[c]) / float64(t+1)
+		}
+	}
+	return chart, nil
+}
+
+// bestPushes returns the small blind's best response to the calling range.
+// Shoving wins the blinds when called by nothing, otherwise it risks the stack;
+// folding loses the small blind.
+func (c *PushFoldChart) bestPushes(equities *ClassEquities) [HAND_CLASSES]float64 {
+	var push [HAND_CLASSES]float64
+	for hero := range HandClass(HAND_CLASSES) {
+		var called, showdown, total float64
+		for villain := range HandClass(HAND_CLASSES) {
+			weight := float64(villain.Combos())
+			total += weight
+			weight *= c.Call[villain]
+			called += weight
+			showdown += weight * (2*equities[hero][villain] - 1) * c.StackBB
+		}
+		ev := (total-called)/total*1 + showdown/total
+		if ev > -0.5 {
+			push[hero] = 1
+		}
+	}
+	return push
+}
+
+// bestCalls returns the big blind's best response to the shoving range.
+// Calling risks the stack, folding loses the big blind.
+func (c *PushFoldChart) bestCalls(equities *ClassEquities) [HAND_CLASSES]float64 {
+	var call [HAND_CLASSES]float64
+	for hero := range HandClass(HAND_CLASSES) {
+		var pushed, showdown float64
+		for villain := range HandClass(HAND_CLASSES) {
+			weight := float64(villain.Combos()) * c.Push[villain]
+			pushed += weight
+			showdown += weight * (2*equities[hero][villain] - 1) * c.StackBB
+		}
+		if pushed > 0 && showdown/pushed > -1 {
+			call[hero] = 1
+		}
+	}
+	return call
+}
+
+// String renders the shoving and calling ranges as 13x13 grids, listing the
+// classes played at least half the time.
+func (c *PushFoldChart) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "push/fold at %.1f big blinds\n", c.StackBB)
+	writeGrid(&sb, "small blind shoves", &c.Push)
+	writeGrid(&sb, "big blind calls", &c.Call)
+	return sb.String()
+}
+
+func writeGrid(sb *strings.Builder, title string, freqs *[HAND_CLASSES]float64) {
+	sb.WriteString(title)
+	sb.WriteString(":\n")
+	for class := range HandClass(HAND_CLASSES) {
+		if class%13 != 0 {
+			sb.WriteString(" ")
+		}
+		if freqs[class] >= 0.5 {
+			fmt.Fprintf(sb, "%-3s", class.String())
+		} else {
+			sb.WriteString(" . ")
+		}
+		if class%13 == 12 {
+			sb.WriteString("\n")
+		}
+	}
+}
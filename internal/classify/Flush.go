@@ -0,0 +1,54 @@
+package classify
+
+import (
+	"cmp"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+type Flush struct {
+	str   string
+	cards []card.Card
+}
+
+func NewFlush(hand string, cards []card.Card) eval.Hand {
+	for _, c := range cards[1:] {
+		if c.Suit() != cards[0].Suit() {
+			return nil
+		}
+	}
+
+	return &Flush{hand, cards}
+}
+
+func (f *Flush) Cards() []card.Card {
+	return f.cards
+}
+
+func (*Flush) Rank() eval.HandRank {
+	return eval.FLUSH
+}
+
+func (f *Flush) MainRank() card.Rank {
+	return f.cards[len(f.cards)-1].Rank()
+}
+
+func (f *Flush) String() string {
+	return f.str
+}
+
+func (f *Flush) Compare(h eval.Hand) int {
+	other, ok := h.(*Flush)
+	if !ok {
+		return cmp.Compare(f.Rank(), h.Rank())
+	}
+
+	for i := len(f.cards) - 1; i >= 0; i-- {
+		c := cmp.Compare(f.cards[i].Rank(), other.Cards()[i].Rank())
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
@@ -0,0 +1,81 @@
+package classify
+
+import (
+	"cmp"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+// FourFlush is four cards of one suit, a Sökö hand. Four-flushes compare by
+// their suited cards, highest first, then by the odd card.
+type FourFlush struct {
+	str    string
+	cards  []card.Card
+	suited []card.Card
+	kicker card.Card
+}
+
+func NewFourFlush(hand string, cards []card.Card) eval.Hand {
+	counts := make(map[card.Suit]int)
+	for _, c := range cards {
+		counts[c.Suit()]++
+	}
+
+	for suit, count := range counts {
+		if count != 4 {
+			continue
+		}
+		f := &FourFlush{str: hand, cards: cards}
+		for _, c := range cards {
+			if c.Suit() == suit {
+				f.suited = append(f.suited, c)
+			} else {
+				f.kicker = c
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+func (f *FourFlush) Cards() []card.Card {
+	return f.cards
+}
+
+// Suited are the four cards of one suit.
+func (f *FourFlush) Suited() []card.Card {
+	return f.suited
+}
+
+// Kicker is the odd card.
+func (f *FourFlush) Kicker() card.Card {
+	return f.kicker
+}
+
+func (*FourFlush) Rank() eval.HandRank {
+	return eval.FOUR_FLUSH
+}
+
+func (f *FourFlush) MainRank() card.Rank {
+	return f.suited[len(f.suited)-1].Rank()
+}
+
+func (f *FourFlush) String() string {
+	return f.str
+}
+
+func (f *FourFlush) Compare(h eval.Hand) int {
+	other, ok := h.(*FourFlush)
+	if !ok {
+		return cmp.Compare(f.Rank(), h.Rank())
+	}
+
+	for i := len(f.suited) - 1; i >= 0; i-- {
+		c := cmp.Compare(f.suited[i].Rank(), other.suited[i].Rank())
+		if c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(f.kicker.Rank(), other.kicker.Rank())
+}
@@ -0,0 +1,75 @@
+package classify
+
+import (
+	"cmp"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+type FourOfAKind struct {
+	str        string
+	cards      []card.Card
+	quadRank   card.Rank
+	singleRank card.Rank
+}
+
+func NewFourOfAKind(hand string, cards []card.Card) eval.Hand {
+	counts := make(map[card.Rank]int)
+	for _, c := range cards {
+		if _, ok := counts[c.Rank()]; ok {
+			counts[c.Rank()]++
+		} else {
+			counts[c.Rank()] = 1
+		}
+	}
+
+	var quadRank card.Rank
+	var singleRank card.Rank
+
+	for rank, count := range counts {
+		if count == 4 {
+			quadRank = rank
+		} else if count == 1 {
+			singleRank = rank
+		}
+	}
+
+	if quadRank == 0 || singleRank == 0 {
+		return nil
+	}
+	return &FourOfAKind{hand, cards, quadRank, singleRank}
+}
+
+func (f *FourOfAKind) Cards() []card.Card {
+	return f.cards
+}
+
+// QuadRank is the rank of the four of a kind.
+func (f *FourOfAKind) QuadRank() card.Rank {
+	return f.quadRank
+}
+
+func (*FourOfAKind) Rank() eval.HandRank {
+	return eval.FOUR_OF_A_KIND
+}
+
+func (f *FourOfAKind) MainRank() card.Rank {
+	return f.quadRank
+}
+
+func (f *FourOfAKind) String() string {
+	return f.str
+}
+
+func (f *FourOfAKind) Compare(h eval.Hand) int {
+	other, ok := h.(*FourOfAKind)
+	if !ok {
+		return cmp.Compare(f.Rank(), h.Rank())
+	}
+
+	if c := cmp.Compare(f.quadRank, other.quadRank); c != 0 {
+		return c
+	}
+	return cmp.Compare(f.singleRank, other.singleRank)
+}
@@ -0,0 +1,78 @@
+package classify
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+// FourStraight is four cards of consecutive ranks, a Sökö hand. An ace plays
+// high or, as the ruleset allows, low. Four-straights compare by their top
+// card, then by the odd card.
+type FourStraight struct {
+	str    string
+	cards  []card.Card
+	run    []card.Card
+	kicker card.Card
+}
+
+func NewFourStraight(straight func([]card.Card) bool, hand string, cards []card.Card) eval.Hand {
+	var best *FourStraight
+	for i := range cards {
+		run := slices.Delete(slices.Clone(cards), i, i+1)
+		if !straight(run) {
+			continue
+		}
+		s := &FourStraight{hand, cards, run, cards[i]}
+		if best == nil || s.compare(best) > 0 {
+			best = s
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	return best
+}
+
+func (s *FourStraight) Cards() []card.Card {
+	return s.cards
+}
+
+// Run are the four cards of consecutive ranks.
+func (s *FourStraight) Run() []card.Card {
+	return s.run
+}
+
+// Kicker is the odd card.
+func (s *FourStraight) Kicker() card.Card {
+	return s.kicker
+}
+
+func (*FourStraight) Rank() eval.HandRank {
+	return eval.FOUR_STRAIGHT
+}
+
+func (s *FourStraight) MainRank() card.Rank {
+	return StraightHighCard(s.run)
+}
+
+func (s *FourStraight) String() string {
+	return s.str
+}
+
+func (s *FourStraight) Compare(h eval.Hand) int {
+	other, ok := h.(*FourStraight)
+	if !ok {
+		return cmp.Compare(s.Rank(), h.Rank())
+	}
+	return s.compare(other)
+}
+
+func (s *FourStraight) compare(other *FourStraight) int {
+	if c := cmp.Compare(StraightHighCard(s.run), StraightHighCard(other.run)); c != 0 {
+		return c
+	}
+	return cmp.Compare(s.kicker.Rank(), other.kicker.Rank())
+}
@@ -0,0 +1,81 @@
+package classify
+
+import (
+	"cmp"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+type FullHouse struct {
+	str     string
+	cards   []card.Card
+	triplet card.Rank
+	pair    card.Rank
+}
+
+func NewFullHouse(hand string, cards []card.Card) eval.Hand {
+	counts := make(map[card.Rank]int)
+	for _, c := range cards {
+		if _, ok := counts[c.Rank()]; ok {
+			counts[c.Rank()]++
+		} else {
+			counts[c.Rank()] = 1
+		}
+	}
+
+	var triplet card.Rank
+	var pair card.Rank
+
+	for rank, count := range counts {
+		if count == 2 {
+			pair = rank
+		} else if count == 3 {
+			triplet = rank
+		}
+	}
+
+	if triplet == 0 || pair == 0 {
+		return nil
+	}
+
+	return &FullHouse{hand, cards, triplet, pair}
+}
+
+func (f *FullHouse) Cards() []card.Card {
+	return f.cards
+}
+
+// Triplet is the rank of the three of a kind.
+func (f *FullHouse) Triplet() card.Rank {
+	return f.triplet
+}
+
+// Pair is the rank of the pair.
+func (f *FullHouse) Pair() card.Rank {
+	return f.pair
+}
+
+func (*FullHouse) Rank() eval.HandRank {
+	return eval.FULL_HOUSE
+}
+
+func (f *FullHouse) MainRank() card.Rank {
+	return f.triplet
+}
+
+func (f *FullHouse) String() string {
+	return f.str
+}
+
+func (f *FullHouse) Compare(h eval.Hand) int {
+	other, ok := h.(*FullHouse)
+	if !ok {
+		return cmp.Compare(f.Rank(), h.Rank())
+	}
+
+	if c := cmp.Compare(f.triplet, other.triplet); c != 0 {
+		return c
+	}
+	return cmp.Compare(f.pair, other.pair)
+}
@@ -0,0 +1,51 @@
+package classify
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+type HighCard struct {
+	str   string
+	cards []card.Card
+}
+
+func NewHighCard(str string, cards []card.Card) *HighCard {
+	return &HighCard{str, cards}
+}
+
+func (hc *HighCard) Cards() []card.Card {
+	return hc.cards
+}
+
+func (*HighCard) Rank() eval.HandRank {
+	return eval.HIGH_CARD
+}
+
+func (hc *HighCard) MainRank() card.Rank {
+	return hc.cards[len(hc.cards)-1].Rank()
+}
+
+func (hc *HighCard) String() string {
+	return hc.str
+}
+
+func (hc *HighCard) Compare(h eval.Hand) int {
+	other, ok := h.(*HighCard)
+	if !ok {
+		return cmp.Compare(hc.Rank(), h.Rank())
+	}
+	if len(other.cards) != len(hc.cards) {
+		panic(fmt.Sprintf("invalid number of cards found in highCard hand: %d vs %d", len(other.cards), len(hc.cards)))
+	}
+	for i := len(hc.cards) - 1; i >= 0; i-- {
+		c := cmp.Compare(hc.cards[i].Rank(), other.cards[i].Rank())
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
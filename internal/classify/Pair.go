@@ -0,0 +1,85 @@
+package classify
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+type Pair struct {
+	str      string
+	cards    []card.Card
+	pairRank card.Rank
+}
+
+func NewPair(hand string, cards []card.Card) eval.Hand {
+	counts := make(map[card.Rank]int)
+	for _, c := range cards {
+		if _, ok := counts[c.Rank()]; ok {
+			counts[c.Rank()]++
+		} else {
+			counts[c.Rank()] = 1
+		}
+	}
+
+	var pairRank card.Rank
+	var pairs int
+
+	for rank, count := range counts {
+		if count == 2 {
+			pairs++
+			pairRank = rank
+		}
+	}
+
+	if pairs == 1 {
+		return &Pair{hand, cards, pairRank}
+	}
+	return nil
+}
+
+func (p *Pair) Cards() []card.Card {
+	return p.cards
+}
+
+// PairRank is the rank of the pair.
+func (p *Pair) PairRank() card.Rank {
+	return p.pairRank
+}
+
+func (*Pair) Rank() eval.HandRank {
+	return eval.PAIR
+}
+
+func (p *Pair) MainRank() card.Rank {
+	return p.pairRank
+}
+
+func (p *Pair) String() string {
+	return p.str
+}
+
+func (p *Pair) Compare(h eval.Hand) int {
+	other, ok := h.(*Pair)
+	if !ok {
+		return cmp.Compare(p.Rank(), h.Rank())
+	}
+	if len(other.cards) != len(p.cards) {
+		panic(fmt.Sprintf("invalid number of cards found in pair hand: %d vs %d", len(other.cards), len(p.cards)))
+	}
+
+	c := cmp.Compare(p.pairRank, other.pairRank)
+	if c != 0 {
+		return c
+	}
+
+	for i := len(p.cards) - 1; i >= 0; i-- {
+		c := cmp.Compare(p.cards[i].Rank(), other.cards[i].Rank())
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
@@ -0,0 +1,56 @@
+package classify
+
+import (
+	"cmp"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+type Straight struct {
+	str   string
+	cards []card.Card
+}
+
+func NewStraight(straight func([]card.Card) bool, hand string, cards []card.Card) eval.Hand {
+	if !straight(cards) {
+		return nil
+	}
+	return &Straight{hand, cards}
+}
+
+func (s *Straight) Cards() []card.Card {
+	return s.cards
+}
+
+func (*Straight) Rank() eval.HandRank {
+	return eval.STRAIGHT
+}
+
+func (s *Straight) MainRank() card.Rank {
+	return StraightHighCard(s.cards)
+}
+
+func (s *Straight) String() string {
+	return s.str
+}
+
+func (s *Straight) Compare(h eval.Hand) int {
+	other, ok := h.(*Straight)
+	if !ok {
+		return cmp.Compare(s.Rank(), h.Rank())
+	}
+
+	return cmp.Compare(StraightHighCard(s.cards), StraightHighCard(other.Cards()))
+}
+
+// StraightHighCard returns the top card of a straight given its sorted cards.
+// An ace without a king plays low, so the wheel is five high, and A-6-7-8-9
+// in short deck nine high.
+func StraightHighCard(cards []card.Card) card.Rank {
+	n := len(cards)
+	if cards[n-1].Rank() == card.ACE && cards[n-2].Rank() != card.KING {
+		return cards[n-2].Rank()
+	}
+	return cards[n-1].Rank()
+}
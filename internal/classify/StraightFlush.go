@@ -0,0 +1,49 @@
+package classify
+
+import (
+	"cmp"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+type StraightFlush struct {
+	straight eval.Hand
+}
+
+func NewStraightFlush(straight func([]card.Card) bool, hand string, cards []card.Card) eval.Hand {
+	if f := NewFlush(hand, cards); f == nil {
+		return nil
+	}
+
+	if s := NewStraight(straight, hand, cards); s == nil {
+		return nil
+	} else {
+		return &StraightFlush{s}
+	}
+}
+
+func (s *StraightFlush) Cards() []card.Card {
+	return s.straight.Cards()
+}
+
+func (*StraightFlush) Rank() eval.HandRank {
+	return eval.STRAIGHT_FLUSH
+}
+
+func (s *StraightFlush) MainRank() card.Rank {
+	return s.straight.MainRank()
+}
+
+func (s *StraightFlush) String() string {
+	return s.straight.String()
+}
+
+func (s *StraightFlush) Compare(h eval.Hand) int {
+	other, ok := h.(*StraightFlush)
+	if !ok {
+		return cmp.Compare(s.Rank(), h.Rank())
+	}
+
+	return s.straight.Compare(other.straight)
+}
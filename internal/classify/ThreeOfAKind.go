@@ -0,0 +1,74 @@
+package classify
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+type ThreeOfAKind struct {
+	str         string
+	cards       []card.Card
+	tripletRank card.Rank
+}
+
+func NewThreeOfAKind(hand string, cards []card.Card) eval.Hand {
+	counts := make(map[card.Rank]int)
+	for _, c := range cards {
+		if _, ok := counts[c.Rank()]; ok {
+			counts[c.Rank()]++
+		} else {
+			counts[c.Rank()] = 1
+		}
+	}
+
+	var tripletRank card.Rank
+
+	for rank, count := range counts {
+		if count == 3 {
+			tripletRank = rank
+			break
+		}
+	}
+
+	if tripletRank == 0 {
+		return nil
+	}
+	return &ThreeOfAKind{hand, cards, tripletRank}
+}
+
+func (t *ThreeOfAKind) Cards() []card.Card {
+	return t.cards
+}
+
+// TripletRank is the rank of the three of a kind.
+func (t *ThreeOfAKind) TripletRank() card.Rank {
+	return t.tripletRank
+}
+
+func (*ThreeOfAKind) Rank() eval.HandRank {
+	return eval.THREE_OF_A_KIND
+}
+
+func (t *ThreeOfAKind) MainRank() card.Rank {
+	return t.tripletRank
+}
+
+func (t *ThreeOfAKind) String() string {
+	return t.str
+}
+
+func (t *ThreeOfAKind) Compare(h eval.Hand) int {
+	other, ok := h.(*ThreeOfAKind)
+	if !ok {
+		return cmp.Compare(t.Rank(), h.Rank())
+	}
+
+	if len(other.cards) != len(t.cards) {
+		panic(fmt.Sprintf("invalid number of cards found in pair hand: %d vs %d", len(other.cards), len(t.cards)))
+	}
+
+	return cmp.Compare(t.tripletRank, other.tripletRank)
+}
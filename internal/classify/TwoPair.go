@@ -0,0 +1,92 @@
+package classify
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+type TwoPair struct {
+	str      string
+	cards    []card.Card
+	pairRank [2]card.Rank
+}
+
+func NewTwoPair(hand string, cards []card.Card) eval.Hand {
+	counts := make(map[card.Rank]int)
+	for _, c := range cards {
+		if _, ok := counts[c.Rank()]; ok {
+			counts[c.Rank()]++
+		} else {
+			counts[c.Rank()] = 1
+		}
+	}
+
+	var pairRank [2]card.Rank
+	var pairs int
+
+	for rank, count := range counts {
+		if count == 2 {
+			pairRank[pairs] = rank
+			pairs++
+		}
+	}
+
+	if pairRank[0] < pairRank[1] {
+		pairRank[0], pairRank[1] = pairRank[1], pairRank[0]
+	}
+
+	if pairs == 2 {
+		return &TwoPair{hand, cards, pairRank}
+	}
+	return nil
+}
+
+func (p *TwoPair) Cards() []card.Card {
+	return p.cards
+}
+
+// PairRanks are the ranks of the pairs, the higher first.
+func (p *TwoPair) PairRanks() [2]card.Rank {
+	return p.pairRank
+}
+
+func (*TwoPair) Rank() eval.HandRank {
+	return eval.TWO_PAIR
+}
+
+func (p *TwoPair) MainRank() card.Rank {
+	return p.pairRank[0]
+}
+
+func (p *TwoPair) String() string {
+	return p.str
+}
+
+func (p *TwoPair) Compare(h eval.Hand) int {
+	other, ok := h.(*TwoPair)
+	if !ok {
+		return cmp.Compare(p.Rank(), h.Rank())
+	}
+
+	if len(other.cards) != len(p.cards) {
+		panic(fmt.Sprintf("invalid number of cards found in pair hand: %d vs %d", len(other.cards), len(p.cards)))
+	}
+
+	if c := cmp.Compare(p.pairRank[0], other.pairRank[0]); c != 0 {
+		return c
+	}
+
+	if c := cmp.Compare(p.pairRank[1], other.pairRank[1]); c != 0 {
+		return c
+	}
+
+	for i, held := range p.cards {
+		if c := cmp.Compare(held.Rank(), other.cards[i].Rank()); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
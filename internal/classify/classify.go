@@ -0,0 +1,3 @@
+// Package classify recognizes the categories of poker hands, one classifier
+// per category. Package poker orders them by ruleset.
+package classify
@@ -0,0 +1,42 @@
+// Package deal holds the helpers the games share for checking and
+// enumerating deals.
+package deal
+
+import (
+	"fmt"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+// Distinct validates the cards of a deal.
+func Distinct(cards ...card.Card) error {
+	var seen eval.CardSet
+	for _, c := range cards {
+		if c.Rank() < card.TWO || c.Rank() > card.ACE || c.Suit() < card.HEARTS || c.Suit() > card.DIAMONDS {
+			return fmt.Errorf("invalid card: rank %d, suit %d", c.Rank(), c.Suit())
+		}
+		if seen.Contains(c) {
+			return fmt.Errorf("card %s used more than once", c.String())
+		}
+		seen = seen.Add(c)
+	}
+	return nil
+}
+
+// ForEachCombination fills cards with every increasing sequence of card
+// indexes below deck in turn, calling f for each.
+func ForEachCombination(deck int, cards []int, f func()) {
+	var visit func(k, from int)
+	visit = func(k, from int) {
+		if k == len(cards) {
+			f()
+			return
+		}
+		for c := from; c < deck; c++ {
+			cards[k] = c
+			visit(k+1, c+1)
+		}
+	}
+	visit(0, 0)
+}
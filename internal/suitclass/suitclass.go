@@ -0,0 +1,69 @@
+// Package suitclass enumerates hands up to renaming their suits, which
+// leaves them strategically unchanged, so that tables over every hand are
+// built from a fraction of the hands.
+package suitclass
+
+import (
+	"math/bits"
+
+	"github.com/sdeboni/go-poker/card"
+)
+
+// masksByCount lists the rank masks of each size, in decreasing order.
+var masksByCount = func() (lists [card.ACE - card.TWO + 2][]uint16) {
+	for m := 1<<(card.ACE-card.TWO+1) - 1; m >= 0; m-- {
+		mask := uint16(m) << card.TWO
+		n := bits.OnesCount16(mask)
+		lists[n] = append(lists[n], mask)
+	}
+	return lists
+}()
+
+// ForEach calls f once for every hand of size cards of rank lowest
+// or above up to renaming the suits, given as the ranks held in each suit,
+// with the number of hands it stands for. Suits are filled in decreasing
+// order of size then mask, so that each class is visited once.
+func ForEach(size int, lowest card.Rank, f func(suitMasks *[card.DIAMONDS + 1]uint16, count int64)) {
+	excluded := uint16(1)<<lowest - 1
+	var suitMasks [card.DIAMONDS + 1]uint16
+	var visit func(suit card.Suit, left, maxCount, from int)
+	visit = func(suit card.Suit, left, maxCount, from int) {
+		if suit > card.DIAMONDS {
+			if left == 0 {
+				f(&suitMasks, permutations(&suitMasks))
+			}
+			return
+		}
+		suits := int(card.DIAMONDS - suit + 1)
+		for n := min(left, maxCount); n*suits >= left; n-- {
+			start := 0
+			if n == maxCount {
+				start = from
+			}
+			for i := start; i < len(masksByCount[n]); i++ {
+				if masksByCount[n][i]&excluded != 0 {
+					continue
+				}
+				suitMasks[suit] = masksByCount[n][i]
+				visit(suit+1, left-n, n, i)
+			}
+		}
+		suitMasks[suit] = 0
+	}
+	visit(card.HEARTS, size, int(card.ACE-card.TWO+1), 0)
+}
+
+// permutations counts the distinct ways to give sorted suit masks to the
+// four suits.
+func permutations(suitMasks *[card.DIAMONDS + 1]uint16) int64 {
+	count, run := int64(24), int64(1)
+	for suit := card.CLUBS; suit <= card.DIAMONDS; suit++ {
+		if suitMasks[suit] == suitMasks[suit-1] {
+			run++
+			count /= run
+		} else {
+			run = 1
+		}
+	}
+	return count
+}
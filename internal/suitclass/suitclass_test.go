@@ -0,0 +1,33 @@
+package suitclass
+
+import (
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+func TestForEachSuitClass(t *testing.T) {
+	cases := []struct {
+		size    int
+		classes int
+		counts  [eval.STRAIGHT_FLUSH + 1]int64
+	}{
+		{5, 134459, [eval.STRAIGHT_FLUSH + 1]int64{eval.HIGH_CARD: 1302540, eval.PAIR: 1098240, eval.TWO_PAIR: 123552, eval.THREE_OF_A_KIND: 54912,
+			eval.STRAIGHT: 10200, eval.FLUSH: 5108, eval.FULL_HOUSE: 3744, eval.FOUR_OF_A_KIND: 624, eval.STRAIGHT_FLUSH: 40}},
+		{7, 6009159, [eval.STRAIGHT_FLUSH + 1]int64{eval.HIGH_CARD: 23294460, eval.PAIR: 58627800, eval.TWO_PAIR: 31433400, eval.THREE_OF_A_KIND: 6461620,
+			eval.STRAIGHT: 6180020, eval.FLUSH: 4047644, eval.FULL_HOUSE: 3473184, eval.FOUR_OF_A_KIND: 224848, eval.STRAIGHT_FLUSH: 41584}},
+	}
+
+	for _, tc := range cases {
+		var classes int
+		var counts [eval.STRAIGHT_FLUSH + 1]int64
+		ForEach(tc.size, card.TWO, func(suitMasks *[card.DIAMONDS + 1]uint16, count int64) {
+			classes++
+			counts[eval.EvaluateMasks(suitMasks).Rank()] += count
+		})
+		if classes != tc.classes || counts != tc.counts {
+			t.Errorf("%d cards: expected %d classes and %v, got %d and %v", tc.size, tc.classes, tc.counts, classes, counts)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package ofc
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+// threeCardHand is a three-card hand, as played in the top row of
+// Open-Face Chinese poker: only three of a kind, pair or high card count.
+// It compares against hands of any size, the missing kickers of a short hand
+// counting as lowest.
+type threeCardHand struct {
+	str   string
+	cards []card.Card
+	value eval.HandValue
+}
+
+// newThreeCardHand classifies three distinct cards. The slice is sorted in
+// place.
+func newThreeCardHand(cards []card.Card) *threeCardHand {
+	str := card.Format(cards)
+	slices.SortFunc(cards, func(a, b card.Card) int {
+		return cmp.Compare(a.Rank(), b.Rank())
+	})
+	return &threeCardHand{str, cards, eval.Evaluate(cards)}
+}
+
+func (t *threeCardHand) Cards() []card.Card {
+	return t.cards
+}
+
+func (t *threeCardHand) Rank() eval.HandRank {
+	return t.value.Rank()
+}
+
+func (t *threeCardHand) MainRank() card.Rank {
+	return t.value.Top()
+}
+
+func (t *threeCardHand) String() string {
+	return t.str
+}
+
+func (t *threeCardHand) Compare(h eval.Hand) int {
+	if other, ok := h.(*threeCardHand); ok {
+		return t.value.Compare(other.value)
+	}
+	return t.value.Compare(eval.Evaluate(h.Cards()))
+}
@@ -0,0 +1,194 @@
+// Package ofc scores Open-Face Chinese poker: fouls, royalties and
+// fantasyland for a player's rows, and the points between players. Pineapple
+// OFC deals three cards a turn and discards one, which changes nothing once
+// the rows are set. Crazy Pineapple is another game, a hold'em variant in
+// which players discard one of three hole cards after the flop and then play
+// on as in hold'em.
+package ofc
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/sdeboni/go-poker"
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+	"github.com/sdeboni/go-poker/internal/deal"
+)
+
+// SCOOP_BONUS is paid on top of the three rows to a player who wins
+// them all.
+const SCOOP_BONUS = 3
+
+// Hand is a set Open-Face Chinese poker hand: a three-card top row and
+// five-card middle and bottom rows, which must not weaken from the bottom
+// up.
+type Hand struct {
+	Top    eval.Hand
+	Middle eval.Hand
+	Bottom eval.Hand
+}
+
+// fiveCardRows classifies the middle and bottom rows.
+var fiveCardRows = poker.DefaultRuleset()
+
+func NewHand(top, middle, bottom []card.Card) (*Hand, error) {
+	if len(top) != 3 {
+		return nil, fmt.Errorf("invalid top row: expected 3 cards, found: %d", len(top))
+	}
+	if err := deal.Distinct(slices.Concat(top, middle, bottom)...); err != nil {
+		return nil, err
+	}
+	m, err := fiveCardRows.NewHand(slices.Clone(middle))
+	if err != nil {
+		return nil, err
+	}
+	b, err := fiveCardRows.NewHand(slices.Clone(bottom))
+	if err != nil {
+		return nil, err
+	}
+	return &Hand{newThreeCardHand(slices.Clone(top)), m, b}, nil
+}
+
+// ParseHand reads the rows of a hand, each as ParseCards reads cards.
+func ParseHand(top, middle, bottom string) (*Hand, error) {
+	var rows [3][]card.Card
+	for i, row := range []string{top, middle, bottom} {
+		cards, err := card.ParseCards(row)
+		if err != nil {
+			return nil, err
+		}
+		rows[i] = cards
+	}
+	return NewHand(rows[0], rows[1], rows[2])
+}
+
+// Fouled reports a hand whose top row beats its middle, or whose middle
+// beats its bottom.
+func (h *Hand) Fouled() bool {
+	return h.Top.Compare(h.Middle) > 0 || h.Middle.Compare(h.Bottom) > 0
+}
+
+// Royalties are the bonus points for strong rows, nothing for a fouled hand.
+func (h *Hand) Royalties() int {
+	if h.Fouled() {
+		return 0
+	}
+	return topRoyalty(h.Top) + middleRoyalty(h.Middle) + bottomRoyalty(h.Bottom)
+}
+
+// Fantasyland reports a hand earning fantasyland: queens or better on top
+// without fouling.
+func (h *Hand) Fantasyland() bool {
+	if h.Fouled() {
+		return false
+	}
+	return h.Top.Rank() == eval.THREE_OF_A_KIND || (h.Top.Rank() == eval.PAIR && h.Top.MainRank() >= card.QUEEN)
+}
+
+// StaysInFantasyland reports a hand, played in fantasyland, that earns
+// another: trips on top, a full house or better in the middle, or four of a
+// kind or better at the bottom.
+func (h *Hand) StaysInFantasyland() bool {
+	if h.Fouled() {
+		return false
+	}
+	return h.Top.Rank() == eval.THREE_OF_A_KIND || h.Middle.Rank() >= eval.FULL_HOUSE || h.Bottom.Rank() >= eval.FOUR_OF_A_KIND
+}
+
+// Score returns the points a wins from b: one per row won, the scoop bonus
+// for winning all three, and the difference in royalties. A fouled hand loses
+// every row to a hand that did not foul.
+func Score(a, b *Hand) int {
+	switch af, bf := a.Fouled(), b.Fouled(); {
+	case af && bf:
+		return 0
+	case af:
+		return -3 - SCOOP_BONUS - b.Royalties()
+	case bf:
+		return 3 + SCOOP_BONUS + a.Royalties()
+	}
+
+	var rows int
+	for _, c := range []int{a.Top.Compare(b.Top), a.Middle.Compare(b.Middle), a.Bottom.Compare(b.Bottom)} {
+		switch {
+		case c > 0:
+			rows++
+		case c < 0:
+			rows--
+		}
+	}
+	if rows == 3 || rows == -3 {
+		rows += rows / 3 * SCOOP_BONUS
+	}
+	return rows + a.Royalties() - b.Royalties()
+}
+
+// ScoreTable scores every pair of players at a table and returns each
+// player's total, which sum to zero.
+func ScoreTable(hands []*Hand) []int {
+	scores := make([]int, len(hands))
+	for i := range hands {
+		for j := i + 1; j < len(hands); j++ {
+			points := Score(hands[i], hands[j])
+			scores[i] += points
+			scores[j] -= points
+		}
+	}
+	return scores
+}
+
+// topRoyalty pays a pair of sixes 1 up to a pair of aces 9, and trips of
+// twos 10 up to trip aces 22.
+func topRoyalty(top eval.Hand) int {
+	switch top.Rank() {
+	case eval.THREE_OF_A_KIND:
+		return int(top.MainRank()) + 8
+	case eval.PAIR:
+		return max(0, int(top.MainRank())-5)
+	default:
+		return 0
+	}
+}
+
+func middleRoyalty(middle eval.Hand) int {
+	switch middle.Rank() {
+	case eval.THREE_OF_A_KIND:
+		return 2
+	case eval.STRAIGHT:
+		return 4
+	case eval.FLUSH:
+		return 8
+	case eval.FULL_HOUSE:
+		return 12
+	case eval.FOUR_OF_A_KIND:
+		return 20
+	case eval.STRAIGHT_FLUSH:
+		if middle.MainRank() == card.ACE {
+			return 50
+		}
+		return 30
+	default:
+		return 0
+	}
+}
+
+func bottomRoyalty(bottom eval.Hand) int {
+	switch bottom.Rank() {
+	case eval.STRAIGHT:
+		return 2
+	case eval.FLUSH:
+		return 4
+	case eval.FULL_HOUSE:
+		return 6
+	case eval.FOUR_OF_A_KIND:
+		return 10
+	case eval.STRAIGHT_FLUSH:
+		if bottom.MainRank() == card.ACE {
+			return 25
+		}
+		return 15
+	default:
+		return 0
+	}
+}
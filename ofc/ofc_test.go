@@ -0,0 +1,135 @@
+package ofc
+
+import (
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+func ofcHand(t *testing.T, top, middle, bottom string) *Hand {
+	t.Helper()
+	h, err := ParseHand(top, middle, bottom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestThreeCardHand(t *testing.T) {
+	cases := []struct {
+		description string
+		a, b        string
+		rank        eval.HandRank
+		expected    int
+	}{
+		{"trips beat a pair", "2♡ 2♧ 2♤", "A♡ A♧ K♤", eval.THREE_OF_A_KIND, 1},
+		{"pair kicker", "Q♡ Q♧ 2♤", "Q♢ Q♤ 3♧", eval.PAIR, -1},
+		{"no straights or flushes", "2♡ 3♡ 4♡", "A♧ 2♧ 5♢", eval.HIGH_CARD, -1},
+		{"high card tie", "A♡ 9♧ 4♤", "A♢ 9♤ 4♧", eval.HIGH_CARD, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			a, err := card.ParseCards(tc.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := card.ParseCards(tc.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			top, other := newThreeCardHand(a), newThreeCardHand(b)
+			if top.Rank() != tc.rank {
+				t.Errorf("expected %s, got %s", tc.rank, top.Rank())
+			}
+			if got := top.Compare(other); got != tc.expected {
+				t.Errorf("expected %d, got %d", tc.expected, got)
+			}
+		})
+	}
+
+	if _, err := ParseHand("A♡ 9♧ 4♤ 2♢", "3♧ 6♧ 9♧ J♧ K♧", "5♡ 5♢ 5♤ 7♢ 7♡"); err == nil {
+		t.Error("expected error for four cards on top")
+	}
+}
+
+func TestOFCHand(t *testing.T) {
+	cases := []struct {
+		description         string
+		top, middle, bottom string
+		fouled              bool
+		royalties           int
+		fantasyland, stays  bool
+	}{
+		{"plain", "K♡ 9♧ 4♤", "J♡ J♧ 8♤ 3♢ 2♢", "10♡ 10♧ 10♤ 5♢ 2♡", false, 0, false, false},
+		{"top beats middle", "Q♡ Q♧ 4♤", "J♡ J♧ 8♤ 3♢ 2♢", "10♡ 10♧ 10♤ 5♢ 2♡", true, 0, false, false},
+		{"middle beats bottom", "K♡ 9♧ 4♤", "10♡ 10♧ 10♤ 5♢ 2♡", "J♡ J♧ 8♤ 3♢ 2♢", true, 0, false, false},
+		{"equal to the missing kickers", "Q♡ Q♧ 5♤", "Q♢ Q♤ 5♧ 4♧ 3♧", "A♡ A♧ 8♤ 3♢ 2♢", false, 7, true, false},
+		{"top kicker beats middle", "Q♡ Q♧ 6♤", "Q♢ Q♤ 5♧ 4♧ 3♧", "A♡ A♧ 8♤ 3♢ 2♢", true, 0, false, false},
+		{"royalties in every row", "A♡ A♧ 2♤", "3♧ 6♧ 9♧ J♧ K♧", "4♡ 4♢ 4♤ 7♢ 7♡", false, 9 + 8 + 6, true, false},
+		{"royal flush at the bottom", "2♡ 2♧ 2♤", "3♡ 3♢ 3♤ 8♢ 8♡", "10♤ J♤ Q♤ K♤ A♤", false, 10 + 12 + 25, true, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			h := ofcHand(t, tc.top, tc.middle, tc.bottom)
+			if h.Fouled() != tc.fouled {
+				t.Errorf("expected fouled %t", tc.fouled)
+			}
+			if got := h.Royalties(); got != tc.royalties {
+				t.Errorf("expected %d royalties, got %d", tc.royalties, got)
+			}
+			if h.Fantasyland() != tc.fantasyland || h.StaysInFantasyland() != tc.stays {
+				t.Errorf("expected fantasyland %t, staying %t", tc.fantasyland, tc.stays)
+			}
+		})
+	}
+
+	if _, err := ParseHand("A♡ A♧ 2♤", "2♤ 6♧ 9♧ J♧ K♧", "4♡ 4♢ 4♤ 7♢ 7♡"); err == nil {
+		t.Error("expected error for a card in two rows")
+	}
+
+	parsed := ofcHand(t, "Q♡ Q♧ 2♤", "3♧ 6♧ 9♧ J♧ K♧", "4♡ 4♢ 4♤ 7♢ 7♡")
+	h, err := NewHand(parsed.Top.Cards(), parsed.Middle.Cards(), parsed.Bottom.Cards())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Royalties() != parsed.Royalties() || h.Fantasyland() != parsed.Fantasyland() || Score(h, parsed) != 0 {
+		t.Error("expected the same hand from its cards as from its strings")
+	}
+}
+
+func TestScoreOFC(t *testing.T) {
+	strong := ofcHand(t, "A♡ A♧ 2♤", "3♧ 6♧ 9♧ J♧ K♧", "4♡ 4♢ 4♤ 7♢ 7♡")
+	weak := ofcHand(t, "K♡ 9♧ 4♤", "J♡ J♧ 8♤ 3♢ 2♢", "10♡ 10♧ 10♤ 5♢ 2♡")
+	split := ofcHand(t, "Q♡ 9♡ 4♡", "J♢ J♤ 8♢ 3♤ 2♤", "5♡ 6♡ 7♤ 8♡ 9♢")
+	fouled := ofcHand(t, "Q♤ Q♢ 5♤", "J♡ J♧ 8♤ 3♢ 2♢", "10♡ 10♧ 10♤ 5♢ 2♡")
+
+	cases := []struct {
+		description string
+		a, b        *Hand
+		expected    int
+	}{
+		{"scoop with royalties", strong, weak, 3 + SCOOP_BONUS + 23},
+		{"rows split", weak, split, 1 + 0 - 1 - 2},
+		{"foul loses everything", fouled, strong, -3 - SCOOP_BONUS - 23},
+		{"both fouled", fouled, fouled, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			if got := Score(tc.a, tc.b); got != tc.expected {
+				t.Errorf("expected %d, got %d", tc.expected, got)
+			}
+			if got := Score(tc.b, tc.a); got != -tc.expected {
+				t.Errorf("expected %d in reverse, got %d", -tc.expected, got)
+			}
+		})
+	}
+
+	scores := ScoreTable([]*Hand{strong, weak, split})
+	if scores[0]+scores[1]+scores[2] != 0 || scores[0] != (3+SCOOP_BONUS+23)+(3+SCOOP_BONUS+23-2) {
+		t.Errorf("unexpected table scores: %v", scores)
+	}
+}
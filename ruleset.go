@@ -0,0 +1,321 @@
+package poker
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+	"github.com/sdeboni/go-poker/internal/classify"
+	"github.com/sdeboni/go-poker/internal/deal"
+)
+
+// Classifier returns the hand that five distinct cards, sorted by rank, make
+// in one category, or nil if they do not make it. hand is the cards' normal
+// form in the order they were given.
+type Classifier func(r *Ruleset, hand string, cards []card.Card) eval.Hand
+
+type Category struct {
+	Rank     eval.HandRank
+	Classify Classifier
+}
+
+// WheelRule says whether an ace may play below the lowest rank in a straight.
+type WheelRule int
+
+const (
+	// ACE_LOW_WHEEL makes A-2-3-4-5 a straight, or A-6-7-8-9 in short deck.
+	ACE_LOW_WHEEL WheelRule = iota
+	// NO_WHEEL plays aces high only, as in deuce to seven lowball.
+	NO_WHEEL
+)
+
+// KickerRule says whether the cards outside a hand's category break ties.
+type KickerRule int
+
+const (
+	KICKERS_PLAY KickerRule = iota
+	// NO_KICKERS ties hands equal in the cards making their category.
+	NO_KICKERS
+)
+
+// Ruleset defines a poker variant's five-card hands as data: which
+// categories there are, how each is recognized and in what order they rank.
+// Hands made by a ruleset compare by its order with other hands it made;
+// within a category the classifier's Compare decides. Against any other hand,
+// including one made by another ruleset, they compare as ParseHand's hands
+// do: by HandRank value, then within the category.
+type Ruleset struct {
+	Name string
+	// Categories lists the categories from best to worst. Cards make the
+	// first category they qualify for, so the last should take any hand.
+	Categories []Category
+	// LowestRank is the lowest rank in the deck, SIX for short deck. Zero
+	// means TWO.
+	LowestRank card.Rank
+	Wheel      WheelRule
+	Kickers    KickerRule
+	// Low reverses the order, the worst hand winning, as in lowball.
+	Low bool
+}
+
+// Classifiers for the categories whose rules do not vary.
+func fixedRules(match func(hand string, cards []card.Card) eval.Hand) Classifier {
+	return func(_ *Ruleset, hand string, cards []card.Card) eval.Hand {
+		return match(hand, cards)
+	}
+}
+
+// The standard categories, for building rulesets. The straights follow the
+// ruleset's LowestRank and Wheel; the others do not vary.
+var (
+	STRAIGHT_FLUSH_CATEGORY = Category{eval.STRAIGHT_FLUSH, func(r *Ruleset, hand string, cards []card.Card) eval.Hand {
+		return classify.NewStraightFlush(r.straight, hand, cards)
+	}}
+	FOUR_OF_A_KIND_CATEGORY = Category{eval.FOUR_OF_A_KIND, fixedRules(classify.NewFourOfAKind)}
+	FULL_HOUSE_CATEGORY     = Category{eval.FULL_HOUSE, fixedRules(classify.NewFullHouse)}
+	FLUSH_CATEGORY          = Category{eval.FLUSH, fixedRules(classify.NewFlush)}
+	STRAIGHT_CATEGORY       = Category{eval.STRAIGHT, func(r *Ruleset, hand string, cards []card.Card) eval.Hand {
+		return classify.NewStraight(r.straight, hand, cards)
+	}}
+	THREE_OF_A_KIND_CATEGORY = Category{eval.THREE_OF_A_KIND, fixedRules(classify.NewThreeOfAKind)}
+	TWO_PAIR_CATEGORY        = Category{eval.TWO_PAIR, fixedRules(classify.NewTwoPair)}
+	PAIR_CATEGORY            = Category{eval.PAIR, fixedRules(classify.NewPair)}
+	FOUR_STRAIGHT_CATEGORY   = Category{eval.FOUR_STRAIGHT, func(r *Ruleset, hand string, cards []card.Card) eval.Hand {
+		return classify.NewFourStraight(r.straight, hand, cards)
+	}}
+	FOUR_FLUSH_CATEGORY = Category{eval.FOUR_FLUSH, fixedRules(classify.NewFourFlush)}
+	HIGH_CARD_CATEGORY  = Category{eval.HIGH_CARD, func(_ *Ruleset, hand string, cards []card.Card) eval.Hand {
+		return classify.NewHighCard(hand, cards)
+	}}
+)
+
+// DefaultRuleset is high-hand poker with a full deck, as ParseHand and
+// BestHand play it.
+func DefaultRuleset() *Ruleset {
+	return &Ruleset{
+		Name: "Standard",
+		Categories: []Category{
+			STRAIGHT_FLUSH_CATEGORY,
+			FOUR_OF_A_KIND_CATEGORY,
+			FULL_HOUSE_CATEGORY,
+			FLUSH_CATEGORY,
+			STRAIGHT_CATEGORY,
+			THREE_OF_A_KIND_CATEGORY,
+			TWO_PAIR_CATEGORY,
+			PAIR_CATEGORY,
+			HIGH_CARD_CATEGORY,
+		},
+	}
+}
+
+var defaultRuleset = DefaultRuleset()
+
+// ShortDeckRuleset is six-plus hold'em: the twos to fives are removed, a
+// flush beats a full house and three of a kind beats a straight.
+func ShortDeckRuleset() *Ruleset {
+	return &Ruleset{
+		Name: "Short deck",
+		Categories: []Category{
+			STRAIGHT_FLUSH_CATEGORY,
+			FOUR_OF_A_KIND_CATEGORY,
+			FLUSH_CATEGORY,
+			FULL_HOUSE_CATEGORY,
+			THREE_OF_A_KIND_CATEGORY,
+			STRAIGHT_CATEGORY,
+			TWO_PAIR_CATEGORY,
+			PAIR_CATEGORY,
+			HIGH_CARD_CATEGORY,
+		},
+		LowestRank: card.SIX,
+	}
+}
+
+// SokoRuleset is Sökö, or Canadian stud: a four-straight and below it a
+// four-flush rank between two pair and a pair.
+func SokoRuleset() *Ruleset {
+	r := DefaultRuleset()
+	r.Name = "Sökö"
+	r.Categories = slices.Insert(r.Categories, slices.IndexFunc(r.Categories, func(c Category) bool {
+		return c.Rank == eval.PAIR
+	}), FOUR_STRAIGHT_CATEGORY, FOUR_FLUSH_CATEGORY)
+	return r
+}
+
+// KansasCityRuleset is deuce to seven lowball: the worst high hand wins,
+// aces are always high and straights and flushes count against the hand.
+func KansasCityRuleset() *Ruleset {
+	r := DefaultRuleset()
+	r.Name = "Kansas City lowball"
+	r.Wheel = NO_WHEEL
+	r.Low = true
+	return r
+}
+
+var kansasCityRuleset = KansasCityRuleset()
+
+func (r *Ruleset) lowestRank() card.Rank {
+	if r.LowestRank == 0 {
+		return card.TWO
+	}
+	return r.LowestRank
+}
+
+// straight reports sorted cards of consecutive ranks.
+func (r *Ruleset) straight(cards []card.Card) bool {
+	for i := 1; i < len(cards); i++ {
+		if cards[i].Rank() != cards[i-1].Rank()+1 {
+			last := i == len(cards)-1
+			return last && r.Wheel == ACE_LOW_WHEEL && cards[i].Rank() == card.ACE && cards[0].Rank() == r.lowestRank()
+		}
+	}
+	return true
+}
+
+func (r *Ruleset) ParseHand(str string) (eval.Hand, error) {
+	str = strings.TrimSpace(str)
+	cards, err := card.ParseCards(str)
+	if err != nil {
+		return nil, err
+	}
+	return r.NewHand(cards)
+}
+
+// BestHand is BestHand played by the ruleset.
+func (r *Ruleset) BestHand(str []string) ([]string, error) {
+	if len(str) == 0 {
+		return nil, fmt.Errorf("no hands given")
+	}
+	hands := make([]eval.Hand, 0, len(str))
+	for _, s := range str {
+		hand, err := r.ParseHand(s)
+		if err != nil {
+			return nil, err
+		}
+		hands = append(hands, hand)
+	}
+	for card, count := range getCountsByCard(hands) {
+		if count > 1 {
+			return nil, fmt.Errorf("card %s used %d times", card.String(), count)
+		}
+	}
+	return bestHands(hands), nil
+}
+
+// NewHand classifies five distinct cards. The slice is sorted in place.
+func (r *Ruleset) NewHand(cards []card.Card) (eval.Hand, error) {
+	if len(cards) != eval.CARDS_PER_HAND {
+		return nil, fmt.Errorf("invalid hand: expected %d cards, found: %d", eval.CARDS_PER_HAND, len(cards))
+	}
+	if err := deal.Distinct(cards...); err != nil {
+		return nil, err
+	}
+	for _, c := range cards {
+		if c.Rank() < r.lowestRank() {
+			return nil, fmt.Errorf("card %s is not in the %s deck", c.String(), r.Name)
+		}
+	}
+	hand, category := r.classify(cards)
+	if hand == nil {
+		return nil, fmt.Errorf("hand %s makes no %s category", card.Format(cards), r.Name)
+	}
+	return &rulesetHand{hand, r, category}, nil
+}
+
+// classify returns the hand the cards make and the index of its category,
+// or nil if they make none. The slice is sorted in place.
+func (r *Ruleset) classify(cards []card.Card) (eval.Hand, int) {
+	str := card.Format(cards)
+	slices.SortFunc(cards, func(a, b card.Card) int {
+		return cmp.Compare(a.Rank(), b.Rank())
+	})
+	for i, category := range r.Categories {
+		if hand := category.Classify(r, str, cards); hand != nil {
+			return hand, i
+		}
+	}
+	return nil, -1
+}
+
+// rulesetHand orders a classified hand by its ruleset.
+type rulesetHand struct {
+	eval.Hand
+	rules    *Ruleset
+	category int
+}
+
+// classified returns the hand a ruleset's category made, for the helpers
+// that look inside hands by their concrete type.
+func classified(hand eval.Hand) eval.Hand {
+	if h, ok := hand.(*rulesetHand); ok {
+		return h.Hand
+	}
+	return hand
+}
+
+func (h *rulesetHand) Rank() eval.HandRank {
+	return h.rules.Categories[h.category].Rank
+}
+
+func (h *rulesetHand) Compare(other eval.Hand) int {
+	o, ok := other.(*rulesetHand)
+	if !ok || o.rules != h.rules {
+		return h.Hand.Compare(classified(other))
+	}
+	c := cmp.Compare(o.category, h.category)
+	if c == 0 {
+		c = h.rules.compareWithin(h.Hand, o.Hand)
+	}
+	if h.rules.Low {
+		return -c
+	}
+	return c
+}
+
+// RulesetOf returns the ruleset that made hand, or nil for a hand from
+// ParseHand or any other source.
+func RulesetOf(hand eval.Hand) *Ruleset {
+	if h, ok := hand.(*rulesetHand); ok {
+		return h.rules
+	}
+	return nil
+}
+
+// CompareRanks orders two categories as the ruleset does, best first, so
+// that Sökö's four-flush ranks below two pair. ok is false when the ruleset
+// has no category of either rank.
+func (r *Ruleset) CompareRanks(a, b eval.HandRank) (c int, ok bool) {
+	index := func(rank eval.HandRank) int {
+		return slices.IndexFunc(r.Categories, func(c Category) bool {
+			return c.Rank == rank
+		})
+	}
+	i, j := index(a), index(b)
+	if i < 0 || j < 0 {
+		return 0, false
+	}
+	c = cmp.Compare(j, i)
+	if r.Low {
+		return -c, true
+	}
+	return c, true
+}
+
+// compareWithin compares two hands of the same category.
+func (r *Ruleset) compareWithin(a, b eval.Hand) int {
+	if r.Kickers == NO_KICKERS {
+		aMade, aKickers := madeAndKickers(a)
+		bMade, _ := madeAndKickers(b)
+		if len(aKickers) > 0 {
+			for i := range aMade {
+				if c := cmp.Compare(aMade[i].Rank(), bMade[i].Rank()); c != 0 {
+					return c
+				}
+			}
+			return 0
+		}
+	}
+	return a.Compare(b)
+}
@@ -0,0 +1,184 @@
+package poker
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/deck"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+func rulesetHands(t *testing.T, r *Ruleset, strs ...string) []eval.Hand {
+	t.Helper()
+	hands := make([]eval.Hand, len(strs))
+	for i, str := range strs {
+		hand, err := r.ParseHand(str)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hands[i] = hand
+	}
+	return hands
+}
+
+func TestDefaultRulesetMatchesParseHand(t *testing.T) {
+	rng := rand.New(rand.NewPCG(44, 1))
+	deck := deck.New().Cards()
+	r := DefaultRuleset()
+	for range 5000 {
+		rng.Shuffle(len(deck), func(i, j int) {
+			deck[i], deck[j] = deck[j], deck[i]
+		})
+		a, _ := r.NewHand(append([]card.Card(nil), deck[:5]...))
+		b, _ := r.NewHand(append([]card.Card(nil), deck[5:10]...))
+		legacyA, legacyB := newHand(append([]card.Card(nil), deck[:5]...)), newHand(append([]card.Card(nil), deck[5:10]...))
+		if a.Rank() != legacyA.Rank() || a.String() != legacyA.String() || a.Compare(b) != legacyA.Compare(legacyB) {
+			t.Fatalf("%s vs %s: ruleset disagrees with ParseHand", legacyA, legacyB)
+		}
+	}
+}
+
+func TestRulesets(t *testing.T) {
+	cases := []struct {
+		description string
+		ruleset     *Ruleset
+		// hands from best to worst
+		hands []string
+		ranks []eval.HandRank
+	}{
+		{
+			"short deck flush beats full house",
+			ShortDeckRuleset(),
+			[]string{"6♡ 8♡ 10♡ Q♡ A♡", "7♡ 7♧ 7♤ 9♢ 9♧"},
+			[]eval.HandRank{eval.FLUSH, eval.FULL_HOUSE},
+		},
+		{
+			"short deck trips beat straights, A-6-7-8-9 the lowest",
+			ShortDeckRuleset(),
+			[]string{"6♡ 6♧ 6♤ 9♢ K♧", "6♢ 7♧ 8♤ 9♧ 10♡", "A♡ 6♧ 7♤ 8♢ 9♡"},
+			[]eval.HandRank{eval.THREE_OF_A_KIND, eval.STRAIGHT, eval.STRAIGHT},
+		},
+		{
+			"Kansas City lowball",
+			KansasCityRuleset(),
+			[]string{"7♡ 5♧ 4♤ 3♢ 2♧", "8♡ 6♧ 4♤ 3♢ 2♧", "K♡ Q♧ J♤ 9♢ 8♧", "A♡ 5♧ 4♤ 3♢ 2♧", "2♡ 2♧ 4♤ 5♢ 7♧", "6♡ 5♧ 4♤ 3♢ 2♧", "7♡ 5♡ 4♡ 3♡ 2♡"},
+			[]eval.HandRank{eval.HIGH_CARD, eval.HIGH_CARD, eval.HIGH_CARD, eval.HIGH_CARD, eval.PAIR, eval.STRAIGHT, eval.FLUSH},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			hands := rulesetHands(t, tc.ruleset, tc.hands...)
+			for i, hand := range hands {
+				if hand.Rank() != tc.ranks[i] {
+					t.Errorf("%s: expected %s, got %s", hand, tc.ranks[i], hand.Rank())
+				}
+				if i > 0 && hands[i-1].Compare(hand) <= 0 {
+					t.Errorf("expected %s to beat %s", hands[i-1], hand)
+				}
+			}
+		})
+	}
+}
+
+func TestRulesetKickers(t *testing.T) {
+	r := DefaultRuleset()
+	r.Kickers = NO_KICKERS
+	hands := rulesetHands(t, r, "K♡ K♧ 9♤ 5♢ 2♧", "K♤ K♢ A♤ 5♧ 2♢", "Q♡ Q♧ A♡ 5♡ 3♧", "6♡ 7♧ 8♤ 9♢ 10♧", "5♡ 6♧ 7♤ 8♢ 9♧")
+	if c := hands[0].Compare(hands[1]); c != 0 {
+		t.Errorf("expected kickers not to play, got %d", c)
+	}
+	if hands[0].Compare(hands[2]) <= 0 || hands[3].Compare(hands[4]) <= 0 {
+		t.Error("expected the made cards to decide")
+	}
+}
+
+func TestSokoRulesetKickers(t *testing.T) {
+	r := SokoRuleset()
+	r.Kickers = NO_KICKERS
+	hands := rulesetHands(t, r, "K♡ 6♡ 3♡ 2♡ 7♧", "Q♤ 6♤ 3♤ 2♤ 7♢", "K♢ 6♢ 3♢ 2♢ 5♧", "8♡ 9♧ 10♤ J♢ 2♧", "8♧ 9♤ 10♢ J♡ 4♡", "7♤ 8♤ 9♡ 10♧ K♤")
+	if hands[0].Compare(hands[1]) <= 0 || hands[3].Compare(hands[5]) <= 0 {
+		t.Error("expected the made cards to decide")
+	}
+	if c := hands[0].Compare(hands[2]); c != 0 {
+		t.Errorf("expected the four-flush kicker not to play, got %d", c)
+	}
+	if c := hands[3].Compare(hands[4]); c != 0 {
+		t.Errorf("expected the four-straight kicker not to play, got %d", c)
+	}
+}
+
+func TestRulesetsCompareAcrossRulesets(t *testing.T) {
+	soko := rulesetHands(t, SokoRuleset(), "K♡ K♧ A♤ 5♢ 2♧", "K♢ 6♢ 3♢ 2♢ 5♧")
+	standard := rulesetHands(t, DefaultRuleset(), "K♤ K♢ Q♤ 5♧ 2♢")
+	parsed, err := ParseHand("K♤ K♢ A♢ 5♧ 2♢")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if soko[0].Compare(standard[0]) <= 0 || standard[0].Compare(soko[0]) >= 0 {
+		t.Error("expected the kickers to decide between rulesets")
+	}
+	if c := soko[0].Compare(parsed); c != 0 {
+		t.Errorf("expected a tie with the parsed hand, got %d", c)
+	}
+	// By HandRank value a four-flush beats a pair, as it does not in Sökö.
+	if soko[1].Compare(standard[0]) <= 0 {
+		t.Error("expected HandRank order between rulesets")
+	}
+}
+
+func TestRulesetRejects(t *testing.T) {
+	pairsOnly := &Ruleset{Name: "Pairs only", Categories: []Category{PAIR_CATEGORY}}
+	cases := []struct {
+		ruleset *Ruleset
+		hand    string
+	}{
+		{ShortDeckRuleset(), "A♡ 2♧ 7♤ 8♢ 9♡"},
+		{DefaultRuleset(), "A♡ 2♧ 7♤ 8♢"},
+		{pairsOnly, "A♡ 2♧ 7♤ 8♢ 9♡"},
+	}
+	for _, tc := range cases {
+		if _, err := tc.ruleset.ParseHand(tc.hand); err == nil {
+			t.Errorf("%s: expected error for %s", tc.ruleset.Name, tc.hand)
+		}
+	}
+}
+
+func TestSokoCases(t *testing.T) {
+	r := SokoRuleset()
+	for _, tc := range sokoCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result, err := r.BestHand(tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(result, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestSokoRanks(t *testing.T) {
+	cases := []struct {
+		hand string
+		rank eval.HandRank
+	}{
+		{"2♡ 5♡ 8♡ J♡ 3♧", eval.FOUR_FLUSH},
+		{"6♢ 7♧ 8♤ 9♢ K♧", eval.FOUR_STRAIGHT},
+		{"6♢ 7♢ 8♢ 9♢ K♧", eval.FOUR_STRAIGHT},
+		{"6♢ 6♧ 8♢ 9♢ J♢", eval.FOUR_FLUSH},
+		{"6♢ 6♧ 8♢ 9♤ J♢", eval.PAIR},
+		{"6♢ 7♢ 8♢ 9♢ 10♧", eval.STRAIGHT},
+		{"2♡ 5♡ 8♡ J♡ K♡", eval.FLUSH},
+		{"2♡ 5♡ 8♤ J♡ K♧", eval.HIGH_CARD},
+	}
+	for _, tc := range cases {
+		hand := rulesetHands(t, SokoRuleset(), tc.hand)[0]
+		if hand.Rank() != tc.rank {
+			t.Errorf("%s: expected %s, got %s", tc.hand, tc.rank, hand.Rank())
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package poker
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+	"github.com/sdeboni/go-poker/internal/classify"
+)
+
+// ShowdownHand explains one hand's place in a showdown.
+type ShowdownHand struct {
+	// Index is the hand's position in the input.
+	Index int
+	Hand  string
+	Rank  eval.HandRank
+	// Position is the hand's place, starting at 1. Tied hands share a
+	// position and the next hand takes the following one.
+	Position int
+	// Made holds the cards making the hand's category, Kickers the rest,
+	// each highest first.
+	Made    []string
+	Kickers []string
+}
+
+type Showdown struct {
+	// Hands lists the hands from best to worst, tied hands in input order.
+	Hands []ShowdownHand
+	// Deciders[i] explains how Hands[i] compares to Hands[i+1], for instance
+	// "higher pair: Kings vs Queens" or "kicker: 9 vs 8".
+	Deciders []string
+}
+
+func NewShowdown(str []string) (*Showdown, error) {
+	hands, err := parseDistinctHands(str)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Showdown{}
+	var prev eval.Hand
+	for tier, indexes := range rankTiers(hands) {
+		for _, index := range indexes {
+			hand := hands[index]
+			if prev != nil {
+				s.Deciders = append(s.Deciders, decider(prev, hand))
+			}
+			prev = hand
+
+			made, kickers := madeAndKickers(hand)
+			s.Hands = append(s.Hands, ShowdownHand{
+				Index:    index,
+				Hand:     hand.String(),
+				Rank:     hand.Rank(),
+				Position: tier + 1,
+				Made:     cardStrings(made),
+				Kickers:  cardStrings(kickers),
+			})
+		}
+	}
+	return s, nil
+}
+
+// madeAndKickers splits a hand's cards into those making its category and
+// the kickers, each highest first.
+func madeAndKickers(hand eval.Hand) (made, kickers []card.Card) {
+	split := func(cards []card.Card, ranks ...card.Rank) ([]card.Card, []card.Card) {
+		var made, kickers []card.Card
+		for i := len(cards) - 1; i >= 0; i-- {
+			if slices.Contains(ranks, cards[i].Rank()) {
+				made = append(made, cards[i])
+			} else {
+				kickers = append(kickers, cards[i])
+			}
+		}
+		return made, kickers
+	}
+
+	switch h := classified(hand).(type) {
+	case *classify.HighCard:
+		cards := h.Cards()
+		return split(cards, cards[len(cards)-1].Rank())
+	case *classify.Pair:
+		return split(h.Cards(), h.PairRank())
+	case *classify.TwoPair:
+		ranks := h.PairRanks()
+		return split(h.Cards(), ranks[0], ranks[1])
+	case *classify.ThreeOfAKind:
+		return split(h.Cards(), h.TripletRank())
+	case *classify.FourOfAKind:
+		return split(h.Cards(), h.QuadRank())
+	case *classify.FourFlush:
+		_, made := split(h.Suited())
+		return made, []card.Card{h.Kicker()}
+	case *classify.FourStraight:
+		_, made := split(h.Run())
+		return made, []card.Card{h.Kicker()}
+	default:
+		_, all := split(hand.Cards())
+		return all, nil
+	}
+}
+
+// decider explains why better ranks above, or ties with, worse.
+func decider(better, worse eval.Hand) string {
+	if better.Rank() != worse.Rank() {
+		return fmt.Sprintf("%s beats %s", better.Rank(), worse.Rank())
+	}
+	if better.Compare(worse) == 0 {
+		return fmt.Sprintf("tie: identical %s", better.Rank())
+	}
+
+	switch b := better.(type) {
+	case *classify.HighCard:
+		return kickerDecider(better, worse, func(b, w card.Rank) string {
+			return fmt.Sprintf("high card: %s vs %s", rankName(b), rankName(w))
+		})
+	case *classify.Pair:
+		w := worse.(*classify.Pair)
+		if b.PairRank() != w.PairRank() {
+			return groupDecider("higher pair", b.PairRank(), w.PairRank())
+		}
+	case *classify.TwoPair:
+		br, wr := b.PairRanks(), worse.(*classify.TwoPair).PairRanks()
+		if br[0] != wr[0] {
+			return groupDecider("higher top pair", br[0], wr[0])
+		}
+		if br[1] != wr[1] {
+			return groupDecider("higher bottom pair", br[1], wr[1])
+		}
+	case *classify.ThreeOfAKind:
+		return groupDecider("higher three of a kind", b.TripletRank(), worse.(*classify.ThreeOfAKind).TripletRank())
+	case *classify.Straight:
+		return highDecider("higher straight", classify.StraightHighCard(b.Cards()), classify.StraightHighCard(worse.Cards()))
+	case *classify.Flush:
+		return kickerDecider(better, worse, func(b, w card.Rank) string {
+			return highDecider("higher flush", b, w)
+		})
+	case *classify.FullHouse:
+		w := worse.(*classify.FullHouse)
+		if b.Triplet() != w.Triplet() {
+			return groupDecider("higher three of a kind", b.Triplet(), w.Triplet())
+		}
+		return groupDecider("higher pair", b.Pair(), w.Pair())
+	case *classify.FourOfAKind:
+		w := worse.(*classify.FourOfAKind)
+		if b.QuadRank() != w.QuadRank() {
+			return groupDecider("higher four of a kind", b.QuadRank(), w.QuadRank())
+		}
+	case *classify.StraightFlush:
+		return highDecider("higher straight flush", classify.StraightHighCard(b.Cards()), classify.StraightHighCard(worse.Cards()))
+	}
+	return kickerDecider(better, worse, nil)
+}
+
+// kickerDecider names the highest kicker that differs. With top set, all
+// cards are compared and a difference in the top card is reported by top.
+func kickerDecider(better, worse eval.Hand, top func(better, worse card.Rank) string) string {
+	bm, bk := madeAndKickers(better)
+	wm, wk := madeAndKickers(worse)
+	if top != nil {
+		bk, wk = append(bm, bk...), append(wm, wk...)
+	}
+	for i := range bk {
+		if bk[i].Rank() != wk[i].Rank() {
+			if i == 0 && top != nil {
+				return top(bk[i].Rank(), wk[i].Rank())
+			}
+			return fmt.Sprintf("kicker: %s vs %s", bk[i].Rank().String(), wk[i].Rank().String())
+		}
+	}
+	return "tie"
+}
+
+func groupDecider(reason string, better, worse card.Rank) string {
+	return fmt.Sprintf("%s: %s vs %s", reason, rankPlural(better), rankPlural(worse))
+}
+
+func highDecider(reason string, better, worse card.Rank) string {
+	return fmt.Sprintf("%s: %s-high vs %s-high", reason, rankName(better), rankName(worse))
+}
+
+func rankName(rank card.Rank) string {
+	switch rank {
+	case card.TWO:
+		return "Two"
+	case card.THREE:
+		return "Three"
+	case card.FOUR:
+		return "Four"
+	case card.FIVE:
+		return "Five"
+	case card.SIX:
+		return "Six"
+	case card.SEVEN:
+		return "Seven"
+	case card.EIGHT:
+		return "Eight"
+	case card.NINE:
+		return "Nine"
+	case card.TEN:
+		return "Ten"
+	case card.JACK:
+		return "Jack"
+	case card.QUEEN:
+		return "Queen"
+	case card.KING:
+		return "King"
+	case card.ACE:
+		return "Ace"
+	default:
+		panic("invalid CardRank")
+	}
+}
+
+func rankPlural(rank card.Rank) string {
+	if rank == card.SIX {
+		return "Sixes"
+	}
+	return rankName(rank) + "s"
+}
+
+func cardStrings(cards []card.Card) []string {
+	var result []string
+	for _, c := range cards {
+		result = append(result, c.String())
+	}
+	return result
+}
@@ -0,0 +1,62 @@
+package poker
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/sdeboni/go-poker/eval"
+)
+
+func TestShowdownDeciders(t *testing.T) {
+	cases := []struct {
+		description string
+		input       []string
+		expected    string
+	}{
+		{"different categories", []string{"2♡ 3♤ 4♢ 5♢ 6♧", "A♡ A♤ A♢ K♧ Q♡"}, "straight beats three of a kind"},
+		{"higher pair", []string{"K♡ K♤ 2♢ 3♢ 4♧", "Q♡ Q♤ A♢ 9♢ 8♧"}, "higher pair: Kings vs Queens"},
+		{"pair kicker", []string{"6♡ 6♤ A♢ 9♢ 3♧", "6♢ 6♧ A♡ 8♤ 4♧"}, "kicker: 9 vs 8"},
+		{"high card", []string{"A♡ 7♤ 5♢ 3♢ 2♧", "K♡ Q♤ J♢ 9♢ 8♧"}, "high card: Ace vs King"},
+		{"high card kicker", []string{"A♡ 10♤ 5♢ 3♢ 2♧", "A♤ 9♡ 8♢ 7♢ 6♧"}, "kicker: 10 vs 9"},
+		{"bottom pair", []string{"K♡ K♤ 5♢ 5♧ 2♧", "K♢ K♧ 4♡ 4♤ A♧"}, "higher bottom pair: Fives vs Fours"},
+		{"wheel", []string{"2♡ 3♤ 4♢ 5♢ 6♧", "A♡ 2♤ 3♢ 4♧ 5♡"}, "higher straight: Six-high vs Five-high"},
+		{"flush", []string{"2♤ 3♤ 9♤ J♤ A♤", "4♡ 5♡ 6♡ J♡ A♡"}, "kicker: 9 vs 6"},
+		{"full house", []string{"3♤ 3♡ 3♢ 2♤ 2♡", "2♢ 2♧ 4♤ 4♡ 4♢"}, "higher three of a kind: Fours vs Threes"},
+		{"tie", []string{"A♡ K♤ Q♢ J♢ 10♧", "10♡ J♤ Q♧ K♧ A♢"}, "tie: identical straight"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			s, err := NewShowdown(tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(s.Deciders) != 1 || s.Deciders[0] != tc.expected {
+				t.Errorf("\nexpected: %s\ngot     : %v", tc.expected, s.Deciders)
+			}
+		})
+	}
+}
+
+func TestShowdownHands(t *testing.T) {
+	s, err := NewShowdown([]string{"5♢ 2♡ 8♡ 7♡ J♡", "3♢ 3♧ 9♤ 4♤ 5♤", "3♡ 3♤ 9♧ 4♧ 5♧"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []ShowdownHand{
+		{Index: 1, Hand: "3♢ 3♧ 9♤ 4♤ 5♤", Rank: eval.PAIR, Position: 1, Made: []string{"3♧", "3♢"}, Kickers: []string{"9♤", "5♤", "4♤"}},
+		{Index: 2, Hand: "3♡ 3♤ 9♧ 4♧ 5♧", Rank: eval.PAIR, Position: 1, Made: []string{"3♤", "3♡"}, Kickers: []string{"9♧", "5♧", "4♧"}},
+		{Index: 0, Hand: "5♢ 2♡ 8♡ 7♡ J♡", Rank: eval.HIGH_CARD, Position: 2, Made: []string{"J♡"}, Kickers: []string{"8♡", "7♡", "5♢", "2♡"}},
+	}
+	for i, hand := range s.Hands {
+		e := expected[i]
+		if hand.Index != e.Index || hand.Hand != e.Hand || hand.Rank != e.Rank || hand.Position != e.Position ||
+			!slices.Equal(hand.Made, e.Made) || !slices.Equal(hand.Kickers, e.Kickers) {
+			t.Errorf("\nexpected: %+v\ngot     : %+v", e, hand)
+		}
+	}
+	if !slices.Equal(s.Deciders, []string{"tie: identical pair", "pair beats high card"}) {
+		t.Errorf("unexpected deciders: %v", s.Deciders)
+	}
+}
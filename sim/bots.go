@@ -0,0 +1,70 @@
+package sim
+
+import (
+	"math/rand/v2"
+	"slices"
+
+	"github.com/sdeboni/go-poker/eval"
+	"github.com/sdeboni/go-poker/holdem"
+	"github.com/sdeboni/go-poker/table"
+)
+
+// prefer returns the first of the actions that is legal.
+func prefer(legal []table.ActionType, actions ...table.ActionType) table.ActionType {
+	for _, action := range actions {
+		if slices.Contains(legal, action) {
+			return action
+		}
+	}
+	return legal[0]
+}
+
+// RandomBot picks any legal action with equal chance.
+type RandomBot struct {
+	rng *rand.Rand
+}
+
+func NewRandomBot(seed uint64) *RandomBot {
+	return &RandomBot{rand.New(rand.NewPCG(seed, 0))}
+}
+
+func (b *RandomBot) Act(state *State) table.ActionType {
+	return state.Legal[b.rng.IntN(len(state.Legal))]
+}
+
+// CallingStation never folds, bets or raises.
+type CallingStation struct{}
+
+func (CallingStation) Act(state *State) table.ActionType {
+	return prefer(state.Legal, table.CHECK, table.CALL)
+}
+
+// TightAggressive bets and raises with strong hands and otherwise checks or
+// folds. Before the flop a hand is strong when its all-in equity against a
+// random holding reaches PreflopEquity; after, when the hole cards make a
+// hand of MadeHand or better that beats the board's.
+type TightAggressive struct {
+	PreflopEquity float64
+	MadeHand      eval.HandRank
+}
+
+// NewTightAggressive plays about the best fifth of starting hands and any
+// pair or better of its own after the flop.
+func NewTightAggressive() TightAggressive {
+	return TightAggressive{PreflopEquity: 0.58, MadeHand: eval.PAIR}
+}
+
+func (b TightAggressive) Act(state *State) table.ActionType {
+	var strong bool
+	if state.Street == holdem.PREFLOP {
+		strong = holdem.PreflopEquity(state.Hole.Class()) >= b.PreflopEquity
+	} else {
+		hand := eval.NewCardSet(append(state.Hole[:], state.Board...)...).Evaluate()
+		board := eval.NewCardSet(state.Board...).Evaluate()
+		strong = hand.Rank() >= b.MadeHand && hand.Rank() > board.Rank()
+	}
+	if strong {
+		return prefer(state.Legal, table.BET, table.RAISE, table.CALL, table.CHECK)
+	}
+	return prefer(state.Legal, table.CHECK, table.FOLD)
+}
@@ -0,0 +1,65 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/holdem"
+	"github.com/sdeboni/go-poker/table"
+)
+
+func mustCards(t *testing.T, str string) []card.Card {
+	t.Helper()
+	hand, err := holdem.ParseHoleCards(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hand[:]
+}
+
+func TestTightAggressive(t *testing.T) {
+	bot := NewTightAggressive()
+	cases := []struct {
+		description string
+		hole        string
+		board       []string
+		legal       []table.ActionType
+		expected    table.ActionType
+	}{
+		{"raises aces", "A♡ A♤", nil, []table.ActionType{table.FOLD, table.CALL, table.RAISE}, table.RAISE},
+		{"folds seven deuce", "7♡ 2♤", nil, []table.ActionType{table.FOLD, table.CALL, table.RAISE}, table.FOLD},
+		{"checks its option with seven deuce", "7♡ 2♤", nil, []table.ActionType{table.CHECK, table.RAISE}, table.CHECK},
+		{"bets top pair", "K♡ Q♤", []string{"K♢ 7♧", "3♤ 9♡"}, []table.ActionType{table.CHECK, table.BET}, table.BET},
+		{"calls a capped pot", "K♡ Q♤", []string{"K♢ 7♧", "3♤ 9♡"}, []table.ActionType{table.FOLD, table.CALL}, table.CALL},
+		{"folds to a bet holding only the board's pair", "A♡ Q♤", []string{"7♢ 7♧", "3♤ 9♡"}, []table.ActionType{table.FOLD, table.CALL, table.RAISE}, table.FOLD},
+	}
+
+	for _, tc := range cases {
+		state := &State{Street: holdem.PREFLOP, Legal: tc.legal}
+		state.Hole = holdem.HoleCards(mustCards(t, tc.hole))
+		for _, cards := range tc.board {
+			state.Board = append(state.Board, mustCards(t, cards)...)
+		}
+		if len(state.Board) > 0 {
+			state.Street = holdem.FLOP
+		}
+		if got := bot.Act(state); got != tc.expected {
+			t.Errorf("%s: expected %s, got %s", tc.description, tc.expected, got)
+		}
+	}
+}
+
+func TestBotsChooseLegalActions(t *testing.T) {
+	legal := []table.ActionType{table.FOLD, table.CALL}
+	random := NewRandomBot(1)
+	seen := make(map[table.ActionType]bool)
+	for range 100 {
+		seen[random.Act(&State{Legal: legal})] = true
+	}
+	if len(seen) != 2 {
+		t.Errorf("expected the random bot to fold and call, got %v", seen)
+	}
+	if got := (CallingStation{}).Act(&State{Legal: []table.ActionType{table.FOLD, table.CALL, table.RAISE}}); got != table.CALL {
+		t.Errorf("expected the calling station to call, got %s", got)
+	}
+}
@@ -0,0 +1,419 @@
+// Package sim plays fixed-limit hold'em between automated players, to stress
+// the rules and compare strategies over many seeded hands. Every player
+// starts each hand with the same stack, so hands are independent samples.
+package sim
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/sdeboni/go-poker"
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/deck"
+	"github.com/sdeboni/go-poker/eval"
+	"github.com/sdeboni/go-poker/holdem"
+	"github.com/sdeboni/go-poker/stats"
+	"github.com/sdeboni/go-poker/table"
+)
+
+// MAX_BETS caps the bets and raises on a street; the big blind is the first
+// bet before the flop.
+const MAX_BETS = 4
+
+// State is what a player sees when it is their turn to act.
+type State struct {
+	Seat   int
+	Hole   holdem.HoleCards
+	Board  []card.Card
+	Street holdem.Street
+	// Pot counts every chip put in so far, this street's bets included.
+	Pot int
+	// ToCall and Raise are the chips a call and a bet or raise put in, less
+	// when the player's stack does not cover them.
+	ToCall int
+	Raise  int
+	Stack  int
+	// Players is the number of players who have not folded.
+	Players int
+	Legal   []table.ActionType
+	// Actions are the hand's actions so far.
+	Actions []table.Action
+}
+
+// Player is a strategy. Act must return one of the state's legal actions.
+type Player interface {
+	Act(state *State) table.ActionType
+}
+
+type Seat struct {
+	ID     poker.PlayerID
+	Player Player
+}
+
+type Config struct {
+	SmallBlind int
+	// BigBlind is also the small bet, made before the flop and on the flop;
+	// the big bet on the turn and river is twice as much.
+	BigBlind int
+	Stack    int
+	Version  deck.ShuffleVersion
+}
+
+func (c Config) validate(seats int) error {
+	if seats < 2 || 2*seats+holdem.BOARD_SIZE > card.DECK_SIZE {
+		return fmt.Errorf("invalid number of seats: %d", seats)
+	}
+	if c.SmallBlind <= 0 || c.BigBlind < c.SmallBlind {
+		return fmt.Errorf("invalid blinds: %d/%d", c.SmallBlind, c.BigBlind)
+	}
+	if c.Stack < c.BigBlind {
+		return fmt.Errorf("stack %d does not cover the big blind", c.Stack)
+	}
+	return nil
+}
+
+type BotResult struct {
+	ID  poker.PlayerID
+	Net int
+	// BBPer100 is the win rate in big blinds per hundred hands and CI95 the
+	// half width of its 95% confidence interval.
+	BBPer100, CI95 float64
+}
+
+type Report struct {
+	Hands int
+	Bots  []BotResult
+}
+
+// Run plays hands hands, dealing hand i from seed+i with the button on seat
+// i modulo the number of seats, and reports each seat's winnings. observe,
+// if not nil, receives the record of every hand, as stats.Tracker takes it.
+func Run(seats []Seat, config Config, hands int, seed uint64, observe func(stats.HandRecord)) (*Report, error) {
+	if err := config.validate(len(seats)); err != nil {
+		return nil, err
+	}
+	if hands <= 0 {
+		return nil, fmt.Errorf("invalid number of hands: %d", hands)
+	}
+	ids := make(map[poker.PlayerID]bool)
+	for _, seat := range seats {
+		if ids[seat.ID] {
+			return nil, fmt.Errorf("duplicate player: %s", seat.ID)
+		}
+		ids[seat.ID] = true
+	}
+
+	sums := make([]float64, len(seats))
+	squares := make([]float64, len(seats))
+	report := &Report{Hands: hands}
+	for _, seat := range seats {
+		report.Bots = append(report.Bots, BotResult{ID: seat.ID})
+	}
+	for i := range hands {
+		record, err := PlayHand(seats, config, i%len(seats), seed+uint64(i))
+		if err != nil {
+			return nil, fmt.Errorf("hand %d: %w", i, err)
+		}
+		for j, seat := range seats {
+			net := record.Net[seat.ID]
+			report.Bots[j].Net += net
+			bb := float64(net) / float64(config.BigBlind)
+			sums[j] += bb
+			squares[j] += bb * bb
+		}
+		if observe != nil {
+			observe(record)
+		}
+	}
+
+	n := float64(hands)
+	for j := range report.Bots {
+		mean := sums[j] / n
+		var variance float64
+		if hands > 1 {
+			variance = max(squares[j]-n*mean*mean, 0) / (n - 1)
+		}
+		report.Bots[j].BBPer100 = mean * 100
+		report.Bots[j].CI95 = 1.96 * math.Sqrt(variance/n) * 100
+	}
+	return report, nil
+}
+
+// handState is the state of one hand.
+type handState struct {
+	seats   []Seat
+	config  Config
+	holes   []holdem.HoleCards
+	board   []card.Card
+	stacks  []int
+	bets    []int
+	totals  []int
+	folded  []bool
+	actions []table.Action
+}
+
+// PlayHand plays one hand dealt from seed with the button on seat button.
+func PlayHand(seats []Seat, config Config, button int, seed uint64) (stats.HandRecord, error) {
+	if err := config.validate(len(seats)); err != nil {
+		return stats.HandRecord{}, err
+	}
+	if button < 0 || button >= len(seats) {
+		return stats.HandRecord{}, fmt.Errorf("invalid button seat: %d", button)
+	}
+	deck, err := deck.NewShuffled(seed, config.Version)
+	if err != nil {
+		return stats.HandRecord{}, err
+	}
+
+	n := len(seats)
+	t := &handState{
+		seats:  seats,
+		config: config,
+		holes:  make([]holdem.HoleCards, n),
+		stacks: make([]int, n),
+		bets:   make([]int, n),
+		totals: make([]int, n),
+		folded: make([]bool, n),
+	}
+	for i := range seats {
+		cards, err := deck.Deal(2)
+		if err != nil {
+			return stats.HandRecord{}, err
+		}
+		t.holes[i] = holdem.HoleCards(cards)
+		t.stacks[i] = config.Stack
+	}
+
+	smallBlind := (button + 1) % n
+	if n == 2 {
+		smallBlind = button
+	}
+	bigBlind := (smallBlind + 1) % n
+	t.put(smallBlind, config.SmallBlind)
+	t.put(bigBlind, config.BigBlind)
+
+	for street := holdem.PREFLOP; street <= holdem.RIVER && t.live() > 1; street++ {
+		if err := t.dealBoard(deck, street); err != nil {
+			return stats.HandRecord{}, err
+		}
+		first := (button + 1) % n
+		if street == holdem.PREFLOP {
+			first = (bigBlind + 1) % n
+		}
+		if err := t.bettingRound(street, first); err != nil {
+			return stats.HandRecord{}, err
+		}
+	}
+	if t.live() > 1 {
+		if err := t.dealBoard(deck, holdem.RIVER); err != nil {
+			return stats.HandRecord{}, err
+		}
+	}
+
+	payouts, err := t.settle(button)
+	if err != nil {
+		return stats.HandRecord{}, err
+	}
+	record := stats.HandRecord{
+		Button:     button,
+		BigBlind:   config.BigBlind,
+		Actions:    t.actions,
+		BoardCards: len(t.board),
+		Net:        make(map[poker.PlayerID]int),
+	}
+	for i, seat := range seats {
+		record.Players = append(record.Players, seat.ID)
+		if net := payouts[seat.ID] - t.totals[i]; net != 0 {
+			record.Net[seat.ID] = net
+		}
+		if t.live() > 1 && !t.folded[i] {
+			record.Showdown = append(record.Showdown, seat.ID)
+		}
+	}
+	return record, nil
+}
+
+var boardSizes = [...]int{holdem.FLOP: 3, holdem.TURN: 4, holdem.RIVER: holdem.BOARD_SIZE}
+
+// dealBoard deals the board up to the street.
+func (t *handState) dealBoard(deck *deck.Deck, street holdem.Street) error {
+	size := boardSizes[street]
+	if len(t.board) >= size {
+		return nil
+	}
+	cards, err := deck.Deal(size - len(t.board))
+	if err != nil {
+		return err
+	}
+	t.board = append(t.board, cards...)
+	return nil
+}
+
+// put moves up to amount chips from the seat's stack to its bet.
+func (t *handState) put(seat, amount int) {
+	amount = min(amount, t.stacks[seat])
+	t.stacks[seat] -= amount
+	t.bets[seat] += amount
+	t.totals[seat] += amount
+}
+
+func (t *handState) live() int {
+	var live int
+	for _, folded := range t.folded {
+		if !folded {
+			live++
+		}
+	}
+	return live
+}
+
+// bettingRound runs a street's betting from seat first until every player
+// who can still act has acted since the last raise and matched it.
+func (t *handState) bettingRound(street holdem.Street, first int) error {
+	unit := t.config.BigBlind
+	if street >= holdem.TURN {
+		unit *= 2
+	}
+	bets := 0
+	if street == holdem.PREFLOP {
+		bets = 1
+	}
+	currentBet := slices.Max(t.bets)
+	acted := make([]bool, len(t.seats))
+
+	for seat := first; t.live() > 1; seat = (seat + 1) % len(t.seats) {
+		// The round is over when everyone who can act has matched the bet,
+		// and has acted unless no one else can.
+		matched, waiting, canAct := true, false, 0
+		for i := range t.seats {
+			if !t.folded[i] && t.stacks[i] > 0 {
+				canAct++
+				matched = matched && t.bets[i] >= currentBet
+				waiting = waiting || !acted[i]
+			}
+		}
+		if matched && (!waiting || canAct <= 1) {
+			break
+		}
+		if t.folded[seat] || t.stacks[seat] == 0 {
+			continue
+		}
+
+		toCall := currentBet - t.bets[seat]
+		state := t.state(seat, street)
+		state.ToCall = min(toCall, t.stacks[seat])
+		state.Raise = min(toCall+unit, t.stacks[seat])
+		switch {
+		case toCall > 0:
+			state.Legal = []table.ActionType{table.FOLD, table.CALL}
+			if bets < MAX_BETS && t.stacks[seat] > toCall {
+				state.Legal = append(state.Legal, table.RAISE)
+			}
+		case bets >= MAX_BETS:
+			state.Legal = []table.ActionType{table.CHECK}
+		case currentBet > 0:
+			state.Legal = []table.ActionType{table.CHECK, table.RAISE}
+		default:
+			state.Legal = []table.ActionType{table.CHECK, table.BET}
+		}
+
+		action := t.seats[seat].Player.Act(state)
+		if !slices.Contains(state.Legal, action) {
+			return fmt.Errorf("player %s chose %s, not one of %v", t.seats[seat].ID, action, state.Legal)
+		}
+		before := t.totals[seat]
+		acted[seat] = true
+		switch action {
+		case table.FOLD:
+			t.folded[seat] = true
+		case table.CALL:
+			t.put(seat, toCall)
+		case table.BET, table.RAISE:
+			t.put(seat, toCall+unit)
+			currentBet = max(currentBet, t.bets[seat])
+			bets++
+			for i := range acted {
+				acted[i] = i == seat
+			}
+		}
+		t.actions = append(t.actions, table.Action{Seat: seat, Type: action, Amount: t.totals[seat] - before, Street: street})
+	}
+
+	for i := range t.bets {
+		t.bets[i] = 0
+	}
+	return nil
+}
+
+func (t *handState) state(seat int, street holdem.Street) *State {
+	var pot int
+	for _, total := range t.totals {
+		pot += total
+	}
+	return &State{
+		Seat:    seat,
+		Hole:    t.holes[seat],
+		Board:   slices.Clone(t.board),
+		Street:  street,
+		Pot:     pot,
+		Stack:   t.stacks[seat],
+		Players: t.live(),
+		Actions: slices.Clone(t.actions),
+	}
+}
+
+// rules makes the five-card hands compared at showdown.
+var rules = poker.DefaultRuleset()
+
+// settle awards the pots and returns each player's payout.
+func (t *handState) settle(button int) (map[poker.PlayerID]int, error) {
+	if t.live() == 1 {
+		var pot int
+		for _, total := range t.totals {
+			pot += total
+		}
+		winner := slices.Index(t.folded, false)
+		return map[poker.PlayerID]int{t.seats[winner].ID: pot}, nil
+	}
+
+	players := make([]table.PotPlayer, len(t.seats))
+	for i, seat := range t.seats {
+		players[i] = table.PotPlayer{ID: seat.ID, Contribution: t.totals[i], Folded: t.folded[i]}
+		if !t.folded[i] {
+			hand, err := bestHand(append(t.holes[i][:], t.board...))
+			if err != nil {
+				return nil, err
+			}
+			players[i].Hand = hand
+		}
+	}
+	settlement, err := table.SettlePots(players, button, table.PotRules{OddChip: table.LEFT_OF_BUTTON, SharedCards: true})
+	if err != nil {
+		return nil, err
+	}
+	return settlement.Payouts, nil
+}
+
+// bestHand picks the best five of seven cards.
+func bestHand(cards []card.Card) (eval.Hand, error) {
+	var best eval.Hand
+	for skipA := range cards {
+		for skipB := skipA + 1; skipB < len(cards); skipB++ {
+			var five []card.Card
+			for i, c := range cards {
+				if i != skipA && i != skipB {
+					five = append(five, c)
+				}
+			}
+			hand, err := rules.NewHand(five)
+			if err != nil {
+				return nil, err
+			}
+			if best == nil || hand.Compare(best) > 0 {
+				best = hand
+			}
+		}
+	}
+	return best, nil
+}
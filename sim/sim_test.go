@@ -0,0 +1,139 @@
+package sim
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sdeboni/go-poker/deck"
+	"github.com/sdeboni/go-poker/holdem"
+	"github.com/sdeboni/go-poker/stats"
+	"github.com/sdeboni/go-poker/table"
+)
+
+var config = Config{SmallBlind: 5, BigBlind: 10, Stack: 1000, Version: deck.SHUFFLE_V1}
+
+// maniac raises whenever it can.
+type maniac struct{}
+
+func (maniac) Act(state *State) table.ActionType {
+	return prefer(state.Legal, table.RAISE, table.BET, table.CALL)
+}
+
+// cheater bets whatever it is allowed to do.
+type cheater struct{}
+
+func (cheater) Act(*State) table.ActionType {
+	return table.BET
+}
+
+func TestPlayHandCapsBets(t *testing.T) {
+	seats := []Seat{{"a", maniac{}}, {"b", maniac{}}}
+	for seed := range uint64(20) {
+		record, err := PlayHand(seats, config, 0, seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Four bets a street, the big blind the first before the flop, small
+		// bets twice and big bets twice.
+		if net := record.Net["a"]; net != 0 && net != 240 && net != -240 {
+			t.Errorf("seed %d: expected a to win or lose 240 or split, got %d", seed, net)
+		}
+		if len(record.Actions) != 4+3*5 || record.BoardCards != holdem.BOARD_SIZE || len(record.Showdown) != 2 {
+			t.Errorf("seed %d: expected capped betting to showdown, got %+v", seed, record)
+		}
+	}
+}
+
+func TestPlayHandAllIn(t *testing.T) {
+	short := config
+	short.Stack = 35
+	seats := []Seat{{"a", maniac{}}, {"b", maniac{}}, {"c", CallingStation{}}}
+	for seed := range uint64(50) {
+		record, err := PlayHand(seats, short, int(seed%3), seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var total int
+		for id, net := range record.Net {
+			if net < -short.Stack {
+				t.Errorf("seed %d: %s lost %d with a stack of %d", seed, id, net, short.Stack)
+			}
+			total += net
+		}
+		if total != 0 {
+			t.Errorf("seed %d: chips not conserved: %v", seed, record.Net)
+		}
+	}
+}
+
+func TestPlayHandRejectsIllegalAction(t *testing.T) {
+	_, err := PlayHand([]Seat{{"a", cheater{}}, {"b", CallingStation{}}}, config, 0, 1)
+	if err == nil || !strings.Contains(err.Error(), "player a chose bet") {
+		t.Errorf("expected the illegal bet to be rejected, got %v", err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	seats := []Seat{
+		{"tag", NewTightAggressive()},
+		{"station", CallingStation{}},
+		{"random", NewRandomBot(7)},
+	}
+	tracker := stats.NewTracker()
+	var chips int
+	report, err := Run(seats, config, 3000, 42, func(record stats.HandRecord) {
+		if err := tracker.Add(record); err != nil {
+			t.Fatal(err)
+		}
+		for _, net := range record.Net {
+			chips += net
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chips != 0 {
+		t.Errorf("expected chips to be conserved, %d were not", chips)
+	}
+
+	tag := report.Bots[0]
+	if tag.BBPer100-tag.CI95 <= 0 {
+		t.Errorf("expected the tight-aggressive bot to win significantly, got %+v", report.Bots)
+	}
+	station, _ := tracker.Stats("station")
+	if station.Hands != 3000 || station.PFR() != 0 || station.WTSD() < 0.5 {
+		t.Errorf("expected the calling station never to raise and to go to showdown often, got %+v", station.Counts)
+	}
+
+	seats[2].Player = NewRandomBot(7)
+	again, err := Run(seats, config, 3000, 42, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(report, again) {
+		t.Error("expected the same seeds to replay the same results")
+	}
+}
+
+func TestRunRejects(t *testing.T) {
+	seats := []Seat{{"a", CallingStation{}}, {"b", CallingStation{}}}
+	cases := []struct {
+		description string
+		seats       []Seat
+		config      Config
+		hands       int
+	}{
+		{"one seat", seats[:1], config, 1},
+		{"duplicate player", []Seat{seats[0], seats[0]}, config, 1},
+		{"no hands", seats, config, 0},
+		{"stack below the big blind", seats, Config{SmallBlind: 5, BigBlind: 10, Stack: 5}, 1},
+		{"small blind above the big blind", seats, Config{SmallBlind: 20, BigBlind: 10, Stack: 100}, 1},
+	}
+
+	for _, tc := range cases {
+		if _, err := Run(tc.seats, tc.config, tc.hands, 1, nil); err == nil {
+			t.Errorf("%s: expected error", tc.description)
+		}
+	}
+}
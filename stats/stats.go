@@ -0,0 +1,414 @@
+// Package stats aggregates the standard player statistics of hold'em
+// trackers from hand records, whether reported by a table engine or parsed
+// from hand histories. A Tracker takes one hand at a time, so the statistics
+// can be kept live.
+package stats
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/sdeboni/go-poker"
+	"github.com/sdeboni/go-poker/holdem"
+	"github.com/sdeboni/go-poker/table"
+)
+
+// Position is a seat's place relative to the button.
+type Position int
+
+const (
+	// EARLY is the first half, rounded up, of the seats between the big
+	// blind and the cutoff, MIDDLE the rest.
+	EARLY Position = iota + 1
+	MIDDLE
+	CUTOFF
+	BUTTON
+	SMALL_BLIND
+	BIG_BLIND
+)
+
+func (p Position) String() string {
+	switch p {
+	case EARLY:
+		return "early"
+	case MIDDLE:
+		return "middle"
+	case CUTOFF:
+		return "cutoff"
+	case BUTTON:
+		return "button"
+	case SMALL_BLIND:
+		return "small blind"
+	case BIG_BLIND:
+		return "big blind"
+	default:
+		return fmt.Sprintf("Position(%d)", int(p))
+	}
+}
+
+// HandRecord is one hand as the statistics need it.
+type HandRecord struct {
+	// Players are in seat order. The two seats after the button post the
+	// blinds; heads up the button posts the small blind.
+	Players  []poker.PlayerID
+	Button   int
+	BigBlind int
+	// Actions are the voluntary actions in the order they were taken, their
+	// seats indexing Players; posting blinds and antes is not one.
+	Actions []table.Action
+	// BoardCards is how many board cards were dealt, 3 or more when the
+	// hand saw a flop.
+	BoardCards int
+	// Showdown lists the players who showed their hands at the end.
+	Showdown []poker.PlayerID
+	// Net is each player's result in chips, missing for zero.
+	Net map[poker.PlayerID]int
+}
+
+// Positions returns the position of each seat.
+func (h *HandRecord) Positions() []Position {
+	n := len(h.Players)
+	positions := make([]Position, n)
+	seat := func(offset int) int {
+		return ((h.Button+offset)%n + n) % n
+	}
+	if n == 2 {
+		positions[seat(0)] = BUTTON
+		positions[seat(1)] = BIG_BLIND
+		return positions
+	}
+	positions[seat(0)] = BUTTON
+	positions[seat(1)] = SMALL_BLIND
+	positions[seat(2)] = BIG_BLIND
+	if n > 3 {
+		positions[seat(-1)] = CUTOFF
+	}
+	between := max(n-4, 0)
+	for i := range between {
+		positions[seat(3+i)] = EARLY
+		if i >= (between+1)/2 {
+			positions[seat(3+i)] = MIDDLE
+		}
+	}
+	return positions
+}
+
+func (h *HandRecord) validate() error {
+	if len(h.Players) < 2 {
+		return fmt.Errorf("invalid hand: %d players", len(h.Players))
+	}
+	if h.Button < 0 || h.Button >= len(h.Players) {
+		return fmt.Errorf("invalid button seat: %d", h.Button)
+	}
+	if h.BigBlind <= 0 {
+		return fmt.Errorf("invalid big blind: %d", h.BigBlind)
+	}
+	seated := make(map[poker.PlayerID]bool)
+	for _, player := range h.Players {
+		if seated[player] {
+			return fmt.Errorf("player %s seated twice", player)
+		}
+		seated[player] = true
+	}
+	street := holdem.PREFLOP
+	for _, action := range h.Actions {
+		if action.Seat < 0 || action.Seat >= len(h.Players) {
+			return fmt.Errorf("action by seat %d not in the hand", action.Seat)
+		}
+		player := h.Players[action.Seat]
+		if action.Street < street || action.Street > holdem.RIVER {
+			return fmt.Errorf("action by player %s on street %d out of order", player, action.Street)
+		}
+		if action.Type < table.FOLD || action.Type > table.RAISE {
+			return fmt.Errorf("invalid action by player %s: %s", player, action.Type)
+		}
+		street = action.Street
+	}
+	for _, player := range h.Showdown {
+		if !seated[player] {
+			return fmt.Errorf("player %s at showdown not in the hand", player)
+		}
+	}
+	for player := range h.Net {
+		if !seated[player] {
+			return fmt.Errorf("result for player %s not in the hand", player)
+		}
+	}
+	return nil
+}
+
+// FromTranscript turns a recorded hold'em hand into a hand record.
+// Transcripts keep neither the button nor the blinds, so they are given: the
+// two seats after the button post smallBlind and bigBlind, heads up the
+// button posts the small blind. Each action puts in its Amount, and the
+// winners split the pot, the odd chips going to the first of them in seat
+// order. When more than one seat is left, every seat still in shows down.
+func FromTranscript(t *table.Transcript, button, smallBlind, bigBlind int) (HandRecord, error) {
+	if t.Game != table.HOLDEM {
+		return HandRecord{}, fmt.Errorf("not a hold'em transcript: game %d", t.Game)
+	}
+	hand := HandRecord{
+		Button:     button,
+		BigBlind:   bigBlind,
+		Actions:    slices.Clone(t.Actions),
+		BoardCards: len(strings.Fields(t.Board)),
+		Net:        make(map[poker.PlayerID]int),
+	}
+	for _, seat := range t.Seats {
+		hand.Players = append(hand.Players, poker.PlayerID(seat))
+	}
+	if err := hand.validate(); err != nil {
+		return HandRecord{}, err
+	}
+
+	n := len(t.Seats)
+	put := make([]int, n)
+	if n == 2 {
+		put[button] += smallBlind
+		put[(button+1)%n] += bigBlind
+	} else {
+		put[(button+1)%n] += smallBlind
+		put[(button+2)%n] += bigBlind
+	}
+	folded := make([]bool, n)
+	for _, action := range t.Actions {
+		put[action.Seat] += action.Amount
+		if action.Type == table.FOLD {
+			folded[action.Seat] = true
+		}
+	}
+
+	var pot int
+	var winners, live []int
+	for seat, id := range t.Seats {
+		pot += put[seat]
+		if !folded[seat] {
+			live = append(live, seat)
+			if slices.Contains(t.Winners, id) {
+				winners = append(winners, seat)
+			}
+		}
+	}
+	if len(winners) == 0 {
+		return HandRecord{}, fmt.Errorf("no winner among the seats left in the hand")
+	}
+	if len(live) > 1 {
+		for _, seat := range live {
+			hand.Showdown = append(hand.Showdown, hand.Players[seat])
+		}
+	}
+	won := make([]int, n)
+	for i, seat := range winners {
+		won[seat] = pot / len(winners)
+		if i < pot%len(winners) {
+			won[seat]++
+		}
+	}
+	for seat, player := range hand.Players {
+		if net := won[seat] - put[seat]; net != 0 {
+			hand.Net[player] = net
+		}
+	}
+	return hand, nil
+}
+
+// Counts are the running totals behind the statistics.
+type Counts struct {
+	Hands int
+	// VoluntarilyPutIn counts hands where the player called or raised
+	// before the flop, PreflopRaised those where they raised.
+	VoluntarilyPutIn int
+	PreflopRaised    int
+	// ThreeBetChances counts hands where the player acted before the flop
+	// facing exactly one raise over the big blind.
+	ThreeBetChances int
+	ThreeBets       int
+	// Aggressive counts bets and raises after the flop, Calls the calls.
+	Aggressive int
+	Calls      int
+	SawFlop    int
+	// WentToShowdown counts hands the player saw the flop and showed down,
+	// WonAtShowdown those of them they won chips in.
+	WentToShowdown int
+	WonAtShowdown  int
+	BigBlindsWon   float64
+}
+
+func (c *Counts) add(other *Counts) {
+	c.Hands += other.Hands
+	c.VoluntarilyPutIn += other.VoluntarilyPutIn
+	c.PreflopRaised += other.PreflopRaised
+	c.ThreeBetChances += other.ThreeBetChances
+	c.ThreeBets += other.ThreeBets
+	c.Aggressive += other.Aggressive
+	c.Calls += other.Calls
+	c.SawFlop += other.SawFlop
+	c.WentToShowdown += other.WentToShowdown
+	c.WonAtShowdown += other.WonAtShowdown
+	c.BigBlindsWon += other.BigBlindsWon
+}
+
+func ratio(n, d int) float64 {
+	if d == 0 {
+		return 0
+	}
+	return float64(n) / float64(d)
+}
+
+// VPIP is the fraction of hands the player voluntarily put chips in.
+func (c Counts) VPIP() float64 {
+	return ratio(c.VoluntarilyPutIn, c.Hands)
+}
+
+// PFR is the fraction of hands the player raised before the flop.
+func (c Counts) PFR() float64 {
+	return ratio(c.PreflopRaised, c.Hands)
+}
+
+// ThreeBet is the fraction of chances to three-bet taken.
+func (c Counts) ThreeBet() float64 {
+	return ratio(c.ThreeBets, c.ThreeBetChances)
+}
+
+// AggressionFactor is bets and raises per call after the flop, +Inf for a
+// player who never called but bet.
+func (c Counts) AggressionFactor() float64 {
+	if c.Calls == 0 && c.Aggressive > 0 {
+		return math.Inf(1)
+	}
+	return ratio(c.Aggressive, c.Calls)
+}
+
+// WTSD is the fraction of flops seen that went to showdown.
+func (c Counts) WTSD() float64 {
+	return ratio(c.WentToShowdown, c.SawFlop)
+}
+
+// WSD is the fraction of showdowns won.
+func (c Counts) WSD() float64 {
+	return ratio(c.WonAtShowdown, c.WentToShowdown)
+}
+
+// BBPer100 is the win rate in big blinds per hundred hands.
+func (c Counts) BBPer100() float64 {
+	if c.Hands == 0 {
+		return 0
+	}
+	return c.BigBlindsWon / float64(c.Hands) * 100
+}
+
+type PlayerStats struct {
+	Counts
+	ByPosition map[Position]Counts
+}
+
+// Tracker keeps every player's statistics over the hands added so far.
+type Tracker struct {
+	players map[poker.PlayerID]map[Position]*Counts
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{make(map[poker.PlayerID]map[Position]*Counts)}
+}
+
+// Add updates the statistics with a hand. A hand that fails validation
+// changes nothing.
+func (t *Tracker) Add(hand HandRecord) error {
+	if err := hand.validate(); err != nil {
+		return err
+	}
+	counts := handCounts(&hand)
+	for i, position := range hand.Positions() {
+		player := hand.Players[i]
+		if t.players[player] == nil {
+			t.players[player] = make(map[Position]*Counts)
+		}
+		if t.players[player][position] == nil {
+			t.players[player][position] = &Counts{}
+		}
+		t.players[player][position].add(counts[player])
+	}
+	return nil
+}
+
+// Stats returns a player's statistics, false if they played no hand.
+func (t *Tracker) Stats(player poker.PlayerID) (PlayerStats, bool) {
+	positions, ok := t.players[player]
+	if !ok {
+		return PlayerStats{}, false
+	}
+	stats := PlayerStats{ByPosition: make(map[Position]Counts)}
+	for position, counts := range positions {
+		stats.Counts.add(counts)
+		stats.ByPosition[position] = *counts
+	}
+	return stats, true
+}
+
+// handCounts counts one hand for each of its players.
+func handCounts(hand *HandRecord) map[poker.PlayerID]*Counts {
+	counts := make(map[poker.PlayerID]*Counts)
+	for _, player := range hand.Players {
+		counts[player] = &Counts{
+			Hands:        1,
+			BigBlindsWon: float64(hand.Net[player]) / float64(hand.BigBlind),
+		}
+	}
+
+	foldedPreflop := make(map[poker.PlayerID]bool)
+	// The big blind is the first raise.
+	raises := 1
+	for _, action := range hand.Actions {
+		player := hand.Players[action.Seat]
+		c := counts[player]
+		aggressive := action.Type == table.BET || action.Type == table.RAISE
+		if action.Street != holdem.PREFLOP {
+			switch {
+			case aggressive:
+				c.Aggressive++
+			case action.Type == table.CALL:
+				c.Calls++
+			}
+			continue
+		}
+
+		if raises == 2 {
+			c.ThreeBetChances = 1
+			if aggressive {
+				c.ThreeBets = 1
+			}
+		}
+		switch {
+		case aggressive:
+			c.VoluntarilyPutIn, c.PreflopRaised = 1, 1
+			raises++
+		case action.Type == table.CALL:
+			c.VoluntarilyPutIn = 1
+		case action.Type == table.FOLD:
+			foldedPreflop[player] = true
+		}
+	}
+
+	showdown := make(map[poker.PlayerID]bool)
+	for _, player := range hand.Showdown {
+		showdown[player] = true
+	}
+	if hand.BoardCards >= 3 {
+		for _, player := range hand.Players {
+			if foldedPreflop[player] {
+				continue
+			}
+			c := counts[player]
+			c.SawFlop = 1
+			if showdown[player] {
+				c.WentToShowdown = 1
+				if hand.Net[player] > 0 {
+					c.WonAtShowdown = 1
+				}
+			}
+		}
+	}
+	return counts
+}
@@ -0,0 +1,225 @@
+package stats
+
+import (
+	"maps"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/sdeboni/go-poker"
+	"github.com/sdeboni/go-poker/deck"
+	"github.com/sdeboni/go-poker/holdem"
+	"github.com/sdeboni/go-poker/table"
+)
+
+func ids(players ...string) []poker.PlayerID {
+	result := make([]poker.PlayerID, len(players))
+	for i, p := range players {
+		result[i] = poker.PlayerID(p)
+	}
+	return result
+}
+
+// act is an action by one of the players a to f, seated in that order.
+func act(player string, street holdem.Street, action table.ActionType) table.Action {
+	return table.Action{Seat: int(player[0] - 'a'), Type: action, Street: street}
+}
+
+// threeBetPot is six-handed with a on the button: d opens, f calls, a
+// three-bets and only d calls, then d check-calls the flop, check-raises the
+// turn and bets the river to win at showdown.
+var threeBetPot = HandRecord{
+	Players:  ids("a", "b", "c", "d", "e", "f"),
+	Button:   0,
+	BigBlind: 10,
+	Actions: []table.Action{
+		act("d", holdem.PREFLOP, table.RAISE),
+		act("e", holdem.PREFLOP, table.FOLD),
+		act("f", holdem.PREFLOP, table.CALL),
+		act("a", holdem.PREFLOP, table.RAISE),
+		act("b", holdem.PREFLOP, table.FOLD),
+		act("c", holdem.PREFLOP, table.FOLD),
+		act("d", holdem.PREFLOP, table.CALL),
+		act("f", holdem.PREFLOP, table.FOLD),
+		act("d", holdem.FLOP, table.CHECK),
+		act("a", holdem.FLOP, table.BET),
+		act("d", holdem.FLOP, table.CALL),
+		act("d", holdem.TURN, table.CHECK),
+		act("a", holdem.TURN, table.BET),
+		act("d", holdem.TURN, table.RAISE),
+		act("a", holdem.TURN, table.CALL),
+		act("d", holdem.RIVER, table.BET),
+		act("a", holdem.RIVER, table.CALL),
+	},
+	BoardCards: 5,
+	Showdown:   ids("d", "a"),
+	Net:        map[poker.PlayerID]int{"a": -400, "b": -5, "c": -10, "d": 445, "f": -30},
+}
+
+// walk has everyone fold to the big blind, the button now on b.
+var walk = HandRecord{
+	Players:  ids("a", "b", "c", "d", "e", "f"),
+	Button:   1,
+	BigBlind: 10,
+	Actions: []table.Action{
+		act("e", holdem.PREFLOP, table.FOLD),
+		act("f", holdem.PREFLOP, table.FOLD),
+		act("a", holdem.PREFLOP, table.FOLD),
+		act("b", holdem.PREFLOP, table.FOLD),
+		act("c", holdem.PREFLOP, table.FOLD),
+	},
+	Net: map[poker.PlayerID]int{"c": -5, "d": 5},
+}
+
+func TestPositions(t *testing.T) {
+	cases := []struct {
+		players  int
+		button   int
+		expected []Position
+	}{
+		{2, 1, []Position{BIG_BLIND, BUTTON}},
+		{3, 0, []Position{BUTTON, SMALL_BLIND, BIG_BLIND}},
+		{6, 0, []Position{BUTTON, SMALL_BLIND, BIG_BLIND, EARLY, MIDDLE, CUTOFF}},
+		{9, 8, []Position{SMALL_BLIND, BIG_BLIND, EARLY, EARLY, EARLY, MIDDLE, MIDDLE, CUTOFF, BUTTON}},
+	}
+
+	for _, tc := range cases {
+		hand := HandRecord{Players: make([]poker.PlayerID, tc.players), Button: tc.button}
+		if got := hand.Positions(); !slices.Equal(got, tc.expected) {
+			t.Errorf("%d players, button %d: expected %v, got %v", tc.players, tc.button, tc.expected, got)
+		}
+	}
+}
+
+func TestTracker(t *testing.T) {
+	tracker := NewTracker()
+	if err := tracker.Add(threeBetPot); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		player                    string
+		vpip, pfr, threeBet, af   float64
+		wtsd, wsd, bbPer100       float64
+		threeBetChances, sawFlops int
+	}{
+		{"a", 1, 1, 1, 1, 1, 0, -4000, 1, 1},
+		{"b", 0, 0, 0, 0, 0, 0, -50, 0, 0},
+		{"d", 1, 1, 0, 2, 1, 1, 4450, 0, 1},
+		{"e", 0, 0, 0, 0, 0, 0, 0, 1, 0},
+		{"f", 1, 0, 0, 0, 0, 0, -300, 1, 0},
+	}
+	for _, tc := range cases {
+		stats, ok := tracker.Stats(poker.PlayerID(tc.player))
+		if !ok {
+			t.Fatalf("no stats for %s", tc.player)
+		}
+		got := []float64{stats.VPIP(), stats.PFR(), stats.ThreeBet(), stats.AggressionFactor(), stats.WTSD(), stats.WSD(), stats.BBPer100()}
+		expected := []float64{tc.vpip, tc.pfr, tc.threeBet, tc.af, tc.wtsd, tc.wsd, tc.bbPer100}
+		if !slices.Equal(got, expected) || stats.ThreeBetChances != tc.threeBetChances || stats.SawFlop != tc.sawFlops {
+			t.Errorf("%s: expected %v, got %v with %+v", tc.player, expected, got, stats.Counts)
+		}
+	}
+}
+
+func TestTrackerIsIncremental(t *testing.T) {
+	tracker := NewTracker()
+	for _, hand := range []HandRecord{threeBetPot, walk} {
+		if err := tracker.Add(hand); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	d, _ := tracker.Stats("d")
+	if d.Hands != 2 || d.VPIP() != 0.5 || d.BBPer100() != (44.5+0.5)/2*100 {
+		t.Errorf("expected d to play 2 hands at 50%% VPIP and 2250bb/100, got %+v", d.Counts)
+	}
+	if early, bigBlind := d.ByPosition[EARLY], d.ByPosition[BIG_BLIND]; early.Hands != 1 || early.PreflopRaised != 1 || bigBlind.Hands != 1 || bigBlind.PreflopRaised != 0 {
+		t.Errorf("expected d's raise in early position only, got %+v", d.ByPosition)
+	}
+	a, _ := tracker.Stats("a")
+	if a.AggressionFactor() != 1 || len(a.ByPosition) != 2 {
+		t.Errorf("expected a's aggression factor 1 over two positions, got %+v", a)
+	}
+}
+
+func TestAggressionFactorWithoutCalls(t *testing.T) {
+	if af := (Counts{Aggressive: 3}).AggressionFactor(); !math.IsInf(af, 1) {
+		t.Errorf("expected +Inf, got %f", af)
+	}
+	if af := (Counts{}).AggressionFactor(); af != 0 {
+		t.Errorf("expected 0, got %f", af)
+	}
+}
+
+func TestTrackerRejects(t *testing.T) {
+	cases := []struct {
+		description string
+		change      func(h *HandRecord)
+	}{
+		{"player seated twice", func(h *HandRecord) { h.Players[1] = "a" }},
+		{"button off the table", func(h *HandRecord) { h.Button = 6 }},
+		{"no big blind", func(h *HandRecord) { h.BigBlind = 0 }},
+		{"action by a stranger", func(h *HandRecord) { h.Actions[0].Seat = 6 }},
+		{"streets out of order", func(h *HandRecord) { h.Actions[len(h.Actions)-1].Street = holdem.FLOP }},
+		{"result for a stranger", func(h *HandRecord) { h.Net["z"] = 1 }},
+	}
+
+	for _, tc := range cases {
+		tracker := NewTracker()
+		hand := threeBetPot
+		hand.Players = slices.Clone(hand.Players)
+		hand.Actions = slices.Clone(hand.Actions)
+		hand.Net = map[poker.PlayerID]int{"d": 445}
+		tc.change(&hand)
+		if err := tracker.Add(hand); err == nil {
+			t.Errorf("%s: expected error", tc.description)
+		}
+		if _, ok := tracker.Stats("a"); ok {
+			t.Errorf("%s: expected no stats after a rejected hand", tc.description)
+		}
+	}
+}
+
+func TestFromTranscript(t *testing.T) {
+	// a raises on the button and b folds; c calls from the big blind, then
+	// folds to a's bet on the flop.
+	tr, err := table.RecordHoldem(7, deck.SHUFFLE_V1, []string{"a", "b", "c"}, []table.Action{
+		{Seat: 0, Type: table.RAISE, Amount: 30, Street: holdem.PREFLOP},
+		{Seat: 1, Type: table.FOLD, Street: holdem.PREFLOP},
+		{Seat: 2, Type: table.CALL, Amount: 20, Street: holdem.PREFLOP},
+		{Seat: 2, Type: table.CHECK, Street: holdem.FLOP},
+		{Seat: 0, Type: table.BET, Amount: 30, Street: holdem.FLOP},
+		{Seat: 2, Type: table.FOLD, Street: holdem.FLOP},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hand, err := FromTranscript(tr, 0, 5, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := map[poker.PlayerID]int{"a": 35, "b": -5, "c": -30}; !maps.Equal(hand.Net, expected) {
+		t.Errorf("expected net %v, got %v", expected, hand.Net)
+	}
+	if hand.BoardCards != 3 || hand.Showdown != nil {
+		t.Errorf("expected a flop and no showdown, got %d board cards and showdown %v", hand.BoardCards, hand.Showdown)
+	}
+
+	tracker := NewTracker()
+	if err := tracker.Add(hand); err != nil {
+		t.Fatal(err)
+	}
+	stats, _ := tracker.Stats("a")
+	if stats.PreflopRaised != 1 || stats.Aggressive != 1 || stats.SawFlop != 1 {
+		t.Errorf("unexpected counts for a: %+v", stats.Counts)
+	}
+
+	stud, err := table.Record(7, deck.SHUFFLE_V1, []string{"a", "b"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := FromTranscript(stud, 0, 5, 10); err == nil {
+		t.Error("expected error for a stud transcript")
+	}
+}
@@ -0,0 +1,220 @@
+package table
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/sdeboni/go-poker"
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+	"github.com/sdeboni/go-poker/holdem"
+	"github.com/sdeboni/go-poker/internal/deal"
+)
+
+// streetBoardCards is how many board cards are out when the betting on each
+// hold'em street ends: preflop, flop, turn and river.
+var streetBoardCards = [4]int{0, 3, 4, holdem.BOARD_SIZE}
+
+// AllInPlayer is one seat of a completed hold'em hand.
+type AllInPlayer struct {
+	ID poker.PlayerID
+	// Hole is ignored for players who folded, whose cards trackers do not
+	// see, and when no one else is left in the hand.
+	Hole holdem.HoleCards
+	// Stack is the player's chips at the start of the hand.
+	Stack int
+	// Bets are the chips the player put in on each street, blinds and antes
+	// included: preflop, flop, turn and river.
+	Bets   [4]int
+	Folded bool
+}
+
+type PlayerEV struct {
+	ID poker.PlayerID
+	// Equity is the player's share of the pots at the all-in point.
+	Equity float64
+	// Expected and Actual are the chips won net of the player's bets, Luck
+	// the difference. Odd chips are split evenly.
+	Expected, Actual, Luck float64
+}
+
+type AllInResult struct {
+	// BoardCards is how many board cards were out when the last chips went
+	// in, BOARD_SIZE when the betting went on to the river.
+	BoardCards int
+	Players    []PlayerEV
+}
+
+// AllInEV compares each player's expected winnings at the point the chips
+// went all in, counting every runout of the board, with what they won on the
+// board that came. The all-in point is the first street after which no one
+// bet and at most one player still in the hand had chips behind. Each side
+// pot is shared by equity among the players eligible for it.
+func AllInEV(players []AllInPlayer, board []card.Card) (*AllInResult, error) {
+	potPlayers, live, err := validateAllIn(players, board)
+	if err != nil {
+		return nil, err
+	}
+
+	contributed := make([]int, len(players))
+	for i, p := range potPlayers {
+		contributed[i] = p.Contribution
+	}
+	potPlayers, bettor, uncalled := returnUncalled(potPlayers)
+	pots := buildPots(potPlayers)
+
+	result := &AllInResult{BoardCards: allInBoardCards(players)}
+	values := make([]eval.HandValue, len(players))
+	expected, actual := make([]float64, len(players)), make([]float64, len(players))
+	runouts := 1
+	if len(live) == 1 {
+		awardPots(pots, values, actual)
+		copy(expected, actual)
+	} else {
+		runouts = runoutWinnings(players, live, pots, board[:result.BoardCards], expected)
+		for _, i := range live {
+			values[i] = eval.NewCardSet(append(players[i].Hole[:], board...)...).Evaluate()
+		}
+		awardPots(pots, values, actual)
+	}
+
+	var total float64
+	for _, pot := range pots {
+		total += float64(pot.amount)
+	}
+	for i, p := range players {
+		ev := PlayerEV{ID: p.ID}
+		ev.Expected = expected[i]/float64(runouts) - float64(contributed[i])
+		ev.Actual = actual[i] - float64(contributed[i])
+		if i == bettor {
+			ev.Expected += float64(uncalled)
+			ev.Actual += float64(uncalled)
+		}
+		ev.Equity = expected[i] / float64(runouts) / total
+		ev.Luck = ev.Actual - ev.Expected
+		result.Players = append(result.Players, ev)
+	}
+	return result, nil
+}
+
+// runoutWinnings adds the players' winnings over every runout of the known
+// board cards to won and returns the number of runouts.
+func runoutWinnings(players []AllInPlayer, live []int, pots []pot, known []card.Card, won []float64) int {
+	holes := make([]eval.CardSet, len(players))
+	dead := eval.NewCardSet(known...)
+	for _, i := range live {
+		holes[i] = eval.NewCardSet(players[i].Hole[:]...)
+		dead |= holes[i]
+	}
+	var deck []card.Card
+	for i := range card.DECK_SIZE {
+		if c, _ := card.FromIndex(i); !dead.Contains(c) {
+			deck = append(deck, c)
+		}
+	}
+
+	values := make([]eval.HandValue, len(players))
+	runout := make([]int, holdem.BOARD_SIZE-len(known))
+	var runouts int
+	deal.ForEachCombination(len(deck), runout, func() {
+		board := eval.NewCardSet(known...)
+		for _, i := range runout {
+			board = board.Add(deck[i])
+		}
+		for _, i := range live {
+			values[i] = (holes[i] | board).Evaluate()
+		}
+		awardPots(pots, values, won)
+		runouts++
+	})
+	return runouts
+}
+
+// validateAllIn checks a completed hand and returns its players as pot
+// players and the indexes of those who did not fold.
+func validateAllIn(players []AllInPlayer, board []card.Card) ([]PotPlayer, []int, error) {
+	if len(players) < 2 {
+		return nil, nil, fmt.Errorf("all-in EV needs at least 2 players, got %d", len(players))
+	}
+	ids := make(map[poker.PlayerID]bool)
+	potPlayers := make([]PotPlayer, len(players))
+	var live []int
+	cards := slices.Clone(board)
+	for i, p := range players {
+		if ids[p.ID] {
+			return nil, nil, fmt.Errorf("duplicate player: %s", p.ID)
+		}
+		ids[p.ID] = true
+		var total int
+		for _, bet := range p.Bets {
+			if bet < 0 {
+				return nil, nil, fmt.Errorf("player %s has a negative bet: %d", p.ID, bet)
+			}
+			total += bet
+		}
+		if total > p.Stack {
+			return nil, nil, fmt.Errorf("player %s bet %d with a stack of %d", p.ID, total, p.Stack)
+		}
+		potPlayers[i] = PotPlayer{ID: p.ID, Contribution: total, Folded: p.Folded}
+		if !p.Folded {
+			live = append(live, i)
+			cards = append(cards, p.Hole[:]...)
+		}
+	}
+	if len(live) == 0 {
+		return nil, nil, fmt.Errorf("every player folded")
+	}
+	if len(live) == 1 {
+		return potPlayers, live, nil
+	}
+	if len(board) != holdem.BOARD_SIZE {
+		return nil, nil, fmt.Errorf("invalid board for a showdown: %d cards", len(board))
+	}
+	if err := deal.Distinct(cards...); err != nil {
+		return nil, nil, err
+	}
+	return potPlayers, live, nil
+}
+
+// allInBoardCards finds the all-in point.
+func allInBoardCards(players []AllInPlayer) int {
+	for street, boardCards := range streetBoardCards {
+		behind, later := 0, false
+		for _, p := range players {
+			var total int
+			for _, bet := range p.Bets[:street+1] {
+				total += bet
+			}
+			if !p.Folded && total < p.Stack {
+				behind++
+			}
+			for _, bet := range p.Bets[street+1:] {
+				later = later || bet > 0
+			}
+		}
+		if behind <= 1 && !later {
+			return boardCards
+		}
+	}
+	return holdem.BOARD_SIZE
+}
+
+// awardPots adds each player's winnings to won given the values of their
+// hands, splitting pots evenly between tied hands.
+func awardPots(pots []pot, values []eval.HandValue, won []float64) {
+	for _, pot := range pots {
+		var best eval.HandValue
+		var winners []int
+		for _, i := range pot.eligible {
+			switch {
+			case len(winners) == 0 || values[i] > best:
+				best, winners = values[i], []int{i}
+			case values[i] == best:
+				winners = append(winners, i)
+			}
+		}
+		for _, i := range winners {
+			won[i] += float64(pot.amount) / float64(len(winners))
+		}
+	}
+}
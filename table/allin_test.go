@@ -0,0 +1,210 @@
+package table
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+	"github.com/sdeboni/go-poker/holdem"
+)
+
+func mustParseCards(t testing.TB, str string) []card.Card {
+	t.Helper()
+	cards, err := card.ParseCards(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cards
+}
+
+func mustHoleCards(t *testing.T, str string) holdem.HoleCards {
+	t.Helper()
+	hole, err := holdem.ParseHoleCards(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hole
+}
+
+func equities(t *testing.T, board string, holes ...string) []float64 {
+	t.Helper()
+	holdings := make([]holdem.HoleCards, len(holes))
+	for i, hole := range holes {
+		holdings[i] = mustHoleCards(t, hole)
+	}
+	var known []card.Card
+	if board != "" {
+		known = mustParseCards(t, board)
+	}
+	result, err := holdem.Equity(holdings, known, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+// sidePotEquities is Equity between the last two holdings, with the first
+// one's cards out of the deck.
+func sidePotEquities(t *testing.T, flop, dead, a, b string) []float64 {
+	t.Helper()
+	known := eval.NewCardSet(mustParseCards(t, flop)...)
+	holes := []eval.CardSet{eval.NewCardSet(mustParseCards(t, a)...), eval.NewCardSet(mustParseCards(t, b)...)}
+	out := known | holes[0] | holes[1] | eval.NewCardSet(mustParseCards(t, dead)...)
+	var shares [2]float64
+	var runouts int
+	for i := range card.DECK_SIZE {
+		for j := i + 1; j < card.DECK_SIZE; j++ {
+			turn, _ := card.FromIndex(i)
+			river, _ := card.FromIndex(j)
+			if out.Contains(turn) || out.Contains(river) {
+				continue
+			}
+			board := known.Add(turn).Add(river)
+			va, vb := (holes[0] | board).Evaluate(), (holes[1] | board).Evaluate()
+			switch {
+			case va > vb:
+				shares[0]++
+			case va < vb:
+				shares[1]++
+			default:
+				shares[0] += 0.5
+				shares[1] += 0.5
+			}
+			runouts++
+		}
+	}
+	return []float64{shares[0] / float64(runouts), shares[1] / float64(runouts)}
+}
+
+func TestAllInEV(t *testing.T) {
+	board := "K♢ 7♧ 2♤ 9♡ 3♢"
+	cases := []struct {
+		description string
+		players     []AllInPlayer
+		boardCards  int
+		// expected winnings net of bets
+		expected func() []float64
+		actual   []float64
+	}{
+		{
+			"aces lose to kings all in preflop",
+			[]AllInPlayer{
+				{ID: "a", Hole: mustHoleCards(t, "A♡ A♤"), Stack: 100, Bets: [4]int{100}},
+				{ID: "b", Hole: mustHoleCards(t, "K♡ K♤"), Stack: 150, Bets: [4]int{100}},
+			},
+			0,
+			func() []float64 {
+				e := equities(t, "", "A♡ A♤", "K♡ K♤")
+				return []float64{200*e[0] - 100, 200*e[1] - 100}
+			},
+			[]float64{-100, 100},
+		},
+		{
+			"uncalled shove is returned",
+			[]AllInPlayer{
+				{ID: "a", Hole: mustHoleCards(t, "A♡ A♤"), Stack: 300, Bets: [4]int{300}},
+				{ID: "b", Hole: mustHoleCards(t, "K♡ K♤"), Stack: 100, Bets: [4]int{100}},
+				{ID: "c", Stack: 500, Bets: [4]int{10}, Folded: true},
+			},
+			0,
+			func() []float64 {
+				e := equities(t, "", "A♡ A♤", "K♡ K♤")
+				return []float64{210*e[0] - 100, 210*e[1] - 100, -10}
+			},
+			[]float64{-100, 110, -10},
+		},
+		{
+			"short stack all in preflop, side pot all in on the flop",
+			[]AllInPlayer{
+				{ID: "a", Hole: mustHoleCards(t, "Q♡ Q♢"), Stack: 50, Bets: [4]int{50}},
+				{ID: "b", Hole: mustHoleCards(t, "A♧ J♧"), Stack: 200, Bets: [4]int{100, 100}},
+				{ID: "c", Hole: mustHoleCards(t, "9♢ 9♧"), Stack: 300, Bets: [4]int{100, 100}},
+			},
+			3,
+			func() []float64 {
+				main := equities(t, "K♢ 7♧ 2♤", "Q♡ Q♢", "A♧ J♧", "9♢ 9♧")
+				side := sidePotEquities(t, "K♢ 7♧ 2♤", "Q♡ Q♢", "A♧ J♧", "9♢ 9♧")
+				return []float64{150*main[0] - 50, 150*main[1] + 300*side[0] - 200, 150*main[2] + 300*side[1] - 200}
+			},
+			[]float64{-50, -200, 250},
+		},
+		{
+			"betting on the river leaves nothing to luck",
+			[]AllInPlayer{
+				{ID: "a", Hole: mustHoleCards(t, "A♡ K♤"), Stack: 500, Bets: [4]int{20, 40, 0, 100}},
+				{ID: "b", Hole: mustHoleCards(t, "Q♡ Q♤"), Stack: 500, Bets: [4]int{20, 40, 0, 100}},
+			},
+			holdem.BOARD_SIZE,
+			func() []float64 {
+				return []float64{160, -160}
+			},
+			[]float64{160, -160},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			result, err := AllInEV(tc.players, mustParseCards(t, board))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.BoardCards != tc.boardCards {
+				t.Errorf("expected the all-in point at %d board cards, got %d", tc.boardCards, result.BoardCards)
+			}
+			expected := tc.expected()
+			var luck float64
+			for i, ev := range result.Players {
+				if math.Abs(ev.Expected-expected[i]) > 1e-9 || ev.Actual != tc.actual[i] || ev.Luck != ev.Actual-ev.Expected {
+					t.Errorf("%s: expected %f and %f, got %+v", ev.ID, expected[i], tc.actual[i], ev)
+				}
+				luck += ev.Luck
+			}
+			if math.Abs(luck) > 1e-9 {
+				t.Errorf("expected luck to sum to zero, got %f", luck)
+			}
+		})
+	}
+}
+
+func TestAllInEVWithoutShowdown(t *testing.T) {
+	result, err := AllInEV([]AllInPlayer{
+		{ID: "a", Stack: 100, Bets: [4]int{100}},
+		{ID: "b", Stack: 100, Bets: [4]int{10}, Folded: true},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := result.Players[0]; a.Expected != 10 || a.Actual != 10 || a.Equity != 1 {
+		t.Errorf("expected a to win the blind uncontested, got %+v", a)
+	}
+}
+
+func TestAllInEVRejects(t *testing.T) {
+	board := mustParseCards(t, "K♢ 7♧ 2♤ 9♡ 3♢")
+	cases := []struct {
+		description string
+		players     []AllInPlayer
+		board       []card.Card
+	}{
+		{"one player", []AllInPlayer{{ID: "a", Stack: 10}}, board},
+		{"bets over the stack", []AllInPlayer{
+			{ID: "a", Hole: mustHoleCards(t, "A♡ A♤"), Stack: 10, Bets: [4]int{20}},
+			{ID: "b", Hole: mustHoleCards(t, "K♡ K♤"), Stack: 20, Bets: [4]int{20}},
+		}, board},
+		{"card used twice", []AllInPlayer{
+			{ID: "a", Hole: mustHoleCards(t, "A♡ K♢"), Stack: 20, Bets: [4]int{20}},
+			{ID: "b", Hole: mustHoleCards(t, "K♡ K♤"), Stack: 20, Bets: [4]int{20}},
+		}, board},
+		{"showdown without a full board", []AllInPlayer{
+			{ID: "a", Hole: mustHoleCards(t, "A♡ A♤"), Stack: 20, Bets: [4]int{20}},
+			{ID: "b", Hole: mustHoleCards(t, "K♡ K♤"), Stack: 20, Bets: [4]int{20}},
+		}, board[:3]},
+	}
+
+	for _, tc := range cases {
+		if _, err := AllInEV(tc.players, tc.board); err == nil {
+			t.Errorf("%s: expected error", tc.description)
+		}
+	}
+}
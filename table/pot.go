@@ -0,0 +1,361 @@
+// Package table handles a cash game hand around the cards: pot settlement
+// with side pots and rake, all-in expected value, and transcripts that
+// replay a hand from its seed.
+package table
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/sdeboni/go-poker"
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+	"github.com/sdeboni/go-poker/internal/deal"
+)
+
+// OddChipRule decides who receives the chips left over when a pot does not
+// split evenly between tied hands.
+type OddChipRule int
+
+const (
+	// LEFT_OF_BUTTON gives odd chips one at a time to the tied players
+	// nearest the button's left.
+	LEFT_OF_BUTTON OddChipRule = iota + 1
+	// HIGHEST_SUIT gives odd chips one at a time to the tied players in order
+	// of their highest card, by rank then by suit in bridge order.
+	HIGHEST_SUIT
+)
+
+type PotRules struct {
+	OddChip OddChipRule
+	// HiLo splits every pot between the best high hand and the best
+	// eight-or-better low. The high half takes the odd chip of an uneven
+	// split, and the whole pot when no eligible player has a low.
+	HiLo bool
+	// Rake, when set, is taken from the pots before they are awarded.
+	Rake *RakePolicy
+	// NoFlop is set when the hand ended before the flop.
+	NoFlop bool
+	// SharedCards is set when the hands are made with community cards, so
+	// the same card may be in several of them.
+	SharedCards bool
+}
+
+// PotPlayer is one seat's part in a hand. Players are given in seat order.
+type PotPlayer struct {
+	ID           poker.PlayerID
+	Contribution int
+	Folded       bool
+	// Hand is required unless the player folded.
+	Hand eval.Hand
+	// Low is the player's five-card low for Hi/Lo games, nil for none. A low
+	// that does not qualify as eight-or-better is ignored.
+	Low []card.Card
+}
+
+type Pot struct {
+	Amount int
+	// Rake and Jackpot are taken from Amount, leaving Net to the winners.
+	Rake     int
+	Jackpot  int
+	Net      int
+	Eligible []poker.PlayerID
+	// Winners and LowWinners are in the order odd chips were handed out.
+	Winners    []poker.PlayerID
+	LowWinners []poker.PlayerID
+}
+
+type Settlement struct {
+	// Pots lists the main pot first, then each side pot.
+	Pots []Pot
+	// Uncalled is the part of the largest bet no one matched, returned to the
+	// bettor before the pots are built.
+	Uncalled int
+	Rake     int
+	Jackpot  int
+	Payouts  map[poker.PlayerID]int
+}
+
+// SettlePots builds the main and side pots from the players' contributions
+// and awards each to the best hands still eligible for it. button is the
+// index of the player on the button.
+func SettlePots(players []PotPlayer, button int, rules PotRules) (*Settlement, error) {
+	if err := validatePotPlayers(players, button, rules); err != nil {
+		return nil, err
+	}
+
+	s := &Settlement{Payouts: make(map[poker.PlayerID]int)}
+	for _, p := range players {
+		s.Payouts[p.ID] = 0
+	}
+	players, bettor, uncalled := returnUncalled(players)
+	if uncalled > 0 {
+		s.Uncalled = uncalled
+		s.Payouts[players[bettor].ID] += uncalled
+	}
+
+	pots := buildPots(players)
+	if rules.Rake != nil {
+		var total int
+		for _, pot := range pots {
+			total += pot.amount
+		}
+		s.Rake, s.Jackpot = rules.Rake.Take(total, rules.NoFlop)
+	}
+	rakes := takeFromPots(pots, s.Rake)
+	jackpots := takeFromPots(pots, s.Jackpot, rakes)
+
+	for i, pot := range pots {
+		net := pot.amount - rakes[i] - jackpots[i]
+		high, low := net, 0
+		var lows []int
+		if rules.HiLo {
+			lows = lowWinners(players, pot.eligible)
+		}
+		if len(lows) > 0 {
+			low = net / 2
+			high -= low
+		}
+
+		winners := highWinners(players, pot.eligible)
+		orderForOddChips(players, winners, button, rules.OddChip, func(p PotPlayer) []card.Card {
+			return p.Hand.Cards()
+		})
+		split(s.Payouts, players, winners, high)
+
+		result := Pot{
+			Amount:  pot.amount,
+			Rake:    rakes[i],
+			Jackpot: jackpots[i],
+			Net:     net,
+			Winners: playerIDs(players, winners),
+		}
+		if low > 0 {
+			orderForOddChips(players, lows, button, rules.OddChip, func(p PotPlayer) []card.Card {
+				return p.Low
+			})
+			split(s.Payouts, players, lows, low)
+			result.LowWinners = playerIDs(players, lows)
+		}
+		result.Eligible = playerIDs(players, pot.eligible)
+		s.Pots = append(s.Pots, result)
+	}
+	return s, nil
+}
+
+func validatePotPlayers(players []PotPlayer, button int, rules PotRules) error {
+	if button < 0 || button >= len(players) {
+		return fmt.Errorf("invalid button: %d", button)
+	}
+	if rules.OddChip != LEFT_OF_BUTTON && rules.OddChip != HIGHEST_SUIT {
+		return fmt.Errorf("invalid odd chip rule: %d", rules.OddChip)
+	}
+
+	ids := make(map[poker.PlayerID]bool)
+	var live []eval.Hand
+	for _, p := range players {
+		if ids[p.ID] {
+			return fmt.Errorf("duplicate player: %s", p.ID)
+		}
+		ids[p.ID] = true
+		if p.Contribution < 0 {
+			return fmt.Errorf("player %s has negative contribution: %d", p.ID, p.Contribution)
+		}
+		if p.Folded {
+			continue
+		}
+		if p.Hand == nil {
+			return fmt.Errorf("player %s has no hand", p.ID)
+		}
+		if p.Low != nil && len(p.Low) != eval.CARDS_PER_HAND {
+			return fmt.Errorf("player %s has a low of %d cards", p.ID, len(p.Low))
+		}
+		live = append(live, p.Hand)
+	}
+	if len(live) == 0 {
+		return fmt.Errorf("every player folded")
+	}
+	if rules.SharedCards {
+		return nil
+	}
+	var cards []card.Card
+	for _, hand := range live {
+		cards = append(cards, hand.Cards()...)
+	}
+	return deal.Distinct(cards...)
+}
+
+// returnUncalled returns a copy of players in which a live player's bet that
+// no one matched is cut down to the next largest contribution, along with
+// that player's index and the chips cut.
+func returnUncalled(players []PotPlayer) ([]PotPlayer, int, int) {
+	players = slices.Clone(players)
+	top, second := 0, 0
+	for i, p := range players[1:] {
+		if p.Contribution > players[top].Contribution {
+			second, top = players[top].Contribution, i+1
+		} else {
+			second = max(second, p.Contribution)
+		}
+	}
+	if players[top].Folded {
+		return players, top, 0
+	}
+	uncalled := players[top].Contribution - second
+	players[top].Contribution = second
+	return players, top, uncalled
+}
+
+type pot struct {
+	amount   int
+	eligible []int
+}
+
+// buildPots layers the contributions into pots, one for each all-in amount.
+// Chips that no live player matched, such as a folded player's bet above
+// every all-in, go to the pot below.
+func buildPots(players []PotPlayer) []pot {
+	var levels []int
+	for _, p := range players {
+		if p.Contribution > 0 {
+			levels = append(levels, p.Contribution)
+		}
+	}
+	slices.Sort(levels)
+	levels = slices.Compact(levels)
+
+	var pots []pot
+	var unmatched, prev int
+	for _, level := range levels {
+		var amount int
+		var eligible []int
+		for i, p := range players {
+			amount += min(p.Contribution, level) - min(p.Contribution, prev)
+			if !p.Folded && p.Contribution >= level {
+				eligible = append(eligible, i)
+			}
+		}
+		prev = level
+
+		switch {
+		case len(pots) > 0 && (len(eligible) == 0 || slices.Equal(eligible, pots[len(pots)-1].eligible)):
+			pots[len(pots)-1].amount += amount
+		case len(eligible) == 0:
+			unmatched += amount
+		default:
+			pots = append(pots, pot{amount + unmatched, eligible})
+			unmatched = 0
+		}
+	}
+
+	// Only when no live player put in a chip, for instance a walk.
+	if len(pots) == 0 {
+		var eligible []int
+		for i, p := range players {
+			if !p.Folded {
+				eligible = append(eligible, i)
+			}
+		}
+		pots = append(pots, pot{unmatched, eligible})
+	}
+	return pots
+}
+
+func highWinners(players []PotPlayer, eligible []int) []int {
+	var winners []int
+	for _, index := range eligible {
+		if len(winners) > 0 {
+			c := players[index].Hand.Compare(players[winners[0]].Hand)
+			if c < 0 {
+				continue
+			}
+			if c > 0 {
+				winners = winners[:0]
+			}
+		}
+		winners = append(winners, index)
+	}
+	return winners
+}
+
+func lowWinners(players []PotPlayer, eligible []int) []int {
+	var winners []int
+	var best uint32
+	for _, index := range eligible {
+		value, ok := eightOrBetter(players[index].Low)
+		switch {
+		case !ok:
+		case len(winners) == 0 || value < best:
+			winners, best = []int{index}, value
+		case value == best:
+			winners = append(winners, index)
+		}
+	}
+	return winners
+}
+
+// eightOrBetter values a qualifying low, lower being better: five distinct
+// ranks of eight or below with aces low, compared from the highest card down.
+func eightOrBetter(cards []card.Card) (uint32, bool) {
+	if len(cards) != eval.CARDS_PER_HAND {
+		return 0, false
+	}
+	ranks := make([]int, 0, len(cards))
+	for _, c := range cards {
+		rank := int(c.Rank())
+		if c.Rank() == card.ACE {
+			rank = 1
+		}
+		if rank > 8 || slices.Contains(ranks, rank) {
+			return 0, false
+		}
+		ranks = append(ranks, rank)
+	}
+	slices.Sort(ranks)
+
+	var value uint32
+	for i := len(ranks) - 1; i >= 0; i-- {
+		value = value<<4 | uint32(ranks[i])
+	}
+	return value, true
+}
+
+// orderForOddChips sorts winners into the order they receive odd chips.
+func orderForOddChips(players []PotPlayer, winners []int, button int, rule OddChipRule, cards func(PotPlayer) []card.Card) {
+	fromButton := func(i int) int {
+		return (i - button - 1 + len(players)) % len(players)
+	}
+	slices.SortFunc(winners, func(a, b int) int {
+		if rule == HIGHEST_SUIT {
+			if c := -highestCard(cards(players[a])).Compare(highestCard(cards(players[b])), card.BRIDGE_SUIT_ORDER); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(fromButton(a), fromButton(b))
+	})
+}
+
+func highestCard(cards []card.Card) card.Card {
+	return slices.MaxFunc(cards, func(a, b card.Card) int {
+		return a.Compare(b, card.BRIDGE_SUIT_ORDER)
+	})
+}
+
+func split(payouts map[poker.PlayerID]int, players []PotPlayer, winners []int, amount int) {
+	share, odd := amount/len(winners), amount%len(winners)
+	for i, index := range winners {
+		payouts[players[index].ID] += share
+		if i < odd {
+			payouts[players[index].ID]++
+		}
+	}
+}
+
+func playerIDs(players []PotPlayer, indexes []int) []poker.PlayerID {
+	ids := make([]poker.PlayerID, len(indexes))
+	for i, index := range indexes {
+		ids[i] = players[index].ID
+	}
+	return ids
+}
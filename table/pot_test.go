@@ -0,0 +1,224 @@
+package table
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/sdeboni/go-poker"
+	"github.com/sdeboni/go-poker/deck"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+func potPlayer(t testing.TB, id poker.PlayerID, contribution int, hand string) PotPlayer {
+	t.Helper()
+	if hand == "" {
+		return PotPlayer{ID: id, Contribution: contribution, Folded: true}
+	}
+	h, err := poker.ParseHand(hand)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return PotPlayer{ID: id, Contribution: contribution, Hand: h, Low: h.Cards()}
+}
+
+func TestSettlePots(t *testing.T) {
+	cases := []struct {
+		description string
+		players     []PotPlayer
+		button      int
+		rules       PotRules
+		expected    map[poker.PlayerID]int
+		pots        int
+	}{
+		{
+			"short stack wins main pot",
+			[]PotPlayer{
+				potPlayer(t, "a", 50, "A♡ A♤ A♢ K♧ Q♡"),
+				potPlayer(t, "b", 100, "K♡ K♤ 2♢ 3♢ 4♧"),
+				potPlayer(t, "c", 100, "Q♢ Q♤ 5♢ 6♢ 8♧"),
+			},
+			0, PotRules{OddChip: LEFT_OF_BUTTON},
+			map[poker.PlayerID]int{"a": 150, "b": 100, "c": 0},
+			2,
+		},
+		{
+			"folded chips above every live player join the pot below",
+			[]PotPlayer{
+				potPlayer(t, "a", 8, "A♡ K♤ Q♢ J♢ 10♧"),
+				potPlayer(t, "b", 9, ""),
+				potPlayer(t, "c", 8, "10♡ J♤ Q♧ K♧ A♢"),
+			},
+			1, PotRules{OddChip: LEFT_OF_BUTTON},
+			map[poker.PlayerID]int{"a": 12, "b": 0, "c": 13},
+			1,
+		},
+		{
+			"odd chip wraps around the button",
+			[]PotPlayer{
+				potPlayer(t, "a", 8, "A♡ K♤ Q♢ J♢ 10♧"),
+				potPlayer(t, "b", 9, ""),
+				potPlayer(t, "c", 8, "10♡ J♤ Q♧ K♧ A♢"),
+			},
+			2, PotRules{OddChip: LEFT_OF_BUTTON},
+			map[poker.PlayerID]int{"a": 13, "b": 0, "c": 12},
+			1,
+		},
+		{
+			"odd chip to the highest suit",
+			[]PotPlayer{
+				potPlayer(t, "a", 8, "A♢ K♤ Q♢ J♢ 10♧"),
+				potPlayer(t, "b", 9, ""),
+				potPlayer(t, "c", 8, "10♡ J♤ Q♧ K♧ A♡"),
+			},
+			2, PotRules{OddChip: HIGHEST_SUIT},
+			map[poker.PlayerID]int{"a": 12, "b": 0, "c": 13},
+			1,
+		},
+		{
+			"hi/lo split with the odd chip to the high half",
+			[]PotPlayer{
+				potPlayer(t, "a", 10, "K♡ K♤ 9♢ 9♧ 2♧"),
+				potPlayer(t, "b", 10, "A♧ 2♢ 3♢ 4♢ 7♧"),
+				potPlayer(t, "c", 11, ""),
+			},
+			0, PotRules{OddChip: LEFT_OF_BUTTON, HiLo: true},
+			map[poker.PlayerID]int{"a": 16, "b": 15, "c": 0},
+			1,
+		},
+		{
+			"hi/lo without a qualifying low",
+			[]PotPlayer{
+				potPlayer(t, "a", 10, "K♡ K♤ 9♢ 9♧ 2♧"),
+				potPlayer(t, "b", 10, "A♧ 2♢ 3♢ 4♢ 9♤"),
+			},
+			0, PotRules{OddChip: LEFT_OF_BUTTON, HiLo: true},
+			map[poker.PlayerID]int{"a": 20, "b": 0},
+			1,
+		},
+		{
+			"hi/lo scoop and quartered low",
+			[]PotPlayer{
+				potPlayer(t, "a", 20, "A♡ 2♡ 3♡ 4♡ 5♡"),
+				potPlayer(t, "b", 20, "A♧ 2♢ 3♢ 4♢ 5♧"),
+				potPlayer(t, "c", 20, "K♡ K♤ 9♢ 9♧ 2♧"),
+			},
+			2, PotRules{OddChip: LEFT_OF_BUTTON, HiLo: true},
+			map[poker.PlayerID]int{"a": 45, "b": 15, "c": 0},
+			1,
+		},
+		{
+			"hands sharing board cards",
+			[]PotPlayer{
+				potPlayer(t, "a", 10, "A♡ K♤ Q♢ J♢ 9♧"),
+				potPlayer(t, "b", 10, "K♧ K♤ Q♢ J♢ 9♧"),
+			},
+			0, PotRules{OddChip: LEFT_OF_BUTTON, SharedCards: true},
+			map[poker.PlayerID]int{"a": 0, "b": 20},
+			1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			s, err := SettlePots(tc.players, tc.button, tc.rules)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !maps.Equal(s.Payouts, tc.expected) {
+				t.Errorf("expected payouts %v, got %v", tc.expected, s.Payouts)
+			}
+			if len(s.Pots) != tc.pots {
+				t.Errorf("expected %d pots, got %+v", tc.pots, s.Pots)
+			}
+		})
+	}
+}
+
+func TestSettlePotsRejectsInvalidInput(t *testing.T) {
+	a := potPlayer(t, "a", 10, "A♡ A♤ A♢ K♧ Q♡")
+	b := potPlayer(t, "b", 10, "K♡ K♤ 2♢ 3♢ 4♧")
+	rules := PotRules{OddChip: LEFT_OF_BUTTON}
+
+	cases := []struct {
+		description string
+		players     []PotPlayer
+		button      int
+		rules       PotRules
+	}{
+		{"button out of range", []PotPlayer{a, b}, 2, rules},
+		{"no odd chip rule", []PotPlayer{a, b}, 0, PotRules{}},
+		{"duplicate player", []PotPlayer{a, a}, 0, rules},
+		{"negative contribution", []PotPlayer{a, {ID: "b", Contribution: -1, Folded: true}}, 0, rules},
+		{"live player without hand", []PotPlayer{a, {ID: "b", Contribution: 10}}, 0, rules},
+		{"everyone folded", []PotPlayer{{ID: "a", Folded: true}, {ID: "b", Folded: true}}, 0, rules},
+		{"shared card", []PotPlayer{a, potPlayer(t, "b", 10, "A♡ K♤ 2♢ 3♢ 4♧")}, 0, rules},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			if _, err := SettlePots(tc.players, tc.button, tc.rules); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestSettlePotsAccountsForEveryChip(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 5))
+
+	for range 5000 {
+		d := deck.New()
+		d.Shuffle(rng.Uint64(), deck.CURRENT_SHUFFLE_VERSION)
+
+		players := make([]PotPlayer, 2+rng.IntN(8))
+		var total int
+		for i := range players {
+			cards, _ := d.Deal(eval.CARDS_PER_HAND)
+			hand, _ := standardRuleset.NewHand(cards)
+			players[i] = PotPlayer{
+				ID:           poker.PlayerID(rune('a' + i)),
+				Contribution: rng.IntN(5) * (1 + rng.IntN(7)),
+				Folded:       i > 0 && rng.IntN(3) == 0,
+				Hand:         hand,
+				Low:          cards,
+			}
+			total += players[i].Contribution
+		}
+		rules := PotRules{OddChip: OddChipRule(1 + rng.IntN(2)), HiLo: rng.IntN(2) == 0, NoFlop: rng.IntN(4) == 0}
+		if rng.IntN(2) == 0 {
+			rules.Rake = &RakePolicy{BasisPoints: 1000, Cap: 3, NoFlopNoDrop: true, JackpotDrop: 1, JackpotMinimum: 10}
+		}
+
+		s, err := SettlePots(players, rng.IntN(len(players)), rules)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var paid, potted, net int
+		for _, p := range players {
+			won := s.Payouts[p.ID]
+			if won < 0 || (p.Folded && won > 0) {
+				t.Fatalf("player %s won %d, folded: %t", p.ID, won, p.Folded)
+			}
+			paid += won
+		}
+		for _, pot := range s.Pots {
+			potted += pot.Amount
+			net += pot.Net
+			if pot.Amount != pot.Net+pot.Rake+pot.Jackpot || pot.Net < 0 {
+				t.Fatalf("pot does not add up: %+v", pot)
+			}
+			for _, id := range append(slices.Clone(pot.Winners), pot.LowWinners...) {
+				if !slices.Contains(pot.Eligible, id) {
+					t.Fatalf("player %s won a pot they were not eligible for", id)
+				}
+			}
+		}
+		if potted+s.Uncalled != total || net+s.Rake+s.Jackpot != potted || paid+s.Rake+s.Jackpot != total {
+			t.Fatalf("contributed %d, potted %d, uncalled %d, rake %d, jackpot %d, paid %d",
+				total, potted, s.Uncalled, s.Rake, s.Jackpot, paid)
+		}
+	}
+}
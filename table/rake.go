@@ -0,0 +1,100 @@
+package table
+
+import (
+	"slices"
+
+	"github.com/sdeboni/go-poker"
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+	"github.com/sdeboni/go-poker/holdem"
+)
+
+// RakePolicy is the house's take from a cash game pot.
+type RakePolicy struct {
+	// BasisPoints, hundredths of a percent, of the pot are raked, rounded
+	// down, up to Cap chips: 500 rakes 5%. A Cap of 0 means no cap.
+	BasisPoints int
+	Cap         int
+	// NoFlopNoDrop waives the rake and the jackpot drop for hands that end
+	// before the flop.
+	NoFlopNoDrop bool
+	// JackpotDrop chips go to the bad beat jackpot from every pot of at least
+	// JackpotMinimum chips.
+	JackpotDrop    int
+	JackpotMinimum int
+}
+
+// Take returns the rake and jackpot drop for a pot. The drop is taken only
+// from what the rake leaves.
+func (r RakePolicy) Take(pot int, noFlop bool) (rake, jackpot int) {
+	if pot <= 0 || (noFlop && r.NoFlopNoDrop) {
+		return 0, 0
+	}
+	rake = pot * r.BasisPoints / 10000
+	if r.Cap > 0 {
+		rake = min(rake, r.Cap)
+	}
+	if r.JackpotDrop > 0 && pot >= r.JackpotMinimum {
+		jackpot = min(r.JackpotDrop, pot-rake)
+	}
+	return rake, jackpot
+}
+
+// takeFromPots splits amount across the pots, main pot first, taking from
+// each no more than the earlier takes left in it.
+func takeFromPots(pots []pot, amount int, earlier ...[]int) []int {
+	taken := make([]int, len(pots))
+	for i, p := range pots {
+		left := p.amount
+		for _, e := range earlier {
+			left -= e[i]
+		}
+		taken[i] = min(amount, left)
+		amount -= taken[i]
+	}
+	return taken
+}
+
+// ShownHand is a player's hole cards and the hand they made with them.
+type ShownHand struct {
+	Hole holdem.HoleCards
+	Hand eval.Hand
+}
+
+// BadBeatQualifier decides whether a losing hand wins the bad beat jackpot.
+var standardRuleset = poker.DefaultRuleset()
+
+type BadBeatQualifier struct {
+	// The losing hand must rank at least Rank in the order of its ruleset, the
+	// default one for hands from ParseHand, and when it is exactly Rank, its
+	// main group (the quads of four of a kind, the trips of a full house, the
+	// top card of a straight or flush...) must be at least Of. For instance
+	// FOUR_OF_A_KIND of EIGHT qualifies quad eights or better.
+	Rank eval.HandRank
+	Of   card.Rank
+	// BothHoleCards requires both players to use both hole cards.
+	BothHoleCards bool
+}
+
+func (q BadBeatQualifier) Qualifies(losing, winning ShownHand) bool {
+	if winning.Hand.Compare(losing.Hand) <= 0 {
+		return false
+	}
+	rules := poker.RulesetOf(losing.Hand)
+	if rules == nil {
+		rules = standardRuleset
+	}
+	c, ok := rules.CompareRanks(losing.Hand.Rank(), q.Rank)
+	if !ok || c < 0 || (c == 0 && losing.Hand.MainRank() < q.Of) {
+		return false
+	}
+	if q.BothHoleCards {
+		for _, shown := range []ShownHand{losing, winning} {
+			cards := shown.Hand.Cards()
+			if !slices.Contains(cards, shown.Hole[0]) || !slices.Contains(cards, shown.Hole[1]) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,144 @@
+package table
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/sdeboni/go-poker"
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/eval"
+)
+
+func TestRakePolicyTake(t *testing.T) {
+	policy := RakePolicy{BasisPoints: 500, Cap: 4, NoFlopNoDrop: true, JackpotDrop: 1, JackpotMinimum: 20}
+
+	cases := []struct {
+		description   string
+		policy        RakePolicy
+		pot           int
+		noFlop        bool
+		rake, jackpot int
+	}{
+		{"rounded down", policy, 39, false, 1, 1},
+		{"below jackpot minimum", policy, 19, false, 0, 0},
+		{"capped", policy, 200, false, 4, 1},
+		{"no flop no drop", policy, 200, true, 0, 0},
+		{"exact at 4.1%", RakePolicy{BasisPoints: 410}, 3000, false, 123, 0},
+		{"exact at 2.8%", RakePolicy{BasisPoints: 280}, 2750, false, 77, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			rake, jackpot := tc.policy.Take(tc.pot, tc.noFlop)
+			if rake != tc.rake || jackpot != tc.jackpot {
+				t.Errorf("expected rake %d and jackpot %d, got %d and %d", tc.rake, tc.jackpot, rake, jackpot)
+			}
+		})
+	}
+}
+
+func TestSettleRakedPots(t *testing.T) {
+	players := []PotPlayer{
+		potPlayer(t, "a", 10, "A♡ A♤ A♢ K♧ Q♡"),
+		potPlayer(t, "b", 60, "K♡ K♤ 2♢ 3♢ 4♧"),
+		potPlayer(t, "c", 40, "Q♢ Q♤ 5♢ 6♢ 8♧"),
+	}
+	rules := PotRules{
+		OddChip: LEFT_OF_BUTTON,
+		Rake:    &RakePolicy{BasisPoints: 1000, Cap: 5, JackpotDrop: 2},
+	}
+
+	s, err := SettlePots(players, 0, rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Uncalled != 20 || s.Rake != 5 || s.Jackpot != 2 {
+		t.Errorf("expected uncalled 20, rake 5 and jackpot 2, got %d, %d and %d", s.Uncalled, s.Rake, s.Jackpot)
+	}
+	// The main pot of 30 pays the rake and the drop, the side pot of 60 is
+	// untouched.
+	if len(s.Pots) != 2 || s.Pots[0].Net != 23 || s.Pots[1].Net != 60 {
+		t.Errorf("unexpected pots: %+v", s.Pots)
+	}
+	expected := map[poker.PlayerID]int{"a": 23, "b": 80, "c": 0}
+	if !maps.Equal(s.Payouts, expected) {
+		t.Errorf("expected payouts %v, got %v", expected, s.Payouts)
+	}
+}
+
+func TestBadBeatQualifier(t *testing.T) {
+	shown := func(hole, board string) ShownHand {
+		h := mustHoleCards(t, hole)
+		hand, err := poker.ParseHand(board)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ShownHand{h, hand}
+	}
+	rules, soko, shortDeck := poker.DefaultRuleset(), poker.SokoRuleset(), poker.ShortDeckRuleset()
+	ruled := func(r *poker.Ruleset, hole, board string) ShownHand {
+		h := mustHoleCards(t, hole)
+		hand, err := r.ParseHand(board)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ShownHand{h, hand}
+	}
+	quadEights := BadBeatQualifier{Rank: eval.FOUR_OF_A_KIND, Of: card.EIGHT, BothHoleCards: true}
+
+	cases := []struct {
+		description     string
+		qualifier       BadBeatQualifier
+		losing, winning ShownHand
+		expected        bool
+	}{
+		{
+			"quad eights beaten by a straight flush", quadEights,
+			shown("8♡ 8♧", "8♡ 8♧ 8♤ 8♢ 2♡"), shown("9♤ 10♤", "9♤ 10♤ J♤ Q♤ K♤"), true,
+		},
+		{
+			"quad sevens do not qualify", quadEights,
+			shown("7♡ 7♧", "7♡ 7♧ 7♤ 7♢ 2♡"), shown("9♤ 10♤", "9♤ 10♤ J♤ Q♤ K♤"), false,
+		},
+		{
+			"loser plays one hole card", quadEights,
+			shown("8♡ A♧", "8♡ 8♧ 8♤ 8♢ 2♡"), shown("9♤ 10♤", "9♤ 10♤ J♤ Q♤ K♤"), false,
+		},
+		{
+			"hole cards need not play", BadBeatQualifier{Rank: eval.FOUR_OF_A_KIND, Of: card.EIGHT},
+			shown("8♡ A♧", "8♡ 8♧ 8♤ 8♢ 2♡"), shown("9♤ 10♤", "9♤ 10♤ J♤ Q♤ K♤"), true,
+		},
+		{
+			"aces full of kings or better", BadBeatQualifier{Rank: eval.FULL_HOUSE, Of: card.ACE},
+			shown("A♡ A♧", "A♡ A♧ A♤ K♢ K♡"), shown("K♤ K♧", "K♤ K♧ K♢ K♡ 2♡"), true,
+		},
+		{
+			"ruleset hands qualify by their quads", BadBeatQualifier{Rank: eval.FOUR_OF_A_KIND, Of: card.EIGHT},
+			ruled(rules, "8♡ 8♧", "8♡ 8♧ 8♤ 8♢ 2♡"), ruled(rules, "9♤ 10♤", "9♤ 10♤ J♤ Q♤ K♤"), true,
+		},
+		{
+			"ruleset quad sevens do not qualify on their kicker", BadBeatQualifier{Rank: eval.FOUR_OF_A_KIND, Of: card.EIGHT},
+			ruled(rules, "7♡ 7♧", "7♡ 7♧ 7♤ 7♢ K♡"), ruled(rules, "9♤ 10♤", "9♤ 10♤ J♤ Q♤ K♤"), false,
+		},
+		{
+			"a Sökö four-flush ranks below quads", BadBeatQualifier{Rank: eval.FOUR_OF_A_KIND, Of: card.TWO},
+			ruled(soko, "K♡ 6♡", "K♡ 6♡ 3♡ 2♡ 7♧"), ruled(soko, "9♤ 9♧", "9♤ 9♧ 9♢ 4♤ 4♧"), false,
+		},
+		{
+			"a short deck flush ranks above a full house", BadBeatQualifier{Rank: eval.FULL_HOUSE, Of: card.ACE},
+			ruled(shortDeck, "A♡ Q♡", "6♡ 8♡ 10♡ Q♡ A♡"), ruled(shortDeck, "9♤ 10♤", "6♤ 7♤ 8♤ 9♤ 10♤"), true,
+		},
+		{
+			"the loser must lose", quadEights,
+			shown("9♤ 10♤", "9♤ 10♤ J♤ Q♤ K♤"), shown("8♡ 8♧", "8♡ 8♧ 8♤ 8♢ 2♡"), false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			if got := tc.qualifier.Qualifies(tc.losing, tc.winning); got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package table
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/deck"
+	"github.com/sdeboni/go-poker/eval"
+	"github.com/sdeboni/go-poker/holdem"
+)
+
+// Transcripts record five-card stud or hold'em hands. In five-card stud every
+// seat is dealt five cards, one at a time starting from the first seat. In
+// hold'em every seat is dealt two hole cards the same way, then the board is
+// dealt with a card burned before the flop, the turn and the river. Seats that
+// have not folded go to showdown.
+
+type TranscriptGame int
+
+const (
+	FIVE_CARD_STUD TranscriptGame = iota
+	HOLDEM
+)
+
+type ActionType int
+
+const (
+	FOLD ActionType = iota + 1
+	CHECK
+	CALL
+	BET
+	RAISE
+)
+
+func (a ActionType) String() string {
+	switch a {
+	case FOLD:
+		return "fold"
+	case CHECK:
+		return "check"
+	case CALL:
+		return "call"
+	case BET:
+		return "bet"
+	case RAISE:
+		return "raise"
+	default:
+		return fmt.Sprintf("ActionType(%d)", int(a))
+	}
+}
+
+type Action struct {
+	Seat int        `json:"seat"`
+	Type ActionType `json:"type"`
+	// Amount is the chips the action puts in.
+	Amount int `json:"amount,omitempty"`
+	// Street is required in hold'em, where it decides how much of the board
+	// is dealt when the hand ends before showdown, and left out in stud.
+	Street holdem.Street `json:"street,omitempty"`
+}
+
+type Transcript struct {
+	Game           TranscriptGame      `json:"game,omitempty"`
+	Seed           uint64              `json:"seed"`
+	ShuffleVersion deck.ShuffleVersion `json:"shuffleVersion"`
+	Seats          []string            `json:"seats"`
+	Actions        []Action            `json:"actions"`
+	// Hands holds each seat's cards in the order they were dealt.
+	Hands []string `json:"hands"`
+	// Board holds the hold'em board cards dealt, in order.
+	Board string `json:"board,omitempty"`
+	// Winners holds the winning seat ids in seat order.
+	Winners []string `json:"winners"`
+}
+
+// DivergenceError reports a replay that did not reproduce what was recorded,
+// for instance because the shuffle or the evaluator changed since.
+type DivergenceError struct {
+	Field    string
+	Recorded []string
+	Replayed []string
+}
+
+func (e *DivergenceError) Error() string {
+	return fmt.Sprintf("replay diverged on %s: recorded %v, replayed %v", e.Field, e.Recorded, e.Replayed)
+}
+
+// Record deals and plays a five-card stud hand.
+func Record(seed uint64, version deck.ShuffleVersion, seats []string, actions []Action) (*Transcript, error) {
+	return record(FIVE_CARD_STUD, seed, version, seats, actions)
+}
+
+// RecordHoldem deals and plays a hold'em hand.
+func RecordHoldem(seed uint64, version deck.ShuffleVersion, seats []string, actions []Action) (*Transcript, error) {
+	return record(HOLDEM, seed, version, seats, actions)
+}
+
+func record(game TranscriptGame, seed uint64, version deck.ShuffleVersion, seats []string, actions []Action) (*Transcript, error) {
+	result, err := play(game, seed, version, seats, actions)
+	if err != nil {
+		return nil, err
+	}
+	return &Transcript{
+		Game:           game,
+		Seed:           seed,
+		ShuffleVersion: version,
+		Seats:          slices.Clone(seats),
+		Actions:        slices.Clone(actions),
+		Hands:          result.hands,
+		Board:          result.board,
+		Winners:        result.winners,
+	}, nil
+}
+
+// Replay re-runs the transcript and returns a *DivergenceError if the dealt
+// hands, the board or the winners differ from the recorded ones.
+func Replay(t *Transcript) error {
+	result, err := play(t.Game, t.Seed, t.ShuffleVersion, t.Seats, t.Actions)
+	if err != nil {
+		return err
+	}
+	if !slices.Equal(result.hands, t.Hands) {
+		return &DivergenceError{"hands", t.Hands, result.hands}
+	}
+	if result.board != t.Board {
+		return &DivergenceError{"board", []string{t.Board}, []string{result.board}}
+	}
+	if !slices.Equal(result.winners, t.Winners) {
+		return &DivergenceError{"winners", t.Winners, result.winners}
+	}
+	return nil
+}
+
+type played struct {
+	hands   []string
+	board   string
+	winners []string
+}
+
+func play(game TranscriptGame, seed uint64, version deck.ShuffleVersion, seats []string, actions []Action) (*played, error) {
+	holeCards, boardCards := eval.CARDS_PER_HAND, 0
+	switch game {
+	case FIVE_CARD_STUD:
+	case HOLDEM:
+		// The board plus a burn card before each of its three streets.
+		holeCards, boardCards = 2, holdem.BOARD_SIZE+3
+	default:
+		return nil, fmt.Errorf("invalid game: %d", game)
+	}
+	if len(seats) < 2 || len(seats)*holeCards+boardCards > card.DECK_SIZE {
+		return nil, fmt.Errorf("invalid number of seats: %d", len(seats))
+	}
+
+	deck, err := deck.NewShuffled(seed, version)
+	if err != nil {
+		return nil, err
+	}
+
+	dealt := make([][]card.Card, len(seats))
+	for range holeCards {
+		for seat := range seats {
+			card, err := deck.Deal(1)
+			if err != nil {
+				return nil, err
+			}
+			dealt[seat] = append(dealt[seat], card[0])
+		}
+	}
+
+	folded := make([]bool, len(seats))
+	remaining := len(seats)
+	street := holdem.PREFLOP
+	for i, action := range actions {
+		if action.Seat < 0 || action.Seat >= len(seats) {
+			return nil, fmt.Errorf("action %d: invalid seat %d", i, action.Seat)
+		}
+		if folded[action.Seat] {
+			return nil, fmt.Errorf("action %d: seat %d acted after folding", i, action.Seat)
+		}
+		if action.Type < FOLD || action.Type > RAISE {
+			return nil, fmt.Errorf("action %d: invalid action type %d", i, action.Type)
+		}
+		if game == HOLDEM {
+			if action.Street < street || action.Street > holdem.RIVER {
+				return nil, fmt.Errorf("action %d: street %d out of order", i, action.Street)
+			}
+			street = action.Street
+		} else if action.Street != 0 {
+			return nil, fmt.Errorf("action %d: street %d in a stud hand", i, action.Street)
+		}
+		if action.Type == FOLD {
+			if remaining == 1 {
+				return nil, fmt.Errorf("action %d: last remaining seat %d cannot fold", i, action.Seat)
+			}
+			folded[action.Seat] = true
+			remaining--
+		}
+	}
+
+	var board []card.Card
+	if game == HOLDEM {
+		if remaining > 1 {
+			street = holdem.RIVER
+		}
+		if board, err = dealBoard(deck, street); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &played{hands: make([]string, len(seats))}
+	if len(board) > 0 {
+		result.board = card.Format(board)
+	}
+	values := make([]eval.HandValue, len(seats))
+	for seat, cards := range dealt {
+		result.hands[seat] = card.Format(cards)
+		values[seat] = eval.NewCardSet(append(slices.Clone(cards), board...)...).Evaluate()
+	}
+
+	var best eval.HandValue
+	for seat, value := range values {
+		if !folded[seat] && value > best {
+			best = value
+		}
+	}
+	for seat, value := range values {
+		if !folded[seat] && value == best {
+			result.winners = append(result.winners, seats[seat])
+		}
+	}
+	return result, nil
+}
+
+// dealBoard burns a card and deals the flop, then does the same for the turn
+// and the river, stopping at the street the hand ended on.
+func dealBoard(deck *deck.Deck, street holdem.Street) ([]card.Card, error) {
+	var board []card.Card
+	for _, size := range []int{3, 1, 1}[:street-holdem.PREFLOP] {
+		cards, err := deck.Deal(1 + size)
+		if err != nil {
+			return nil, err
+		}
+		board = append(board, cards[1:]...)
+	}
+	return board, nil
+}
@@ -0,0 +1,198 @@
+package table
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/sdeboni/go-poker/deck"
+	"github.com/sdeboni/go-poker/holdem"
+)
+
+var transcriptSeats = []string{"alice", "bob", "carol"}
+
+var transcriptActions = []Action{
+	{Seat: 0, Type: BET, Amount: 10},
+	{Seat: 1, Type: FOLD},
+	{Seat: 2, Type: CALL, Amount: 10},
+}
+
+func TestRecordIsStable(t *testing.T) {
+	tr, err := Record(42, deck.SHUFFLE_V1, transcriptSeats, transcriptActions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Pinned output: if this changes, SHUFFLE_V1 changed and old transcripts no longer replay.
+	expectedHands := []string{"5♡ K♢ K♤ 10♡ 3♧", "4♡ Q♤ J♤ 2♤ A♤", "2♡ 8♢ 8♧ 4♤ 4♧"}
+	if !slices.Equal(tr.Hands, expectedHands) {
+		t.Errorf("\nexpected hands: %v\ngot           : %v", expectedHands, tr.Hands)
+	}
+	if !slices.Equal(tr.Winners, []string{"carol"}) {
+		t.Errorf("expected carol to win, got %v", tr.Winners)
+	}
+}
+
+// holdemActions end the hand on the flop.
+var holdemActions = []Action{
+	{Seat: 0, Type: RAISE, Amount: 20, Street: holdem.PREFLOP},
+	{Seat: 1, Type: CALL, Amount: 20, Street: holdem.PREFLOP},
+	{Seat: 2, Type: FOLD, Street: holdem.PREFLOP},
+	{Seat: 1, Type: BET, Amount: 30, Street: holdem.FLOP},
+	{Seat: 0, Type: FOLD, Street: holdem.FLOP},
+}
+
+func TestRecordHoldem(t *testing.T) {
+	// The seed deals the same cards as in TestRecordIsStable: two to each
+	// seat, then a burn before each street.
+	showdown := append(slices.Clone(holdemActions[:4]), Action{Seat: 0, Type: CALL, Amount: 30, Street: holdem.FLOP})
+	cases := []struct {
+		description string
+		actions     []Action
+		board       string
+		winners     []string
+	}{
+		{"ends on the flop", holdemActions, "J♤ 8♧ 10♡", []string{"bob"}},
+		{"deals the board out for a showdown", showdown, "J♤ 8♧ 10♡ 4♤ A♤", []string{"bob"}},
+	}
+
+	for _, tc := range cases {
+		tr, err := RecordHoldem(42, deck.SHUFFLE_V1, transcriptSeats, tc.actions)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectedHands := []string{"5♡ K♢", "4♡ Q♤", "2♡ 8♢"}
+		if !slices.Equal(tr.Hands, expectedHands) || tr.Board != tc.board || !slices.Equal(tr.Winners, tc.winners) {
+			t.Errorf("%s: expected hands %v, board %s and winners %v, got %v, %s and %v",
+				tc.description, expectedHands, tc.board, tc.winners, tr.Hands, tr.Board, tr.Winners)
+		}
+		if err := Replay(tr); err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.description, err.Error())
+		}
+	}
+}
+
+func TestReplayRoundTrip(t *testing.T) {
+	tr, err := Record(7, deck.SHUFFLE_V1, transcriptSeats, transcriptActions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(tr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Transcript
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Replay(&decoded); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+}
+
+func TestReplayDetectsDivergence(t *testing.T) {
+	tr, err := Record(42, deck.SHUFFLE_V1, transcriptSeats, transcriptActions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tampered := *tr
+	tampered.Winners = []string{"alice"}
+	var divergence *DivergenceError
+	if err := Replay(&tampered); !errors.As(err, &divergence) || divergence.Field != "winners" {
+		t.Errorf("expected divergence on winners, got: %v", err)
+	}
+
+	tampered = *tr
+	tampered.Seed++
+	if err := Replay(&tampered); !errors.As(err, &divergence) || divergence.Field != "hands" {
+		t.Errorf("expected divergence on hands, got: %v", err)
+	}
+
+	holdem, err := RecordHoldem(42, deck.SHUFFLE_V1, transcriptSeats, holdemActions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	holdem.Board = "J♤ 8♧ 9♡"
+	if err := Replay(holdem); !errors.As(err, &divergence) || divergence.Field != "board" {
+		t.Errorf("expected divergence on the board, got: %v", err)
+	}
+}
+
+func TestReplayStudTranscriptWithoutGame(t *testing.T) {
+	data := `{"seed":42,"shuffleVersion":1,"seats":["alice","bob","carol"],
+		"actions":[{"seat":0,"type":4,"amount":10},{"seat":1,"type":1},{"seat":2,"type":3,"amount":10}],
+		"hands":["5♡ K♢ K♤ 10♡ 3♧","4♡ Q♤ J♤ 2♤ A♤","2♡ 8♢ 8♧ 4♤ 4♧"],"winners":["carol"]}`
+	var tr Transcript
+	if err := json.Unmarshal([]byte(data), &tr); err != nil {
+		t.Fatal(err)
+	}
+	if err := Replay(&tr); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+}
+
+func TestRecordHoldemRejectsStreets(t *testing.T) {
+	cases := []struct {
+		description string
+		actions     []Action
+	}{
+		{"missing street", []Action{{Seat: 0, Type: CHECK}}},
+		{"street after the river", []Action{{Seat: 0, Type: CHECK, Street: holdem.RIVER + 1}}},
+		{"streets out of order", []Action{{Seat: 0, Type: CHECK, Street: holdem.FLOP}, {Seat: 1, Type: CHECK, Street: holdem.PREFLOP}}},
+	}
+
+	for _, tc := range cases {
+		if _, err := RecordHoldem(1, deck.SHUFFLE_V1, transcriptSeats, tc.actions); err == nil {
+			t.Errorf("%s: expected error", tc.description)
+		}
+	}
+}
+
+func TestRecordFullTables(t *testing.T) {
+	seats := func(n int) []string {
+		names := make([]string, n)
+		for i := range names {
+			names[i] = fmt.Sprint("seat", i)
+		}
+		return names
+	}
+	if _, err := Record(1, deck.SHUFFLE_V1, seats(10), nil); err != nil {
+		t.Errorf("stud: %v", err)
+	}
+	if _, err := RecordHoldem(1, deck.SHUFFLE_V1, seats(22), nil); err != nil {
+		t.Errorf("hold'em: %v", err)
+	}
+	if _, err := RecordHoldem(1, deck.SHUFFLE_V1, seats(23), nil); err == nil {
+		t.Error("expected too many hold'em seats to deal the board and burns")
+	}
+}
+
+func TestRecordRejectsInvalidTranscripts(t *testing.T) {
+	cases := []struct {
+		description string
+		version     deck.ShuffleVersion
+		seats       []string
+		actions     []Action
+	}{
+		{"unknown shuffle version", 99, transcriptSeats, nil},
+		{"single seat", deck.SHUFFLE_V1, []string{"alice"}, nil},
+		{"too many seats to deal", deck.SHUFFLE_V1, make([]string, 11), nil},
+		{"action from unknown seat", deck.SHUFFLE_V1, transcriptSeats, []Action{{Seat: 3, Type: CHECK}}},
+		{"action after fold", deck.SHUFFLE_V1, transcriptSeats, []Action{{Seat: 1, Type: FOLD}, {Seat: 1, Type: CALL}}},
+		{"everyone folds", deck.SHUFFLE_V1, transcriptSeats[:2], []Action{{Seat: 0, Type: FOLD}, {Seat: 1, Type: FOLD}}},
+		{"street in a stud hand", deck.SHUFFLE_V1, transcriptSeats, []Action{{Seat: 0, Type: CHECK, Street: holdem.FLOP}}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			if tr, err := Record(1, tc.version, tc.seats, tc.actions); err == nil {
+				t.Errorf("expected error, got transcript: %v", tr)
+			}
+		})
+	}
+}
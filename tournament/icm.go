@@ -0,0 +1,201 @@
+package tournament
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand/v2"
+	"slices"
+)
+
+// MAX_EXACT_ICM_PLAYERS bounds ICMEquity, whose cost doubles with each player.
+const MAX_EXACT_ICM_PLAYERS = 20
+
+// ICMEquity returns each player's share of the payouts, listed from first
+// place down, under the Malmuth-Harville model: a player finishes in the
+// highest remaining place with probability proportional to their stack.
+func ICMEquity(stacks []int, payouts []float64) ([]float64, error) {
+	if err := validateICM(stacks, payouts); err != nil {
+		return nil, err
+	}
+	if len(stacks) > MAX_EXACT_ICM_PLAYERS {
+		return nil, fmt.Errorf("exact ICM supports at most %d players, got %d", MAX_EXACT_ICM_PLAYERS, len(stacks))
+	}
+
+	n := len(stacks)
+	places := min(n, len(payouts))
+	var total float64
+	for _, s := range stacks {
+		total += float64(s)
+	}
+
+	// placed[mask] is the probability that the players in mask took the top
+	// popcount(mask) places, in any order.
+	placed := make([]float64, 1<<n)
+	chips := make([]float64, 1<<n)
+	placed[0] = 1
+	equity := make([]float64, n)
+
+	for mask := range placed {
+		p := placed[mask]
+		if p == 0 {
+			continue
+		}
+		place := bits.OnesCount(uint(mask))
+		if place >= places {
+			continue
+		}
+		left := total - chips[mask]
+		for i, s := range stacks {
+			if mask&(1<<i) != 0 {
+				continue
+			}
+			q := p * float64(s) / left
+			equity[i] += q * payouts[place]
+			next := mask | 1<<i
+			placed[next] += q
+			chips[next] = chips[mask] + float64(s)
+		}
+	}
+	return equity, nil
+}
+
+// ICMEquitySampled approximates ICMEquity for large fields by sampling
+// finishing orders from the same model.
+func ICMEquitySampled(stacks []int, payouts []float64, trials int, rng *rand.Rand) ([]float64, error) {
+	if err := validateICM(stacks, payouts); err != nil {
+		return nil, err
+	}
+	if trials < 1 {
+		return nil, fmt.Errorf("invalid number of trials: %d", trials)
+	}
+
+	places := min(len(stacks), len(payouts))
+	equity := make([]float64, len(stacks))
+	remaining := make([]int, len(stacks))
+
+	for range trials {
+		var left int
+		for i, s := range stacks {
+			remaining[i] = s
+			left += s
+		}
+		for place := range places {
+			pick := rng.IntN(left)
+			for i, s := range remaining {
+				if pick < s {
+					equity[i] += payouts[place]
+					left -= s
+					remaining[i] = 0
+					break
+				}
+				pick -= s
+			}
+		}
+	}
+
+	for i := range equity {
+		equity[i] /= float64(trials)
+	}
+	return equity, nil
+}
+
+func validateICM(stacks []int, payouts []float64) error {
+	if len(stacks) == 0 {
+		return fmt.Errorf("no stacks given")
+	}
+	for i, s := range stacks {
+		if s <= 0 {
+			return fmt.Errorf("player %d has invalid stack %d", i, s)
+		}
+	}
+	for i, p := range payouts {
+		if p < 0 {
+			return fmt.Errorf("place %d has negative payout %f", i+1, p)
+		}
+	}
+	return nil
+}
+
+type ChopMethod int
+
+const (
+	// ICM_CHOP pays each player their ICM equity.
+	ICM_CHOP ChopMethod = iota + 1
+	// CHIP_CHOP pays everyone the lowest remaining payout, then splits the
+	// rest in proportion to chips.
+	CHIP_CHOP
+)
+
+func (m ChopMethod) String() string {
+	switch m {
+	case ICM_CHOP:
+		return "ICM chop"
+	case CHIP_CHOP:
+		return "chip chop"
+	default:
+		return fmt.Sprintf("ChopMethod(%d)", int(m))
+	}
+}
+
+type ChopProposal struct {
+	Method  ChopMethod
+	Amounts []int
+}
+
+// ChopProposals proposes deals for the players left in a tournament, given
+// the payouts still to be awarded from first place down. Every proposal
+// distributes exactly the remaining prize money.
+func ChopProposals(stacks []int, payouts []int) ([]ChopProposal, error) {
+	if len(payouts) < len(stacks) {
+		return nil, fmt.Errorf("%d players left but only %d payouts", len(stacks), len(payouts))
+	}
+
+	prizes := make([]float64, len(stacks))
+	var pool int
+	for i, p := range payouts[:len(stacks)] {
+		prizes[i] = float64(p)
+		pool += p
+	}
+
+	icm, err := ICMEquity(stacks, prizes)
+	if err != nil {
+		return nil, err
+	}
+
+	var chips int
+	for _, s := range stacks {
+		chips += s
+	}
+	floor := payouts[len(stacks)-1]
+	chip := make([]float64, len(stacks))
+	for i, s := range stacks {
+		chip[i] = float64(floor) + float64(pool-floor*len(stacks))*float64(s)/float64(chips)
+	}
+
+	return []ChopProposal{
+		{ICM_CHOP, roundPreservingTotal(icm, pool)},
+		{CHIP_CHOP, roundPreservingTotal(chip, pool)},
+	}, nil
+}
+
+// roundPreservingTotal rounds amounts down, then hands the remaining units to
+// the largest fractional parts so the result adds up to total.
+func roundPreservingTotal(amounts []float64, total int) []int {
+	result := make([]int, len(amounts))
+	order := make([]int, len(amounts))
+	for i, a := range amounts {
+		result[i] = int(math.Floor(a))
+		total -= result[i]
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(amounts[b]-math.Floor(amounts[b]), amounts[a]-math.Floor(amounts[a]))
+	})
+	for i := 0; total > 0; i = (i + 1) % len(order) {
+		result[order[i]]++
+		total--
+	}
+	return result
+}
@@ -0,0 +1,116 @@
+package tournament
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/sdeboni/go-poker/card"
+	"github.com/sdeboni/go-poker/holdem"
+)
+
+func TestICMEquity(t *testing.T) {
+	cases := []struct {
+		description string
+		stacks      []int
+		payouts     []float64
+		expected    []float64
+	}{
+		{"equal stacks share equally", []int{100, 100, 100}, []float64{50, 30, 20}, []float64{100.0 / 3, 100.0 / 3, 100.0 / 3}},
+		{"heads up", []int{300, 100}, []float64{70, 30}, []float64{60, 40}},
+		// P(a first) = 1/2, P(b first) = 1/3, P(c first) = 1/6; second place
+		// follows from the remaining stacks.
+		{"three players, two paid", []int{300, 200, 100}, []float64{70, 30}, []float64{
+			70.0/2 + 30*(1.0/3*3/4+1.0/6*3/5),
+			70.0/3 + 30*(1.0/2*2/3+1.0/6*2/5),
+			70.0/6 + 30*(1.0/2*1/3+1.0/3*1/4),
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			got, err := ICMEquity(tc.stacks, tc.payouts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := range got {
+				if math.Abs(got[i]-tc.expected[i]) > 1e-9 {
+					t.Errorf("expected %v, got %v", tc.expected, got)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestICMEquitySampledApproximatesExact(t *testing.T) {
+	stacks := []int{5000, 3000, 2000, 1500, 1000, 500}
+	payouts := []float64{50, 30, 20}
+
+	exact, err := ICMEquity(stacks, payouts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sampled, err := ICMEquitySampled(stacks, payouts, 100000, rand.New(rand.NewPCG(5, 6)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range exact {
+		if math.Abs(exact[i]-sampled[i]) > 0.5 {
+			t.Errorf("player %d: exact %f, sampled %f", i, exact[i], sampled[i])
+		}
+	}
+}
+
+func TestChopProposals(t *testing.T) {
+	stacks := []int{600, 300, 100}
+	payouts := []int{5000, 3000, 2001, 1000}
+
+	proposals, err := ChopProposals(stacks, payouts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, proposal := range proposals {
+		var total int
+		for i, amount := range proposal.Amounts {
+			if amount < payouts[len(stacks)-1] {
+				t.Errorf("%s: player %d gets %d, less than third place", proposal.Method, i, amount)
+			}
+			total += amount
+		}
+		if total != 10001 {
+			t.Errorf("%s: expected 10001 to be distributed, got %d", proposal.Method, total)
+		}
+	}
+
+	chip := proposals[1].Amounts
+	// Everyone is guaranteed 2001; the remaining 3998 goes 60/30/10.
+	if chip[0] != 4400 || chip[1] != 3200 || chip[2] != 2401 {
+		t.Errorf("unexpected chip chop: %v", chip)
+	}
+}
+
+func TestPushFoldChart(t *testing.T) {
+	chart, err := holdem.NewPushFoldChart(10, holdem.PreflopClassEquities(), 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	aces := holdem.NewHandClass(card.New(card.ACE, card.HEARTS), card.New(card.ACE, card.SPADES))
+	trash := holdem.NewHandClass(card.New(card.SEVEN, card.HEARTS), card.New(card.TWO, card.SPADES))
+	if chart.Push[aces] != 1 || chart.Call[aces] != 1 {
+		t.Errorf("expected aces to always shove and call, got %f and %f", chart.Push[aces], chart.Call[aces])
+	}
+	if chart.Call[trash] > 0.1 {
+		t.Errorf("expected 72o to fold to a shove at 10 big blinds, got %f", chart.Call[trash])
+	}
+
+	var pushed, called int
+	for class := range holdem.HandClass(holdem.HAND_CLASSES) {
+		pushed += int(math.Round(chart.Push[class])) * class.Combos()
+		called += int(math.Round(chart.Call[class])) * class.Combos()
+	}
+	if pushed <= called {
+		t.Errorf("expected the shoving range (%d combos) to be wider than the calling range (%d)", pushed, called)
+	}
+}
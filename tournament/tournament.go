@@ -0,0 +1,366 @@
+// Package tournament runs multi-table tournaments on top of hand results
+// reported by a table engine: blind levels, eliminations, table balancing
+// and payouts, and ICM equity for deals. It keeps no clock of its own; levels
+// advance with hands played.
+package tournament
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+)
+
+type BlindLevel struct {
+	SmallBlind int
+	BigBlind   int
+	Ante       int
+	// Hands is how many hands, counted across all tables, the level lasts.
+	// The last level of a schedule lasts forever.
+	Hands int
+}
+
+type Schedule []BlindLevel
+
+// Level returns the level in play after handsPlayed hands, the zero level
+// for an empty schedule.
+func (s Schedule) Level(handsPlayed int) BlindLevel {
+	if len(s) == 0 {
+		return BlindLevel{}
+	}
+	for _, level := range s[:len(s)-1] {
+		if handsPlayed < level.Hands {
+			return level
+		}
+		handsPlayed -= level.Hands
+	}
+	return s[len(s)-1]
+}
+
+type Entrant struct {
+	ID    string
+	Stack int
+}
+
+// HandResult is what a table reports after each hand: the stack of every
+// player seated at the table once the hand is over.
+type HandResult struct {
+	Table  int
+	Stacks map[string]int
+}
+
+// Finish is a player's final place. Players busting in the same hand with the
+// same starting stack tie and share the best of the places they cover.
+type Finish struct {
+	ID    string
+	Place int
+}
+
+type Move struct {
+	ID        string
+	FromTable int
+	ToTable   int
+}
+
+type table struct {
+	players []string
+	broken  bool
+}
+
+type Tournament struct {
+	schedule    Schedule
+	tableSize   int
+	stacks      map[string]int
+	tableOf     map[string]int
+	tables      []table
+	finishes    []Finish
+	handsPlayed int
+}
+
+// New seats entrants in the order given, dealing them round-robin across as
+// few tables as possible; callers draw for seats by shuffling entrants first.
+func New(entrants []Entrant, tableSize int, schedule Schedule) (*Tournament, error) {
+	if len(entrants) < 2 {
+		return nil, fmt.Errorf("tournament needs at least 2 entrants, got %d", len(entrants))
+	}
+	if tableSize < 2 {
+		return nil, fmt.Errorf("invalid table size: %d", tableSize)
+	}
+	if len(schedule) == 0 {
+		return nil, fmt.Errorf("blind schedule is empty")
+	}
+
+	t := &Tournament{
+		schedule:  schedule,
+		tableSize: tableSize,
+		stacks:    make(map[string]int, len(entrants)),
+		tableOf:   make(map[string]int, len(entrants)),
+		tables:    make([]table, tablesNeeded(len(entrants), tableSize)),
+	}
+
+	for i, entrant := range entrants {
+		if _, ok := t.stacks[entrant.ID]; ok {
+			return nil, fmt.Errorf("duplicate entrant: %s", entrant.ID)
+		}
+		if entrant.Stack <= 0 {
+			return nil, fmt.Errorf("entrant %s has invalid stack %d", entrant.ID, entrant.Stack)
+		}
+		t.stacks[entrant.ID] = entrant.Stack
+		t.seat(entrant.ID, i%len(t.tables))
+	}
+	return t, nil
+}
+
+func (t *Tournament) Level() BlindLevel {
+	return t.schedule.Level(t.handsPlayed)
+}
+
+func (t *Tournament) HandsPlayed() int {
+	return t.handsPlayed
+}
+
+func (t *Tournament) Stack(id string) int {
+	return t.stacks[id]
+}
+
+func (t *Tournament) Remaining() int {
+	return len(t.tableOf)
+}
+
+func (t *Tournament) Finished() bool {
+	return t.Remaining() == 1
+}
+
+// Tables returns the players seated at each table, indexed by table number.
+// Broken tables are empty.
+func (t *Tournament) Tables() [][]string {
+	tables := make([][]string, len(t.tables))
+	for i, tbl := range t.tables {
+		tables[i] = slices.Clone(tbl.players)
+	}
+	return tables
+}
+
+// Standings returns finishes from first place down. Players still in the
+// tournament are not included until it is finished.
+func (t *Tournament) Standings() []Finish {
+	standings := slices.Clone(t.finishes)
+	if t.Finished() {
+		for id := range t.tableOf {
+			standings = append(standings, Finish{id, 1})
+		}
+	}
+	slices.Reverse(standings)
+	return standings
+}
+
+// RecordHand applies a table's hand result, eliminates busted players and
+// rebalances the tables, returning the moves the tables must make.
+func (t *Tournament) RecordHand(result HandResult) ([]Move, error) {
+	if t.Finished() {
+		return nil, fmt.Errorf("tournament is finished")
+	}
+	if result.Table < 0 || result.Table >= len(t.tables) || t.tables[result.Table].broken {
+		return nil, fmt.Errorf("invalid table: %d", result.Table)
+	}
+
+	players := t.tables[result.Table].players
+	if len(result.Stacks) != len(players) {
+		return nil, fmt.Errorf("table %d has %d players, result has %d", result.Table, len(players), len(result.Stacks))
+	}
+
+	var before, after int
+	for _, id := range players {
+		stack, ok := result.Stacks[id]
+		if !ok {
+			return nil, fmt.Errorf("result for table %d is missing player %s", result.Table, id)
+		}
+		if stack < 0 {
+			return nil, fmt.Errorf("player %s has negative stack %d", id, stack)
+		}
+		before += t.stacks[id]
+		after += stack
+	}
+	if before != after {
+		return nil, fmt.Errorf("table %d had %d chips before the hand and %d after", result.Table, before, after)
+	}
+
+	var busted []Entrant
+	for _, id := range players {
+		if result.Stacks[id] == 0 {
+			busted = append(busted, Entrant{id, t.stacks[id]})
+		}
+		t.stacks[id] = result.Stacks[id]
+	}
+	t.handsPlayed++
+	t.eliminate(busted)
+
+	if t.Finished() {
+		return nil, nil
+	}
+	return t.balance(), nil
+}
+
+// eliminate records busted players, those who started the hand with fewer
+// chips finishing lower.
+func (t *Tournament) eliminate(busted []Entrant) {
+	slices.SortStableFunc(busted, func(a, b Entrant) int {
+		return cmp.Compare(a.Stack, b.Stack)
+	})
+
+	for i := 0; i < len(busted); {
+		j := i + 1
+		for j < len(busted) && busted[j].Stack == busted[i].Stack {
+			j++
+		}
+		// The group covers places Remaining()-(j-i)+1 .. Remaining().
+		place := t.Remaining() - (j - i) + 1
+		for _, entrant := range busted[i:j] {
+			t.unseat(entrant.ID)
+			t.finishes = append(t.finishes, Finish{entrant.ID, place})
+		}
+		i = j
+	}
+}
+
+// balance breaks tables no longer needed, then evens out the rest so no two
+// tables differ by more than one player.
+func (t *Tournament) balance() []Move {
+	var moves []Move
+
+	for t.activeTables() > tablesNeeded(t.Remaining(), t.tableSize) {
+		broken := t.smallestTable()
+		t.tables[broken].broken = true
+		for _, id := range slices.Clone(t.tables[broken].players) {
+			to := t.smallestTable()
+			t.unseat(id)
+			t.seat(id, to)
+			moves = append(moves, Move{id, broken, to})
+		}
+	}
+
+	for {
+		from, to := t.largestTable(), t.smallestTable()
+		if len(t.tables[from].players)-len(t.tables[to].players) <= 1 {
+			return moves
+		}
+		players := t.tables[from].players
+		id := players[len(players)-1]
+		t.unseat(id)
+		t.seat(id, to)
+		moves = append(moves, Move{id, from, to})
+	}
+}
+
+func (t *Tournament) seat(id string, tbl int) {
+	t.tables[tbl].players = append(t.tables[tbl].players, id)
+	t.tableOf[id] = tbl
+}
+
+func (t *Tournament) unseat(id string) {
+	tbl := t.tableOf[id]
+	t.tables[tbl].players = slices.DeleteFunc(t.tables[tbl].players, func(p string) bool {
+		return p == id
+	})
+	delete(t.tableOf, id)
+}
+
+func (t *Tournament) activeTables() int {
+	var n int
+	for _, tbl := range t.tables {
+		if !tbl.broken {
+			n++
+		}
+	}
+	return n
+}
+
+// smallestTable returns the open table with the fewest players, preferring
+// the highest numbered one so that tables are broken from the end.
+func (t *Tournament) smallestTable() int {
+	smallest := -1
+	for i, tbl := range t.tables {
+		if tbl.broken {
+			continue
+		}
+		if smallest == -1 || len(tbl.players) <= len(t.tables[smallest].players) {
+			smallest = i
+		}
+	}
+	return smallest
+}
+
+func (t *Tournament) largestTable() int {
+	largest := -1
+	for i, tbl := range t.tables {
+		if tbl.broken {
+			continue
+		}
+		if largest == -1 || len(tbl.players) > len(t.tables[largest].players) {
+			largest = i
+		}
+	}
+	return largest
+}
+
+func tablesNeeded(players, tableSize int) int {
+	return (players + tableSize - 1) / tableSize
+}
+
+// Payouts splits a prize pool by percentages, listed from first place down.
+// Amounts are rounded down and leftover chips go to the top places.
+func Payouts(prizePool int, percentages []float64) ([]int, error) {
+	if prizePool < 0 {
+		return nil, fmt.Errorf("invalid prize pool: %d", prizePool)
+	}
+
+	var total float64
+	for i, p := range percentages {
+		if p < 0 {
+			return nil, fmt.Errorf("place %d has negative percentage %f", i+1, p)
+		}
+		total += p
+	}
+	if math.Abs(total-100) > 1e-9 {
+		return nil, fmt.Errorf("payout percentages add up to %f, expected 100", total)
+	}
+
+	payouts := make([]int, len(percentages))
+	remaining := prizePool
+	for i, p := range percentages {
+		payouts[i] = int(math.Floor(float64(prizePool) * p / 100))
+		remaining -= payouts[i]
+	}
+	for i := 0; remaining > 0; i = (i + 1) % len(payouts) {
+		payouts[i]++
+		remaining--
+	}
+	return payouts, nil
+}
+
+// Awards pays out finishes, tied players splitting the combined payouts of the
+// places they cover. Any chips left over from splitting go to the first of
+// them in standings order.
+func Awards(standings []Finish, payouts []int) map[string]int {
+	byPlace := make(map[int][]string)
+	for _, finish := range standings {
+		byPlace[finish.Place] = append(byPlace[finish.Place], finish.ID)
+	}
+
+	awards := make(map[string]int)
+	for place, ids := range byPlace {
+		var pool int
+		for p := place; p < place+len(ids); p++ {
+			if p <= len(payouts) {
+				pool += payouts[p-1]
+			}
+		}
+		for i, id := range ids {
+			awards[id] = pool / len(ids)
+			if i < pool%len(ids) {
+				awards[id]++
+			}
+		}
+	}
+	return awards
+}
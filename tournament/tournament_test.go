@@ -0,0 +1,172 @@
+package tournament
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+)
+
+var schedule = Schedule{
+	{SmallBlind: 10, BigBlind: 20, Hands: 10},
+	{SmallBlind: 20, BigBlind: 40, Ante: 5, Hands: 10},
+	{SmallBlind: 50, BigBlind: 100, Ante: 10},
+}
+
+func entrants(n int) []Entrant {
+	result := make([]Entrant, n)
+	for i := range result {
+		result[i] = Entrant{fmt.Sprintf("p%d", i), 1000}
+	}
+	return result
+}
+
+// bust moves all chips of the losers at their table to the winner.
+func bust(t *testing.T, tr *Tournament, winner string, losers ...string) []Move {
+	t.Helper()
+	table := slices.IndexFunc(tr.Tables(), func(players []string) bool {
+		return slices.Contains(players, winner)
+	})
+	stacks := make(map[string]int)
+	for _, id := range tr.Tables()[table] {
+		stacks[id] = tr.Stack(id)
+	}
+	for _, id := range losers {
+		stacks[winner] += stacks[id]
+		stacks[id] = 0
+	}
+	moves, err := tr.RecordHand(HandResult{table, stacks})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return moves
+}
+
+func TestScheduleLevel(t *testing.T) {
+	cases := []struct {
+		hands    int
+		expected int
+	}{
+		{0, 20}, {9, 20}, {10, 40}, {19, 40}, {20, 100}, {1000, 100},
+	}
+	for _, tc := range cases {
+		if got := schedule.Level(tc.hands).BigBlind; got != tc.expected {
+			t.Errorf("after %d hands expected big blind %d, got %d", tc.hands, tc.expected, got)
+		}
+	}
+	if got := (Schedule{}).Level(5); got != (BlindLevel{}) {
+		t.Errorf("expected the zero level for an empty schedule, got %+v", got)
+	}
+}
+
+func TestSeatingAndBalancing(t *testing.T) {
+	tr, err := New(entrants(20), 10, schedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sizes := tableSizes(tr); !slices.Equal(sizes, []int{10, 10}) {
+		t.Fatalf("expected two tables of 10, got %v", sizes)
+	}
+
+	// p0 sits at table 0 with p2, p4, ...; two eliminations there leave it two
+	// short of table 1, so one player moves over.
+	moves := bust(t, tr, "p0", "p2", "p4")
+	if len(moves) != 1 || moves[0].FromTable != 1 || moves[0].ToTable != 0 {
+		t.Errorf("expected one move from table 1 to table 0, got %v", moves)
+	}
+	if sizes := tableSizes(tr); !slices.Equal(sizes, []int{9, 9}) {
+		t.Errorf("expected tables of 9 and 9, got %v", sizes)
+	}
+}
+
+func TestBreakingTables(t *testing.T) {
+	tr, err := New(entrants(20), 9, schedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sizes := tableSizes(tr); !slices.Equal(sizes, []int{7, 7, 6}) {
+		t.Fatalf("expected tables of 7, 7 and 6, got %v", sizes)
+	}
+
+	if moves := bust(t, tr, "p0", "p3"); len(moves) != 0 {
+		t.Errorf("expected no moves with tables of 6, 7 and 6, got %v", moves)
+	}
+
+	// 18 players fit on two tables of 9, so the smallest table is broken.
+	moves := bust(t, tr, "p0", "p6")
+	if len(moves) != 5 {
+		t.Errorf("expected the 5 players of table 0 to move, got %v", moves)
+	}
+	if sizes := tableSizes(tr); !slices.Equal(sizes, []int{0, 9, 9}) {
+		t.Errorf("expected table 0 broken and tables of 9 and 9, got %v", sizes)
+	}
+}
+
+func TestSimultaneousEliminations(t *testing.T) {
+	tr, err := New([]Entrant{{"a", 500}, {"b", 300}, {"c", 200}, {"d", 200}}, 9, schedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bust(t, tr, "a", "b", "c", "d")
+	if !tr.Finished() {
+		t.Fatal("expected tournament to be finished")
+	}
+
+	expected := []Finish{{"a", 1}, {"b", 2}, {"d", 3}, {"c", 3}}
+	if got := tr.Standings(); !slices.Equal(got, expected) {
+		t.Errorf("\nexpected: %v\ngot     : %v", expected, got)
+	}
+
+	awards := Awards(tr.Standings(), []int{500, 300, 150, 51})
+	expectedAwards := map[string]int{"a": 500, "b": 300, "c": 100, "d": 101}
+	if !maps.Equal(awards, expectedAwards) {
+		t.Errorf("\nexpected: %v\ngot     : %v", expectedAwards, awards)
+	}
+}
+
+func TestRecordHandRejectsInvalidResults(t *testing.T) {
+	tr, err := New(entrants(4), 9, schedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		description string
+		result      HandResult
+	}{
+		{"unknown table", HandResult{1, nil}},
+		{"missing player", HandResult{0, map[string]int{"p0": 1000, "p1": 1000, "p2": 1000, "x": 1000}}},
+		{"chips created", HandResult{0, map[string]int{"p0": 1100, "p1": 1000, "p2": 1000, "p3": 1000}}},
+		{"negative stack", HandResult{0, map[string]int{"p0": 2100, "p1": -100, "p2": 1000, "p3": 1000}}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			if _, err := tr.RecordHand(tc.result); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestPayouts(t *testing.T) {
+	payouts, err := Payouts(1001, []float64{50, 30, 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(payouts, []int{501, 300, 200}) {
+		t.Errorf("expected [501 300 200], got %v", payouts)
+	}
+
+	if _, err := Payouts(1000, []float64{50, 30}); err == nil {
+		t.Error("expected error for percentages not adding up to 100")
+	}
+}
+
+func tableSizes(tr *Tournament) []int {
+	var sizes []int
+	for _, players := range tr.Tables() {
+		sizes = append(sizes, len(players))
+	}
+	return sizes
+}